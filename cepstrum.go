@@ -0,0 +1,251 @@
+package algofft
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// cepstrumFloor is the smallest magnitude passed to the logarithm, so that
+// spectral zeros give a large negative but finite log magnitude.
+const cepstrumFloor = 1e-300
+
+// RealCepstrum computes the real cepstrum of x, the inverse FFT of the log
+// magnitude spectrum, ifft(log|fft(x)|), as MATLAB's rceps. Periodic
+// structure in the spectrum, such as the harmonics of a pitch or the
+// ripple of an echo, appears as a peak at the period (quefrency) in
+// samples. dst must have the length of x.
+func RealCepstrum(dst, x []float32) error {
+	return realCepstrum[float32, complex64](dst, x)
+}
+
+// RealCepstrum64 is the float64 variant of RealCepstrum.
+func RealCepstrum64(dst, x []float64) error {
+	return realCepstrum[float64, complex128](dst, x)
+}
+
+// ComplexCepstrum computes the complex cepstrum of x, the inverse FFT of
+// the complex logarithm of its spectrum, as MATLAB's cceps. The phase is
+// unwrapped across frequency and its linear part, a circular delay of x,
+// is removed first; the delay in samples is returned for
+// InverseComplexCepstrum. dst must have the length of x.
+//
+// Convolution of signals becomes addition of their complex cepstra, which
+// is the basis of homomorphic deconvolution. The sign of x is not
+// represented: for a negative DC gain the cepstrum of -x is returned.
+func ComplexCepstrum(dst, x []float32) (int, error) {
+	return complexCepstrum[float32, complex64](dst, x)
+}
+
+// ComplexCepstrum64 is the float64 variant of ComplexCepstrum.
+func ComplexCepstrum64(dst, x []float64) (int, error) {
+	return complexCepstrum[float64, complex128](dst, x)
+}
+
+// InverseComplexCepstrum reconstructs a signal from its complex cepstrum
+// and the delay returned by ComplexCepstrum. dst must have the length of
+// xhat.
+func InverseComplexCepstrum(dst, xhat []float32, delay int) error {
+	return inverseComplexCepstrum[float32, complex64](dst, xhat, delay)
+}
+
+// InverseComplexCepstrum64 is the float64 variant of
+// InverseComplexCepstrum.
+func InverseComplexCepstrum64(dst, xhat []float64, delay int) error {
+	return inverseComplexCepstrum[float64, complex128](dst, xhat, delay)
+}
+
+// MinimumPhase computes the minimum-phase FIR filter with a given
+// magnitude response by folding its real cepstrum onto positive
+// quefrencies. magnitude holds |H| at the n/2+1 frequencies k/n, k = 0..n/2,
+// of an even FFT length n = 2·(len(magnitude)-1), as returned by
+// RFFTFreq(n, 1). dst receives the first len(dst) ≤ n taps; a dense
+// frequency grid keeps cepstral aliasing low.
+func MinimumPhase(dst, magnitude []float32) error {
+	return minimumPhase[float32, complex64](dst, magnitude)
+}
+
+// MinimumPhase64 is the float64 variant of MinimumPhase.
+func MinimumPhase64(dst, magnitude []float64) error {
+	return minimumPhase[float64, complex128](dst, magnitude)
+}
+
+// newCepstrumTransform validates dst and x and returns the transform and
+// a spectrum buffer for their length.
+func newCepstrumTransform[F Float, C Complex](dst, x []F) (*realTransform[F, C], []C, error) {
+	if dst == nil || x == nil {
+		return nil, nil, ErrNilSlice
+	}
+
+	if len(dst) != len(x) {
+		return nil, nil, ErrLengthMismatch
+	}
+
+	t, err := newRealTransform[F, C](len(x), PlanOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return t, make([]C, t.bins()), nil
+}
+
+func realCepstrum[F Float, C Complex](dst, x []F) error {
+	t, spec, err := newCepstrumTransform[F, C](dst, x)
+	if err != nil {
+		return err
+	}
+
+	err = t.forward(spec, x)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range spec {
+		spec[k] = C(complex(math.Log(max(cmplx.Abs(complex128(v)), cepstrumFloor)), 0))
+	}
+
+	return t.inverse(dst, spec)
+}
+
+func complexCepstrum[F Float, C Complex](dst, x []F) (int, error) {
+	t, spec, err := newCepstrumTransform[F, C](dst, x)
+	if err != nil {
+		return 0, err
+	}
+
+	err = t.forward(spec, x)
+	if err != nil {
+		return 0, err
+	}
+
+	phase := make([]float64, len(spec))
+	for k, v := range spec {
+		phase[k] = cmplx.Phase(complex128(v))
+	}
+
+	unwrapPhase(phase)
+
+	// A negative DC gain starts the phase at ±π; drop the sign.
+	offset := phase[0]
+	for k := range phase {
+		phase[k] -= offset
+	}
+
+	delay := linearPhaseDelay(phase, t.n)
+
+	for k, v := range spec {
+		p := phase[k] + 2*math.Pi*float64(delay*k)/float64(t.n)
+		spec[k] = C(complex(math.Log(max(cmplx.Abs(complex128(v)), cepstrumFloor)), p))
+	}
+
+	err = t.inverse(dst, spec)
+	if err != nil {
+		return 0, err
+	}
+
+	return delay, nil
+}
+
+func inverseComplexCepstrum[F Float, C Complex](dst, xhat []F, delay int) error {
+	t, spec, err := newCepstrumTransform[F, C](dst, xhat)
+	if err != nil {
+		return err
+	}
+
+	err = t.forward(spec, xhat)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range spec {
+		logX := complex128(v) - complex(0, 2*math.Pi*float64(delay*k)/float64(t.n))
+		spec[k] = C(cmplx.Exp(logX))
+	}
+
+	return t.inverse(dst, spec)
+}
+
+func minimumPhase[F Float, C Complex](dst, magnitude []F) error {
+	if dst == nil || magnitude == nil {
+		return ErrNilSlice
+	}
+
+	if len(magnitude) < 2 {
+		return ErrInvalidLength
+	}
+
+	n := 2 * (len(magnitude) - 1)
+	if len(dst) < 1 || len(dst) > n {
+		return ErrLengthMismatch
+	}
+
+	t, err := newRealTransform[F, C](n, PlanOptions{})
+	if err != nil {
+		return err
+	}
+
+	spec := make([]C, t.bins())
+
+	for k, v := range magnitude {
+		if !(v >= 0) {
+			return ErrInvalidParameter
+		}
+
+		spec[k] = C(complex(math.Log(max(float64(v), cepstrumFloor)), 0))
+	}
+
+	cepstrum := make([]F, n)
+
+	err = t.inverse(cepstrum, spec)
+	if err != nil {
+		return err
+	}
+
+	// Fold the even real cepstrum onto positive quefrencies: the result is
+	// the complex cepstrum of the causal, minimum-phase filter.
+	for i := 1; i < n/2; i++ {
+		cepstrum[i] *= 2
+	}
+
+	clear(cepstrum[n/2+1:])
+
+	err = t.forward(spec, cepstrum)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range spec {
+		spec[k] = C(cmplx.Exp(complex128(v)))
+	}
+
+	err = t.inverse(cepstrum, spec)
+	if err != nil {
+		return err
+	}
+
+	copy(dst, cepstrum)
+
+	return nil
+}
+
+// unwrapPhase removes the 2π jumps between consecutive phase samples.
+func unwrapPhase(phase []float64) {
+	for k := 1; k < len(phase); k++ {
+		diff := phase[k] - phase[k-1]
+		phase[k] -= 2 * math.Pi * math.Round(diff/(2*math.Pi))
+	}
+}
+
+// linearPhaseDelay returns the integer circular delay d whose linear
+// phase -2π·d·k/n best explains the unwrapped phase at the Nyquist
+// frequency, which a real signal's phase reaches as a multiple of π.
+func linearPhaseDelay(phase []float64, n int) int {
+	last := len(phase) - 1
+	if last == 0 {
+		return 0
+	}
+
+	// For odd n the last bin lies just below the Nyquist frequency.
+	nyquist := phase[last] * float64(n) / float64(2*last)
+
+	return int(-math.Round(nyquist / math.Pi))
+}
@@ -0,0 +1,313 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+// naiveDFT returns the DFT of x, or its normalized inverse.
+func naiveDFT(x []complex128, inverse bool) []complex128 {
+	n := len(x)
+	sign, scale := -1.0, 1.0
+
+	if inverse {
+		sign, scale = 1, 1/float64(n)
+	}
+
+	out := make([]complex128, n)
+
+	for k := range out {
+		for i, v := range x {
+			out[k] += v * cmplx.Exp(complex(0, sign*2*math.Pi*float64(k*i)/float64(n)))
+		}
+
+		out[k] *= complex(scale, 0)
+	}
+
+	return out
+}
+
+func TestRealCepstrumMatchesNaive(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(17))
+
+	for _, n := range []int{1, 2, 15, 16, 63} {
+		x := randomFloat64s(rng, n)
+
+		xc := make([]complex128, n)
+		for i, v := range x {
+			xc[i] = complex(v, 0)
+		}
+
+		spec := naiveDFT(xc, false)
+		for k, v := range spec {
+			spec[k] = complex(math.Log(cmplx.Abs(v)), 0)
+		}
+
+		want := naiveDFT(spec, true)
+
+		got := make([]float64, n)
+		if err := RealCepstrum64(got, x); err != nil {
+			t.Fatalf("RealCepstrum64(n=%d) returned error: %v", n, err)
+		}
+
+		got32 := make([]float32, n)
+		if err := RealCepstrum(got32, toFloat32(x)); err != nil {
+			t.Fatalf("RealCepstrum(n=%d) returned error: %v", n, err)
+		}
+
+		for i := range want {
+			assertApproxFloat64(t, got[i], real(want[i]), 1e-10, "RealCepstrum64 n=%d [%d]", n, i)
+			assertApproxFloat64(t, float64(got32[i]), real(want[i]), 1e-3, "RealCepstrum n=%d [%d]", n, i)
+		}
+	}
+}
+
+func TestRealCepstrumFindsEcho(t *testing.T) {
+	t.Parallel()
+
+	const n, lag = 2048, 37
+
+	rng := rand.New(rand.NewSource(2))
+	s := randomFloat64s(rng, n)
+	x := make([]float64, n)
+
+	for i := range x {
+		x[i] = s[i]
+		if i >= lag {
+			x[i] += 0.6 * s[i-lag]
+		}
+	}
+
+	c := make([]float64, n)
+	if err := RealCepstrum64(c, x); err != nil {
+		t.Fatalf("RealCepstrum64() returned error: %v", err)
+	}
+
+	peak := 10
+	for q := 10; q < n/2; q++ {
+		if c[q] > c[peak] {
+			peak = q
+		}
+	}
+
+	if peak != lag {
+		t.Fatalf("cepstral peak at quefrency %d, want echo lag %d", peak, lag)
+	}
+}
+
+func TestComplexCepstrum(t *testing.T) {
+	t.Parallel()
+
+	// x = δ[0] - a·δ[1] has log X(z) = -Σ a^k/k z^-k.
+	const a, n = 0.5, 64
+
+	x := make([]float64, n)
+	x[0], x[1] = 1, -a
+
+	xhat := make([]float64, n)
+
+	delay, err := ComplexCepstrum64(xhat, x)
+	if err != nil {
+		t.Fatalf("ComplexCepstrum64() returned error: %v", err)
+	}
+
+	if delay != 0 {
+		t.Fatalf("minimum-phase delay = %d, want 0", delay)
+	}
+
+	for k := range xhat {
+		want := 0.0
+		if k > 0 {
+			want = -math.Pow(a, float64(k)) / float64(k)
+		}
+
+		assertApproxFloat64(t, xhat[k], want, 1e-12, "cepstrum [%d]", k)
+	}
+
+	// A circular delay is removed and reported, leaving the cepstrum as is.
+	shifted := make([]float64, n)
+	for i, v := range x {
+		shifted[(i+5)%n] = v
+	}
+
+	got := make([]float64, n)
+
+	delay, err = ComplexCepstrum64(got, shifted)
+	if err != nil {
+		t.Fatalf("ComplexCepstrum64(shifted) returned error: %v", err)
+	}
+
+	if delay != 5 {
+		t.Fatalf("delay = %d, want 5", delay)
+	}
+
+	for k := range got {
+		assertApproxFloat64(t, got[k], xhat[k], 1e-12, "shifted cepstrum [%d]", k)
+	}
+
+	// Convolution adds cepstra.
+	y := causalFilter(x, []float64{1, 0.3, -0.2})
+	h := make([]float64, n)
+	h[0], h[1], h[2] = 1, 0.3, -0.2
+
+	yhat := make([]float64, n)
+	hhat := make([]float64, n)
+
+	if _, err := ComplexCepstrum64(yhat, y); err != nil {
+		t.Fatalf("ComplexCepstrum64(y) returned error: %v", err)
+	}
+
+	if _, err := ComplexCepstrum64(hhat, h); err != nil {
+		t.Fatalf("ComplexCepstrum64(h) returned error: %v", err)
+	}
+
+	for k := range yhat {
+		assertApproxFloat64(t, yhat[k], xhat[k]+hhat[k], 1e-10, "cepstrum of convolution [%d]", k)
+	}
+}
+
+func TestComplexCepstrumRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(19))
+
+	for _, n := range []int{2, 17, 64, 101} {
+		x := randomFloat64s(rng, n)
+
+		// Force a positive DC gain so that the sign survives.
+		var sum float64
+		for _, v := range x {
+			sum += v
+		}
+
+		if sum < 0 {
+			for i := range x {
+				x[i] = -x[i]
+			}
+		}
+
+		xhat := make([]float64, n)
+
+		delay, err := ComplexCepstrum64(xhat, x)
+		if err != nil {
+			t.Fatalf("ComplexCepstrum64(n=%d) returned error: %v", n, err)
+		}
+
+		got := make([]float64, n)
+		if err := InverseComplexCepstrum64(got, xhat, delay); err != nil {
+			t.Fatalf("InverseComplexCepstrum64(n=%d) returned error: %v", n, err)
+		}
+
+		for i := range x {
+			assertApproxFloat64(t, got[i], x[i], 1e-9, "round trip n=%d [%d]", n, i)
+		}
+
+		xhat32 := make([]float32, n)
+
+		delay, err = ComplexCepstrum(xhat32, toFloat32(x))
+		if err != nil {
+			t.Fatalf("ComplexCepstrum(n=%d) returned error: %v", n, err)
+		}
+
+		got32 := make([]float32, n)
+		if err := InverseComplexCepstrum(got32, xhat32, delay); err != nil {
+			t.Fatalf("InverseComplexCepstrum(n=%d) returned error: %v", n, err)
+		}
+
+		for i := range x {
+			assertApproxFloat64(t, float64(got32[i]), x[i], 1e-3, "float32 round trip n=%d [%d]", n, i)
+		}
+	}
+
+	// The sign of a signal with negative DC gain is dropped.
+	x := []float64{-1, 0.5, 0.1, 0, 0, 0, 0, 0}
+	xhat := make([]float64, len(x))
+
+	delay, err := ComplexCepstrum64(xhat, x)
+	if err != nil {
+		t.Fatalf("ComplexCepstrum64() returned error: %v", err)
+	}
+
+	got := make([]float64, len(x))
+	if err := InverseComplexCepstrum64(got, xhat, delay); err != nil {
+		t.Fatalf("InverseComplexCepstrum64() returned error: %v", err)
+	}
+
+	for i := range x {
+		assertApproxFloat64(t, got[i], -x[i], 1e-12, "negated round trip [%d]", i)
+	}
+}
+
+func TestMinimumPhase(t *testing.T) {
+	t.Parallel()
+
+	const n = 512
+
+	// Minimum- and maximum-phase filters with the same magnitude response
+	// both map to the minimum-phase one.
+	minPhase := []float64{1, -0.5, 0.2}
+	maxPhase := []float64{0.2, -0.5, 1}
+
+	for _, h := range [][]float64{minPhase, maxPhase} {
+		mag := make([]float64, n/2+1)
+		for k := range mag {
+			mag[k] = cmplx.Abs(filterResponse(h, float64(k)/n))
+		}
+
+		got := make([]float64, 6)
+		if err := MinimumPhase64(got, mag); err != nil {
+			t.Fatalf("MinimumPhase64(%v) returned error: %v", h, err)
+		}
+
+		for i := range got {
+			want := 0.0
+			if i < len(minPhase) {
+				want = minPhase[i]
+			}
+
+			assertApproxFloat64(t, got[i], want, 1e-9, "MinimumPhase64(%v) [%d]", h, i)
+		}
+
+		got32 := make([]float32, 6)
+		if err := MinimumPhase(got32, toFloat32(mag)); err != nil {
+			t.Fatalf("MinimumPhase(%v) returned error: %v", h, err)
+		}
+
+		for i := range got32 {
+			assertApproxFloat64(t, float64(got32[i]), got[i], 1e-4, "MinimumPhase(%v) [%d]", h, i)
+		}
+	}
+}
+
+func TestCepstrumErrors(t *testing.T) {
+	t.Parallel()
+
+	if err := RealCepstrum(nil, make([]float32, 4)); !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil dst error = %v, want ErrNilSlice", err)
+	}
+
+	if _, err := ComplexCepstrum64(make([]float64, 3), make([]float64, 4)); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("length mismatch error = %v, want ErrLengthMismatch", err)
+	}
+
+	if err := InverseComplexCepstrum64([]float64{}, []float64{}, 0); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("empty input error = %v, want ErrInvalidLength", err)
+	}
+
+	if err := MinimumPhase64(make([]float64, 4), []float64{1}); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("short magnitude error = %v, want ErrInvalidLength", err)
+	}
+
+	if err := MinimumPhase64(make([]float64, 5), []float64{1, 1, 1}); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("long dst error = %v, want ErrLengthMismatch", err)
+	}
+
+	if err := MinimumPhase(make([]float32, 2), []float32{1, -1, 1}); !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("negative magnitude error = %v, want ErrInvalidParameter", err)
+	}
+}
@@ -0,0 +1,244 @@
+package algofft
+
+import "github.com/MeKo-Christian/algo-fft/internal/fft"
+
+// CircularConvolve computes the N-point circular convolution of a and b,
+// dst[k] = Σ a[j]·b[(k-j) mod N], with a single length-N transform and no
+// zero padding. All three slices must have the same length.
+func CircularConvolve[T Complex](dst, a, b []T) error {
+	return circularComplex(dst, a, b, len(a), 1, false)
+}
+
+// CircularCorrelate computes the N-point circular cross-correlation
+// dst[k] = Σ a[(j+k) mod N]·conj(b[j]). Index k holds lag k; negative lags
+// wrap to the end. All three slices must have the same length.
+func CircularCorrelate[T Complex](dst, a, b []T) error {
+	return circularComplex(dst, a, b, len(a), 1, true)
+}
+
+// CircularConvolveBatch circularly convolves count length-n signals stored
+// back to back in a with b, reusing one Plan. The dst slice must have
+// length count*n. If b has length n it is applied to every signal and its
+// spectrum is computed once; if it has length count*n, signal i is
+// convolved with b[i*n:(i+1)*n]. dst may alias a.
+func CircularConvolveBatch[T Complex](dst, a, b []T, n, count int) error {
+	return circularComplex(dst, a, b, n, count, false)
+}
+
+// CircularCorrelateBatch is the batch variant of CircularCorrelate, with
+// the layout of CircularConvolveBatch.
+func CircularCorrelateBatch[T Complex](dst, a, b []T, n, count int) error {
+	return circularComplex(dst, a, b, n, count, true)
+}
+
+// CircularConvolveReal computes the N-point circular convolution of real
+// signals. Even lengths use a real FFT; odd lengths fall back to a complex
+// transform of the same length.
+func CircularConvolveReal(dst, a, b []float32) error {
+	return circularReal[float32, complex64](dst, a, b, len(a), 1, false)
+}
+
+// CircularConvolveReal64 is the float64 variant of CircularConvolveReal.
+func CircularConvolveReal64(dst, a, b []float64) error {
+	return circularReal[float64, complex128](dst, a, b, len(a), 1, false)
+}
+
+// CircularCorrelateReal computes the N-point circular cross-correlation
+// dst[k] = Σ a[(j+k) mod N]·b[j] of real signals.
+func CircularCorrelateReal(dst, a, b []float32) error {
+	return circularReal[float32, complex64](dst, a, b, len(a), 1, true)
+}
+
+// CircularCorrelateReal64 is the float64 variant of CircularCorrelateReal.
+func CircularCorrelateReal64(dst, a, b []float64) error {
+	return circularReal[float64, complex128](dst, a, b, len(a), 1, true)
+}
+
+// CircularConvolveRealBatch is the real-signal variant of
+// CircularConvolveBatch.
+func CircularConvolveRealBatch(dst, a, b []float32, n, count int) error {
+	return circularReal[float32, complex64](dst, a, b, n, count, false)
+}
+
+// CircularConvolveReal64Batch is the float64 variant of
+// CircularConvolveRealBatch.
+func CircularConvolveReal64Batch(dst, a, b []float64, n, count int) error {
+	return circularReal[float64, complex128](dst, a, b, n, count, false)
+}
+
+// CircularCorrelateRealBatch is the real-signal variant of
+// CircularCorrelateBatch.
+func CircularCorrelateRealBatch(dst, a, b []float32, n, count int) error {
+	return circularReal[float32, complex64](dst, a, b, n, count, true)
+}
+
+// CircularCorrelateReal64Batch is the float64 variant of
+// CircularCorrelateRealBatch.
+func CircularCorrelateReal64Batch(dst, a, b []float64, n, count int) error {
+	return circularReal[float64, complex128](dst, a, b, n, count, true)
+}
+
+// validateCircular checks the batch layout and reports whether b is shared
+// by all signals.
+func validateCircular(dstLen, aLen, bLen, n, count int, isNil bool) (bool, error) {
+	if isNil {
+		return false, ErrNilSlice
+	}
+
+	if n < 1 || count < 1 {
+		return false, ErrInvalidLength
+	}
+
+	if dstLen != n*count || aLen != n*count || (bLen != n && bLen != n*count) {
+		return false, ErrLengthMismatch
+	}
+
+	return bLen == n, nil
+}
+
+func circularComplex[T Complex](dst, a, b []T, n, count int, correlate bool) error {
+	shared, err := validateCircular(len(dst), len(a), len(b), n, count, dst == nil || a == nil || b == nil)
+	if err != nil {
+		return err
+	}
+
+	plan, err := NewPlanT[T](n)
+	if err != nil {
+		return err
+	}
+
+	freq, releaseFreq := circularScratch[T](n)
+	defer releaseFreq()
+
+	bFreq, releaseB := circularScratch[T](n)
+	defer releaseB()
+
+	for i := range count {
+		signal := a[i*n : (i+1)*n]
+
+		if i == 0 || !shared {
+			err = plan.Forward(bFreq, b[i*n:(i+1)*n])
+			if err != nil {
+				return err
+			}
+
+			// Correlation multiplies by conj(B); conjugate it once so
+			// every signal goes through the SIMD multiply.
+			if correlate {
+				conjInPlace(bFreq)
+			}
+		}
+
+		err = plan.Forward(freq, signal)
+		if err != nil {
+			return err
+		}
+
+		complexMulInPlace(freq, bFreq)
+
+		err = plan.Inverse(dst[i*n:(i+1)*n], freq)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// circularReal uses half-spectrum transforms for even n. Real plans need
+// an even length, so odd n runs the complex path on promoted copies.
+func circularReal[F Float, C Complex](dst, a, b []F, n, count int, correlate bool) error {
+	shared, err := validateCircular(len(dst), len(a), len(b), n, count, dst == nil || a == nil || b == nil)
+	if err != nil {
+		return err
+	}
+
+	if n%2 != 0 {
+		return circularRealOdd[F, C](dst, a, b, n, count, correlate)
+	}
+
+	plan, err := NewPlanRealT[F, C](n)
+	if err != nil {
+		return err
+	}
+
+	freq, releaseFreq := circularScratch[C](plan.SpectrumLen())
+	defer releaseFreq()
+
+	bFreq, releaseB := circularScratch[C](plan.SpectrumLen())
+	defer releaseB()
+
+	for i := range count {
+		if i == 0 || !shared {
+			err = plan.Forward(bFreq, b[i*n:(i+1)*n])
+			if err != nil {
+				return err
+			}
+
+			// Correlation multiplies by conj(B); conjugate it once so
+			// every signal goes through the SIMD multiply.
+			if correlate {
+				conjInPlace(bFreq)
+			}
+		}
+
+		err = plan.Forward(freq, a[i*n:(i+1)*n])
+		if err != nil {
+			return err
+		}
+
+		complexMulInPlace(freq, bFreq)
+
+		err = plan.Inverse(dst[i*n:(i+1)*n], freq)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func circularRealOdd[F Float, C Complex](dst, a, b []F, n, count int, correlate bool) error {
+	ac, releaseA := circularScratch[C](len(a))
+	defer releaseA()
+
+	for i, v := range a {
+		ac[i] = C(complex(float64(v), 0))
+	}
+
+	bc, releaseB := circularScratch[C](len(b))
+	defer releaseB()
+
+	for i, v := range b {
+		bc[i] = C(complex(float64(v), 0))
+	}
+
+	// The complex path works in place on ac.
+	err := circularComplex(ac, ac, bc, n, count, correlate)
+	if err != nil {
+		return err
+	}
+
+	for i, v := range ac {
+		dst[i] = F(real(complex128(v)))
+	}
+
+	return nil
+}
+
+// circularScratch returns a length-n buffer from the shared FFT buffer
+// pool and a function that returns it. The contents are undefined.
+func circularScratch[T Complex](n int) ([]T, func()) {
+	var zero T
+
+	switch any(zero).(type) {
+	case complex64:
+		data, backing := fft.DefaultPool.GetComplex64(n)
+
+		return any(data).([]T), func() { fft.DefaultPool.PutComplex64(n, data, backing) }
+	default:
+		data, backing := fft.DefaultPool.GetComplex128(n)
+
+		return any(data).([]T), func() { fft.DefaultPool.PutComplex128(n, data, backing) }
+	}
+}
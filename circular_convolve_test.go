@@ -0,0 +1,207 @@
+package algofft
+
+import (
+	"errors"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+func naiveCircular(a, b []complex128, correlate bool) []complex128 {
+	n := len(a)
+	out := make([]complex128, n)
+
+	for k := range out {
+		for j := range n {
+			if correlate {
+				out[k] += a[(j+k)%n] * cmplx.Conj(b[j])
+			} else {
+				out[k] += a[j] * b[((k-j)%n+n)%n]
+			}
+		}
+	}
+
+	return out
+}
+
+func randomComplex128s(rng *rand.Rand, n int) []complex128 {
+	out := make([]complex128, n)
+	for i := range out {
+		out[i] = complex(rng.Float64()*2-1, rng.Float64()*2-1)
+	}
+
+	return out
+}
+
+func narrowComplex(x []complex128) []complex64 {
+	out := make([]complex64, len(x))
+	for i, v := range x {
+		out[i] = complex64(v)
+	}
+
+	return out
+}
+
+func TestCircularConvolveAndCorrelate(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(40))
+
+	for _, n := range []int{1, 7, 16, 60, 97} {
+		a := randomComplex128s(rng, n)
+		b := randomComplex128s(rng, n)
+
+		for _, correlate := range []bool{false, true} {
+			want := naiveCircular(a, b, correlate)
+			got := make([]complex128, n)
+			got64 := make([]complex64, n)
+
+			var err, err64 error
+			if correlate {
+				err = CircularCorrelate(got, a, b)
+				err64 = CircularCorrelate(got64, narrowComplex(a), narrowComplex(b))
+			} else {
+				err = CircularConvolve(got, a, b)
+				err64 = CircularConvolve(got64, narrowComplex(a), narrowComplex(b))
+			}
+
+			if err != nil || err64 != nil {
+				t.Fatalf("n=%d correlate=%v: errors %v, %v", n, correlate, err, err64)
+			}
+
+			for i := range want {
+				assertApproxComplex128Tolf(t, got[i], want[i], 1e-10, "n=%d correlate=%v got[%d]", n, correlate, i)
+				assertApproxComplex128Tolf(t, complex128(got64[i]), want[i], 1e-4,
+					"n=%d correlate=%v got64[%d]", n, correlate, i)
+			}
+		}
+	}
+}
+
+func TestCircularBatchSharedAndPairwise(t *testing.T) {
+	t.Parallel()
+
+	const n, count = 24, 5
+
+	rng := rand.New(rand.NewSource(41))
+	a := randomComplex128s(rng, n*count)
+	kernel := randomComplex128s(rng, n)
+	pairs := randomComplex128s(rng, n*count)
+
+	// In place with a shared kernel.
+	shared := append([]complex128(nil), a...)
+
+	err := CircularConvolveBatch(shared, shared, kernel, n, count)
+	if err != nil {
+		t.Fatalf("CircularConvolveBatch(shared) returned error: %v", err)
+	}
+
+	pairwise := make([]complex128, n*count)
+
+	err = CircularCorrelateBatch(pairwise, a, pairs, n, count)
+	if err != nil {
+		t.Fatalf("CircularCorrelateBatch(pairwise) returned error: %v", err)
+	}
+
+	for i := range count {
+		signal := a[i*n : (i+1)*n]
+		wantConv := naiveCircular(signal, kernel, false)
+		wantCorr := naiveCircular(signal, pairs[i*n:(i+1)*n], true)
+
+		for k := range n {
+			assertApproxComplex128Tolf(t, shared[i*n+k], wantConv[k], 1e-10, "conv signal %d [%d]", i, k)
+			assertApproxComplex128Tolf(t, pairwise[i*n+k], wantCorr[k], 1e-10, "corr signal %d [%d]", i, k)
+		}
+	}
+}
+
+func TestCircularReal(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(42))
+
+	for _, n := range []int{1, 2, 9, 32, 45} {
+		const count = 3
+
+		a := randomFloat64s(rng, n*count)
+		b := randomFloat64s(rng, n)
+
+		for _, correlate := range []bool{false, true} {
+			got := make([]float64, n*count)
+			got32 := make([]float32, n*count)
+
+			var err, err32 error
+			if correlate {
+				err = CircularCorrelateReal64Batch(got, a, b, n, count)
+				err32 = CircularCorrelateRealBatch(got32, toFloat32(a), toFloat32(b), n, count)
+			} else {
+				err = CircularConvolveReal64Batch(got, a, b, n, count)
+				err32 = CircularConvolveRealBatch(got32, toFloat32(a), toFloat32(b), n, count)
+			}
+
+			if err != nil || err32 != nil {
+				t.Fatalf("n=%d correlate=%v: errors %v, %v", n, correlate, err, err32)
+			}
+
+			for i := range count {
+				want := naiveCircular(toComplex128(a[i*n:(i+1)*n]), toComplex128(b), correlate)
+
+				for k := range n {
+					assertApproxFloat64(t, got[i*n+k], real(want[k]), 1e-10,
+						"n=%d correlate=%v signal %d [%d]", n, correlate, i, k)
+					assertApproxFloat64(t, float64(got32[i*n+k]), real(want[k]), 1e-4,
+						"n=%d correlate=%v float32 signal %d [%d]", n, correlate, i, k)
+				}
+			}
+		}
+	}
+
+	// Single-signal wrappers.
+	a := []float64{1, 2, 3, 4}
+	b := []float64{0, 1, 0, 0}
+	got := make([]float64, 4)
+
+	err := CircularConvolveReal64(got, a, b)
+	if err != nil {
+		t.Fatalf("CircularConvolveReal64() returned error: %v", err)
+	}
+
+	for i, want := range []float64{4, 1, 2, 3} {
+		assertApproxFloat64(t, got[i], want, 1e-12, "shift got[%d]", i)
+	}
+
+	err = CircularCorrelateReal64(got, a, b)
+	if err != nil {
+		t.Fatalf("CircularCorrelateReal64() returned error: %v", err)
+	}
+
+	for i, want := range []float64{2, 3, 4, 1} {
+		assertApproxFloat64(t, got[i], want, 1e-12, "lag got[%d]", i)
+	}
+}
+
+func TestCircularErrors(t *testing.T) {
+	t.Parallel()
+
+	x := make([]complex64, 8)
+
+	if err := CircularConvolve(nil, x, x); !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil dst error = %v, want ErrNilSlice", err)
+	}
+
+	if err := CircularConvolve(x, x, x[:4]); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("short b error = %v, want ErrLengthMismatch", err)
+	}
+
+	if err := CircularConvolveBatch(x, x, x[:3], 4, 2); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("bad batch b error = %v, want ErrLengthMismatch", err)
+	}
+
+	if err := CircularConvolveBatch(x, x, x, 4, 0); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("zero count error = %v, want ErrInvalidLength", err)
+	}
+
+	if err := CircularConvolveReal([]float32{}, []float32{}, []float32{}); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("empty real error = %v, want ErrInvalidLength", err)
+	}
+}
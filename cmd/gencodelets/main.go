@@ -0,0 +1,647 @@
+// Command gencodelets emits straight-line Go FFT codelets for small fixed
+// sizes, in the spirit of FFTW's genfft.
+//
+// For every requested size it writes internal/kernels/dit_size<N>_gen.go with
+// forward and inverse kernels for complex64 and complex128, plus
+// codelet_init_gen.go (registry entries) and dit_gen_test.go (reference and
+// round-trip tests).
+//
+// The transform is unrolled symbolically: sizes are split decimation-in-time
+// (radix 4 first, then the smallest prime factor) down to radix-2, radix-4
+// or prime butterflies. All twiddles become constants, trivial ones (±1, ±i)
+// turn into additions and swaps, and prime butterflies use the symmetric
+// cos/sin pairing. The kernels read src completely before writing dst, so
+// they are safe in place and need neither twiddle, scratch nor bitrev.
+//
+// Usage (from the repository root):
+//
+//	go run ./cmd/gencodelets -sizes 6,10,12,15,20,24,48,60 -dir internal/kernels
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	header = "// Code generated by gencodelets. DO NOT EDIT.\n\n"
+
+	// maxSize bounds the unrolled code size; beyond it the recursive and
+	// mixed-radix kernels are the better tool.
+	maxSize = 128
+
+	// codeletPriority keeps hand-tuned codelets of the same size preferred.
+	codeletPriority = 5
+)
+
+type precision struct {
+	suffix string // Function-name suffix: "Complex64"
+	typ    string // Element type: "complex64"
+	reg    string // Registry variable: "Registry64"
+	wrap   string // Codelet wrapper: "wrapCodelet64"
+}
+
+var precisions = []precision{
+	{suffix: "Complex64", typ: "complex64", reg: "Registry64", wrap: "wrapCodelet64"},
+	{suffix: "Complex128", typ: "complex128", reg: "Registry128", wrap: "wrapCodelet128"},
+}
+
+func main() {
+	var (
+		sizeList = flag.String("sizes", "6,10,12,15,20,24,48,60", "comma-separated codelet sizes")
+		dir      = flag.String("dir", "internal/kernels", "output directory (package kernels)")
+	)
+
+	flag.Parse()
+
+	sizes, err := parseSizes(*sizeList)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gencodelets:", err)
+		os.Exit(2)
+	}
+
+	for _, n := range sizes {
+		err = writeFile(filepath.Join(*dir, "dit_size"+strconv.Itoa(n)+"_gen.go"), codeletFile(n))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "gencodelets:", err)
+			os.Exit(1)
+		}
+	}
+
+	err = writeFile(filepath.Join(*dir, "codelet_init_gen.go"), registrationFile(sizes))
+	if err == nil {
+		err = writeFile(filepath.Join(*dir, "dit_gen_test.go"), testFile(sizes))
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gencodelets:", err)
+		os.Exit(1)
+	}
+}
+
+func parseSizes(list string) ([]int, error) {
+	seen := map[int]bool{}
+
+	var sizes []int
+
+	for _, field := range strings.Split(list, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 2 || n > maxSize {
+			return nil, fmt.Errorf("invalid size %q (want 2..%d)", field, maxSize)
+		}
+
+		if !seen[n] {
+			seen[n] = true
+			sizes = append(sizes, n)
+		}
+	}
+
+	if len(sizes) == 0 {
+		return nil, fmt.Errorf("no sizes given")
+	}
+
+	sort.Ints(sizes)
+
+	return sizes, nil
+}
+
+func writeFile(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("format %s: %w", path, err)
+	}
+
+	return os.WriteFile(path, formatted, 0o644)
+}
+
+// kernelName returns the kernel name, e.g. forwardDIT6GenComplex64.
+func kernelName(direction string, n int, p precision) string {
+	return direction + "DIT" + strconv.Itoa(n) + "Gen" + p.suffix
+}
+
+func codeletFile(n int) []byte {
+	var b bytes.Buffer
+
+	b.WriteString(header)
+	b.WriteString("package kernels\n")
+
+	for _, p := range precisions {
+		for _, inverse := range []bool{false, true} {
+			b.WriteString("\n")
+			writeKernel(&b, n, p, inverse)
+		}
+	}
+
+	return b.Bytes()
+}
+
+func writeKernel(b *bytes.Buffer, n int, p precision, inverse bool) {
+	direction, what := "forward", "forward"
+	if inverse {
+		direction, what = "inverse", "inverse (scaled by 1/n)"
+	}
+
+	name := kernelName(direction, n, p)
+
+	fmt.Fprintf(b, "// %s computes a %d-point %s FFT for %s data as\n", name, n, what, p.typ)
+	fmt.Fprintf(b, "// straight-line code (radices %s). twiddle, scratch and bitrev are unused;\n", radixPlan(n))
+	b.WriteString("// dst may alias src. Returns false if a slice is too small.\n")
+	fmt.Fprintf(b, "func %s(dst, src, twiddle, scratch []%s, bitrev []int) bool {\n", name, p.typ)
+	fmt.Fprintf(b, "\tconst n = %d\n\n", n)
+	b.WriteString("\tif len(dst) < n || len(src) < n {\n\t\treturn false\n\t}\n\n")
+	b.WriteString("\ts := src[:n]\n\td := dst[:n]\n\n")
+
+	g := &gen{b: b, inverse: inverse}
+
+	inputs := make([]string, n)
+	for i := range n {
+		inputs[i] = "x" + strconv.Itoa(i)
+		fmt.Fprintf(b, "\t%s := s[%d]\n", inputs[i], i)
+	}
+
+	b.WriteString("\n")
+
+	outputs := g.dft(inputs)
+
+	b.WriteString("\n")
+
+	if inverse {
+		fmt.Fprintf(b, "\tconst scale = 1.0 / %d\n\n", n)
+
+		for k, v := range outputs {
+			fmt.Fprintf(b, "\td[%d] = complex(real(%s)*scale, imag(%s)*scale)\n", k, v, v)
+		}
+	} else {
+		for k, v := range outputs {
+			fmt.Fprintf(b, "\td[%d] = %s\n", k, v)
+		}
+	}
+
+	b.WriteString("\n\treturn true\n}\n")
+}
+
+// gen emits the symbolic transform as a sequence of short variable declarations.
+type gen struct {
+	b       *bytes.Buffer
+	inverse bool
+	next    int
+}
+
+func (g *gen) tmp() string {
+	name := "t" + strconv.Itoa(g.next)
+	g.next++
+
+	return name
+}
+
+func (g *gen) assign(expr string) string {
+	name := g.tmp()
+	fmt.Fprintf(g.b, "\t%s := %s\n", name, expr)
+
+	return name
+}
+
+// dft emits the DFT of the named inputs and returns the output names in order.
+func (g *gen) dft(x []string) []string {
+	n := len(x)
+
+	switch {
+	case n == 1:
+		return x
+	case n == 2:
+		return []string{g.assign(x[0] + " + " + x[1]), g.assign(x[0] + " - " + x[1])}
+	case n == 4:
+		return g.radix4(x)
+	case isPrime(n):
+		return g.prime(x)
+	}
+
+	r := splitRadix(n)
+	m := n / r
+
+	// Sub-transforms over the decimated sequences x[j], x[j+r], ...
+	subs := make([][]string, r)
+
+	for j := range r {
+		seq := make([]string, m)
+		for i := range m {
+			seq[i] = x[j+i*r]
+		}
+
+		subs[j] = g.dft(seq)
+	}
+
+	out := make([]string, n)
+
+	for k := range m {
+		col := make([]string, r)
+		for j := range r {
+			col[j] = g.twiddle(subs[j][k], j*k, n)
+		}
+
+		for q, v := range g.dft(col) {
+			out[k+q*m] = v
+		}
+	}
+
+	return out
+}
+
+// twiddle multiplies v by W_n^e (conjugated for the inverse).
+func (g *gen) twiddle(v string, e, n int) string {
+	e %= n
+	if e == 0 {
+		return v
+	}
+
+	turns := -e
+	if g.inverse {
+		turns = e
+	}
+
+	switch {
+	case 4*e == n: // ∓i
+		if g.inverse {
+			return g.assign(mulI(v))
+		}
+
+		return g.assign(mulNegI(v))
+	case 2*e == n:
+		return g.assign("-" + v)
+	case 4*e == 3*n: // ±i
+		if g.inverse {
+			return g.assign(mulNegI(v))
+		}
+
+		return g.assign(mulI(v))
+	}
+
+	c, sn := cosSinTurns(turns, n)
+
+	return g.assign(fmt.Sprintf("%s * complex(%s, %s)", v, lit(c), lit(sn)))
+}
+
+func (g *gen) radix4(x []string) []string {
+	a := g.assign(x[0] + " + " + x[2])
+	b := g.assign(x[0] + " - " + x[2])
+	c := g.assign(x[1] + " + " + x[3])
+	d := g.assign(x[1] + " - " + x[3])
+
+	// Forward: y1 = b - i·d, y3 = b + i·d (swapped for the inverse).
+	rotExpr := mulNegI(d)
+	if g.inverse {
+		rotExpr = mulI(d)
+	}
+
+	rot := g.assign(rotExpr)
+
+	return []string{
+		g.assign(a + " + " + c),
+		g.assign(b + " + " + rot),
+		g.assign(a + " - " + c),
+		g.assign(b + " - " + rot),
+	}
+}
+
+// prime emits an odd prime-size DFT using the symmetric pairing
+// x[j] ± x[p-j], which halves the constant multiplications.
+func (g *gen) prime(x []string) []string {
+	p := len(x)
+	h := (p - 1) / 2
+
+	sums := make([]string, h+1)
+	diffs := make([]string, h+1)
+
+	for j := 1; j <= h; j++ {
+		sums[j] = g.assign(x[j] + " + " + x[p-j])
+		diffs[j] = g.assign(x[j] + " - " + x[p-j])
+	}
+
+	out := make([]string, p)
+
+	dc := x[0]
+	for j := 1; j <= h; j++ {
+		dc += " + " + sums[j]
+	}
+
+	out[0] = g.assign(dc)
+
+	for k := 1; k <= h; k++ {
+		re, im := "real("+x[0]+")", "imag("+x[0]+")"
+		sre, sim := "", ""
+
+		for j := 1; j <= h; j++ {
+			c, s := cosSinTurns(j*k, p)
+
+			re += signedTerm(c, "real("+sums[j]+")")
+			im += signedTerm(c, "imag("+sums[j]+")")
+			sre += signedTerm(s, "real("+diffs[j]+")")
+			sim += signedTerm(s, "imag("+diffs[j]+")")
+		}
+
+		a := g.assign("complex(" + re + ", " + im + ")")
+		bb := g.assign("complex(" + strings.TrimPrefix(sre, " + ") + ", " + strings.TrimPrefix(sim, " + ") + ")")
+
+		// Forward: y[k] = a - i·b, y[p-k] = a + i·b (swapped for the inverse).
+		minus := "complex(real(" + a + ")+imag(" + bb + "), imag(" + a + ")-real(" + bb + "))"
+		plus := "complex(real(" + a + ")-imag(" + bb + "), imag(" + a + ")+real(" + bb + "))"
+
+		if g.inverse {
+			minus, plus = plus, minus
+		}
+
+		out[k] = g.assign(minus)
+		out[p-k] = g.assign(plus)
+	}
+
+	return out
+}
+
+func signedTerm(c float64, v string) string {
+	if c < 0 {
+		return " - " + lit(-c) + "*" + v
+	}
+
+	return " + " + lit(c) + "*" + v
+}
+
+func mulNegI(v string) string {
+	return "complex(imag(" + v + "), -real(" + v + "))"
+}
+
+func mulI(v string) string {
+	return "complex(-imag(" + v + "), real(" + v + "))"
+}
+
+// piDigits is π to well beyond the 256-bit working precision of cosSinTurns.
+const piDigits = "3.14159265358979323846264338327950288419716939937510582097494459230781640628620899862803482534211706798"
+
+// cosSinTurns returns cos and sin of 2π·num/den, correctly rounded to
+// float64. A 256-bit Taylor series keeps constants such as cos(2π/3) exact
+// (-0.5) instead of inheriting the rounding of math.Pi.
+func cosSinTurns(num, den int) (float64, float64) {
+	const prec = 256
+
+	num %= den
+	if num < 0 {
+		num += den
+	}
+
+	// Reduce to (-π, π] so the series converges quickly.
+	if 2*num > den {
+		num -= den
+	}
+
+	pi, _, err := big.ParseFloat(piDigits, 10, prec, big.ToNearestEven)
+	if err != nil {
+		panic(err)
+	}
+
+	x := new(big.Float).SetPrec(prec).SetInt64(int64(2 * num))
+	x.Mul(x, pi)
+	x.Quo(x, new(big.Float).SetPrec(prec).SetInt64(int64(den)))
+
+	x2 := new(big.Float).SetPrec(prec).Mul(x, x)
+	cos := new(big.Float).SetPrec(prec).SetInt64(1)
+	sin := new(big.Float).SetPrec(prec).Set(x)
+	cosTerm := new(big.Float).SetPrec(prec).SetInt64(1)
+	sinTerm := new(big.Float).SetPrec(prec).Set(x)
+
+	for k := int64(1); k < 80; k++ {
+		// cosTerm *= -x²/((2k-1)(2k)), sinTerm *= -x²/((2k)(2k+1))
+		cosTerm.Mul(cosTerm, x2)
+		cosTerm.Quo(cosTerm, new(big.Float).SetPrec(prec).SetInt64(-(2*k-1)*(2*k)))
+		cos.Add(cos, cosTerm)
+
+		sinTerm.Mul(sinTerm, x2)
+		sinTerm.Quo(sinTerm, new(big.Float).SetPrec(prec).SetInt64(-(2*k)*(2*k+1)))
+		sin.Add(sin, sinTerm)
+	}
+
+	c, _ := cos.Float64()
+	s, _ := sin.Float64()
+
+	return c, s
+}
+
+// lit formats a constant with full float64 precision, snapping values that
+// are zero up to rounding.
+func lit(v float64) string {
+	if math.Abs(v) < 1e-15 {
+		v = 0
+	}
+
+	s := strconv.FormatFloat(v, 'g', 17, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+
+	return s
+}
+
+// splitRadix picks the outermost DIT radix for a composite n.
+func splitRadix(n int) int {
+	if n%4 == 0 {
+		return 4
+	}
+
+	for r := 2; r < n; r++ {
+		if n%r == 0 {
+			return r
+		}
+	}
+
+	return n
+}
+
+// radixPlan describes the split sequence, e.g. "4x3x5".
+func radixPlan(n int) string {
+	var parts []string
+
+	for n > 1 {
+		r := n
+		if n != 2 && n != 4 && !isPrime(n) {
+			r = splitRadix(n)
+		}
+
+		parts = append(parts, strconv.Itoa(r))
+		n /= r
+	}
+
+	return strings.Join(parts, "x")
+}
+
+func isPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+
+	for d := 2; d*d <= n; d++ {
+		if n%d == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func registrationFile(sizes []int) []byte {
+	var b bytes.Buffer
+
+	b.WriteString(header)
+	b.WriteString("package kernels\n")
+
+	for _, p := range precisions {
+		bits := strings.TrimPrefix(p.suffix, "Complex")
+
+		fmt.Fprintf(&b, "\n// registerGeneratedCodelets%s registers the gencodelets %s codelets.\n", bits, p.typ)
+		fmt.Fprintf(&b, "func registerGeneratedCodelets%s() {\n", bits)
+
+		for _, n := range sizes {
+			fmt.Fprintf(&b, "\t%s.Register(CodeletEntry[%s]{\n", p.reg, p.typ)
+			fmt.Fprintf(&b, "\t\tSize: %d,\n", n)
+			fmt.Fprintf(&b, "\t\tForward: %s(%s),\n", p.wrap, kernelName("forward", n, p))
+			fmt.Fprintf(&b, "\t\tInverse: %s(%s),\n", p.wrap, kernelName("inverse", n, p))
+			b.WriteString("\t\tAlgorithm: KernelDIT,\n")
+			b.WriteString("\t\tSIMDLevel: SIMDNone,\n")
+			fmt.Fprintf(&b, "\t\tSignature: \"dit%d_gen_generic\",\n", n)
+			fmt.Fprintf(&b, "\t\tPriority: %d, // Below hand-tuned codelets of the same size\n", codeletPriority)
+			b.WriteString("\t\tBitrevFunc: nil, // Natural-order input\n")
+			b.WriteString("\t})\n")
+		}
+
+		b.WriteString("}\n")
+	}
+
+	return b.Bytes()
+}
+
+func testFile(sizes []int) []byte {
+	var b bytes.Buffer
+
+	list := make([]string, len(sizes))
+	for i, n := range sizes {
+		list[i] = strconv.Itoa(n)
+	}
+
+	b.WriteString(header)
+	b.WriteString(`package kernels
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/internal/reference"
+)
+
+type (
+	genKernel64  func(dst, src, twiddle, scratch []complex64, bitrev []int) bool
+	genKernel128 func(dst, src, twiddle, scratch []complex128, bitrev []int) bool
+)
+
+`)
+
+	b.WriteString("var generatedCodelets64 = map[int][2]genKernel64{\n")
+
+	for _, n := range sizes {
+		fmt.Fprintf(&b, "\t%d: {%s, %s},\n", n, kernelName("forward", n, precisions[0]), kernelName("inverse", n, precisions[0]))
+	}
+
+	b.WriteString("}\n\nvar generatedCodelets128 = map[int][2]genKernel128{\n")
+
+	for _, n := range sizes {
+		fmt.Fprintf(&b, "\t%d: {%s, %s},\n", n, kernelName("forward", n, precisions[1]), kernelName("inverse", n, precisions[1]))
+	}
+
+	b.WriteString("}\n")
+
+	fmt.Fprintf(&b, `
+// TestGeneratedCodeletsComplex64 checks every generated complex64 codelet
+// against the reference DFT and for an in-place round trip.
+func TestGeneratedCodeletsComplex64(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{%[1]s} {
+		kernels := generatedCodelets64[n]
+		src := randomComplex64(n, uint64(0x6E0+n))
+		dst := make([]complex64, n)
+
+		if !kernels[0](dst, src, nil, nil, nil) {
+			t.Fatalf("size %%d: forward failed", n)
+		}
+
+		assertComplex64Close(t, dst, reference.NaiveDFT(src), 1e-4)
+
+		if !kernels[1](dst, dst, nil, nil, nil) {
+			t.Fatalf("size %%d: inverse failed", n)
+		}
+
+		assertComplex64Close(t, dst, src, 1e-5)
+
+		if kernels[0](dst[:n-1], src, nil, nil, nil) {
+			t.Fatalf("size %%d: forward accepted a short dst", n)
+		}
+	}
+}
+
+// TestGeneratedCodeletsComplex128 checks every generated complex128 codelet
+// against the reference DFT and for an in-place round trip.
+func TestGeneratedCodeletsComplex128(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{%[1]s} {
+		kernels := generatedCodelets128[n]
+		src := randomComplex128(n, uint64(0x6E1+n))
+		dst := make([]complex128, n)
+
+		if !kernels[0](dst, src, nil, nil, nil) {
+			t.Fatalf("size %%d: forward failed", n)
+		}
+
+		assertComplex128Close(t, dst, reference.NaiveDFT128(src), 1e-10)
+
+		if !kernels[1](dst, dst, nil, nil, nil) {
+			t.Fatalf("size %%d: inverse failed", n)
+		}
+
+		assertComplex128Close(t, dst, src, 1e-12)
+
+		if kernels[1](dst, src[:n-1], nil, nil, nil) {
+			t.Fatalf("size %%d: inverse accepted a short src", n)
+		}
+	}
+}
+
+// TestGeneratedCodeletsRegistered checks that the generated codelets are
+// reachable through both registries.
+func TestGeneratedCodeletsRegistered(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{%[1]s} {
+		if Registry64.LookupBySignature(n, "dit"+strconv.Itoa(n)+"_gen_generic") == nil {
+			t.Errorf("size %%d: complex64 codelet not registered", n)
+		}
+
+		if Registry128.LookupBySignature(n, "dit"+strconv.Itoa(n)+"_gen_generic") == nil {
+			t.Errorf("size %%d: complex128 codelet not registered", n)
+		}
+	}
+}
+`, strings.Join(list, ", "))
+
+	return b.Bytes()
+}
@@ -4,91 +4,58 @@ import "github.com/MeKo-Christian/algo-fft/internal/fft"
 
 // Convolve computes the linear convolution of a and b using FFTs.
 // The dst slice must have length len(a)+len(b)-1.
-//
-//nolint:cyclop
 func Convolve(dst, a, b []complex64) error {
-	if dst == nil || a == nil || b == nil {
-		return ErrNilSlice
-	}
-
-	if len(a) == 0 || len(b) == 0 {
-		return ErrInvalidLength
-	}
-
-	convLen := len(a) + len(b) - 1
-	if len(dst) != convLen {
-		return ErrLengthMismatch
-	}
-
-	plan, err := NewPlanT[complex64](convLen)
-	if err != nil {
-		return err
-	}
-
-	aPadded := make([]complex64, convLen)
-	bPadded := make([]complex64, convLen)
-
-	copy(aPadded, a)
-	copy(bPadded, b)
-
-	aFreq := make([]complex64, convLen)
-	bFreq := make([]complex64, convLen)
-
-	err = plan.Forward(aFreq, aPadded)
-	if err != nil {
-		return err
-	}
-
-	err = plan.Forward(bFreq, bPadded)
-	if err != nil {
-		return err
-	}
-
-	fft.ComplexMulArrayInPlaceComplex64(aFreq, bFreq)
-
-	time := make([]complex64, convLen)
-
-	err = plan.Inverse(time, aFreq)
-	if err != nil {
-		return err
-	}
-
-	copy(dst, time)
+	return convolveComplex(dst, a, b, ConvFull)
+}
 
-	return nil
+// ConvolveWithMode computes the linear convolution of a and b and writes the
+// part selected by mode to dst. The dst slice must have length
+// ConvOutputLen(len(a), len(b), mode).
+func ConvolveWithMode(dst, a, b []complex64, mode ConvMode) error {
+	return convolveComplex(dst, a, b, mode)
 }
 
 // Convolve128 computes the linear convolution of a and b using FFTs.
 // The dst slice must have length len(a)+len(b)-1.
-//
-//nolint:cyclop
 func Convolve128(dst, a, b []complex128) error {
+	return convolveComplex(dst, a, b, ConvFull)
+}
+
+// Convolve128WithMode computes the linear convolution of a and b and writes
+// the part selected by mode to dst. The dst slice must have length
+// ConvOutputLen(len(a), len(b), mode).
+func Convolve128WithMode(dst, a, b []complex128, mode ConvMode) error {
+	return convolveComplex(dst, a, b, mode)
+}
+
+// convolveComplex transforms both inputs at a fast length covering the full
+// convolution, multiplies the spectra and copies the requested window of the
+// inverse transform straight into dst.
+func convolveComplex[T Complex](dst, a, b []T, mode ConvMode) error {
 	if dst == nil || a == nil || b == nil {
 		return ErrNilSlice
 	}
 
-	if len(a) == 0 || len(b) == 0 {
-		return ErrInvalidLength
+	convLen, outLen, err := validateConvMode(len(dst), len(a), len(b), mode)
+	if err != nil {
+		return err
 	}
 
-	convLen := len(a) + len(b) - 1
-	if len(dst) != convLen {
-		return ErrLengthMismatch
-	}
+	fftLen := NextFastLen(convLen, ComplexTransform)
 
-	plan, err := NewPlanT[complex128](convLen)
+	plan, err := NewPlanT[T](fftLen)
 	if err != nil {
 		return err
 	}
 
-	aPadded := make([]complex128, convLen)
-	bPadded := make([]complex128, convLen)
+	aPadded := make([]T, fftLen)
+	bPadded := make([]T, fftLen)
 
 	copy(aPadded, a)
 	copy(bPadded, b)
 
-	aFreq := make([]complex128, convLen)
-	bFreq := make([]complex128, convLen)
+	aFreq := make([]T, fftLen)
+	bFreq := make([]T, fftLen)
 
 	err = plan.Forward(aFreq, aPadded)
 	if err != nil {
@@ -100,16 +67,27 @@ func Convolve128(dst, a, b []complex128) error {
 		return err
 	}
 
-	fft.ComplexMulArrayInPlaceComplex128(aFreq, bFreq)
-
-	time := make([]complex128, convLen)
+	complexMulInPlace(aFreq, bFreq)
 
-	err = plan.Inverse(time, aFreq)
+	// The padded input is no longer needed and doubles as the time buffer.
+	err = plan.Inverse(aPadded, aFreq)
 	if err != nil {
 		return err
 	}
 
-	copy(dst, time)
+	start := convModeOffset(len(a), len(b), mode)
+	copy(dst, aPadded[start:start+outLen])
 
 	return nil
 }
+
+// complexMulInPlace computes dst[i] *= src[i], using the SIMD kernels for
+// the concrete precision.
+func complexMulInPlace[T Complex](dst, src []T) {
+	switch d := any(dst).(type) {
+	case []complex64:
+		fft.ComplexMulArrayInPlaceComplex64(d, any(src).([]complex64))
+	case []complex128:
+		fft.ComplexMulArrayInPlaceComplex128(d, any(src).([]complex128))
+	}
+}
@@ -0,0 +1,560 @@
+package algofft
+
+import "slices"
+
+// Boundary selects how an image is extended past its edges for 2D
+// convolution and correlation.
+type Boundary uint8
+
+const (
+	// BoundaryZero treats samples outside the image as zero
+	// (scipy.signal boundary="fill" with fillvalue 0).
+	BoundaryZero Boundary = iota
+
+	// BoundaryWrap extends the image periodically (boundary="wrap").
+	BoundaryWrap
+
+	// BoundaryReflect mirrors the image about its edges, repeating the edge
+	// sample: d c b a | a b c d | d c b a (scipy.signal boundary="symm",
+	// scipy.ndimage mode="reflect").
+	BoundaryReflect
+)
+
+// String returns the name of the boundary rule.
+func (b Boundary) String() string {
+	switch b {
+	case BoundaryZero:
+		return "zero"
+	case BoundaryWrap:
+		return "wrap"
+	case BoundaryReflect:
+		return "reflect"
+	default:
+		return "unknown"
+	}
+}
+
+// Conv2DOptions configures 2D convolution and correlation.
+type Conv2DOptions struct {
+	// Mode selects the full, same or valid output, applied per dimension
+	// like ConvOutputLen: the output is
+	// ConvOutputLen(rows, kRows, Mode) × ConvOutputLen(cols, kCols, Mode).
+	Mode ConvMode
+
+	// Boundary selects how the image is extended past its edges.
+	Boundary Boundary
+}
+
+// Convolve2D computes the 2D linear convolution of a rows×cols image with a
+// kRows×kCols kernel, both row-major, and writes the part selected by
+// opts.Mode to dst.
+func Convolve2D[T Complex](dst, img []T, rows, cols int, kernel []T, kRows, kCols int, opts Conv2DOptions) error {
+	conv, err := NewConvolver2D(rows, cols, kernel, kRows, kCols, opts)
+	if err != nil {
+		return err
+	}
+
+	return conv.Apply(dst, img)
+}
+
+// CrossCorrelate2D computes the 2D cross-correlation of a rows×cols image
+// with a kRows×kCols template, matching scipy.signal.correlate2d. Output
+// index (0, 0) of the full result corresponds to the template's bottom-right
+// sample overlapping the image's top-left sample.
+func CrossCorrelate2D[T Complex](
+	dst, img []T, rows, cols int, template []T, kRows, kCols int, opts Conv2DOptions,
+) error {
+	conv, err := NewCorrelator2D(rows, cols, template, kRows, kCols, opts)
+	if err != nil {
+		return err
+	}
+
+	return conv.Apply(dst, img)
+}
+
+// ConvolveReal2D computes the 2D linear convolution of a real rows×cols
+// image with a real kRows×kCols kernel using real FFTs.
+func ConvolveReal2D(dst, img []float32, rows, cols int, kernel []float32, kRows, kCols int, opts Conv2DOptions) error {
+	conv, err := NewRealConvolver2D[float32, complex64](rows, cols, kernel, kRows, kCols, opts)
+	if err != nil {
+		return err
+	}
+
+	return conv.Apply(dst, img)
+}
+
+// ConvolveReal2D64 is the float64 variant of ConvolveReal2D.
+func ConvolveReal2D64(dst, img []float64, rows, cols int, kernel []float64, kRows, kCols int, opts Conv2DOptions) error {
+	conv, err := NewRealConvolver2D[float64, complex128](rows, cols, kernel, kRows, kCols, opts)
+	if err != nil {
+		return err
+	}
+
+	return conv.Apply(dst, img)
+}
+
+// CrossCorrelateReal2D computes the 2D cross-correlation of a real image
+// with a real template using real FFTs, as used for template matching.
+func CrossCorrelateReal2D(
+	dst, img []float32, rows, cols int, template []float32, kRows, kCols int, opts Conv2DOptions,
+) error {
+	conv, err := NewRealCorrelator2D[float32, complex64](rows, cols, template, kRows, kCols, opts)
+	if err != nil {
+		return err
+	}
+
+	return conv.Apply(dst, img)
+}
+
+// CrossCorrelateReal2D64 is the float64 variant of CrossCorrelateReal2D.
+func CrossCorrelateReal2D64(
+	dst, img []float64, rows, cols int, template []float64, kRows, kCols int, opts Conv2DOptions,
+) error {
+	conv, err := NewRealCorrelator2D[float64, complex128](rows, cols, template, kRows, kCols, opts)
+	if err != nil {
+		return err
+	}
+
+	return conv.Apply(dst, img)
+}
+
+// Convolver2D convolves (or correlates) rows×cols images with a fixed
+// kernel. The kernel spectrum, the 2D plan and all workspace are computed
+// once, so Apply performs no allocations. A Convolver2D must not be used by
+// multiple goroutines at the same time.
+type Convolver2D[T Complex] struct {
+	geom conv2DGeometry
+
+	plan       *Plan2D[T]
+	kernelFreq []T
+	work       []T
+}
+
+// NewConvolver2D creates a Convolver2D for rows×cols images and a
+// kRows×kCols row-major kernel.
+func NewConvolver2D[T Complex](
+	rows, cols int, kernel []T, kRows, kCols int, opts Conv2DOptions,
+) (*Convolver2D[T], error) {
+	return newConvolver2D(rows, cols, kernel, kRows, kCols, opts, false)
+}
+
+// NewCorrelator2D creates a Convolver2D whose Apply computes the
+// cross-correlation with template, matching CrossCorrelate2D.
+func NewCorrelator2D[T Complex](
+	rows, cols int, template []T, kRows, kCols int, opts Conv2DOptions,
+) (*Convolver2D[T], error) {
+	return newConvolver2D(rows, cols, template, kRows, kCols, opts, true)
+}
+
+func newConvolver2D[T Complex](
+	rows, cols int, kernel []T, kRows, kCols int, opts Conv2DOptions, correlate bool,
+) (*Convolver2D[T], error) {
+	geom, err := newConv2DGeometry(rows, cols, kernel == nil, len(kernel), kRows, kCols, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := NewPlan2D[T](geom.fftRows, geom.fftCols)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Convolver2D[T]{
+		geom:       geom,
+		plan:       plan,
+		kernelFreq: make([]T, geom.fftRows*geom.fftCols),
+		work:       make([]T, geom.fftRows*geom.fftCols),
+	}
+
+	if correlate {
+		// Reversing the row-major kernel flips both dimensions.
+		flipped := make([]T, len(kernel))
+		reverseConj(flipped, kernel)
+		kernel = flipped
+	}
+
+	placeKernel2D(c.work, geom.fftCols, kernel, kRows, kCols)
+
+	err = plan.Forward(c.kernelFreq, c.work)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// OutputDims returns the dimensions of the result written by Apply.
+func (c *Convolver2D[T]) OutputDims() (rows, cols int) {
+	return c.geom.outRows, c.geom.outCols
+}
+
+// Apply convolves the row-major img with the kernel and writes the
+// OutputDims() result to dst. It does not allocate.
+func (c *Convolver2D[T]) Apply(dst, img []T) error {
+	err := c.geom.validate(len(dst), len(img), dst == nil || img == nil)
+	if err != nil {
+		return err
+	}
+
+	fillPadded2D(&c.geom, c.work, img)
+
+	err = c.plan.Forward(c.work, c.work)
+	if err != nil {
+		return err
+	}
+
+	complexMulInPlace(c.work, c.kernelFreq)
+
+	err = c.plan.Inverse(c.work, c.work)
+	if err != nil {
+		return err
+	}
+
+	extract2D(&c.geom, dst, c.work)
+
+	return nil
+}
+
+// RealConvolver2D convolves (or correlates) real rows×cols images with a
+// fixed real kernel using 2D real FFTs. Like Convolver2D it precomputes the
+// kernel spectrum and does not allocate in Apply.
+type RealConvolver2D[F Float, C Complex] struct {
+	geom conv2DGeometry
+
+	plan       real2DPlan[F, C]
+	kernelFreq []C
+	freq       []C
+	work       []F
+}
+
+// NewRealConvolver2D creates a RealConvolver2D for rows×cols images and a
+// kRows×kCols row-major kernel. The complex type C must match F
+// (float32→complex64, float64→complex128).
+func NewRealConvolver2D[F Float, C Complex](
+	rows, cols int, kernel []F, kRows, kCols int, opts Conv2DOptions,
+) (*RealConvolver2D[F, C], error) {
+	return newRealConvolver2D[F, C](rows, cols, kernel, kRows, kCols, opts, false)
+}
+
+// NewRealCorrelator2D creates a RealConvolver2D whose Apply computes the
+// cross-correlation with template, matching CrossCorrelateReal2D.
+func NewRealCorrelator2D[F Float, C Complex](
+	rows, cols int, template []F, kRows, kCols int, opts Conv2DOptions,
+) (*RealConvolver2D[F, C], error) {
+	return newRealConvolver2D[F, C](rows, cols, template, kRows, kCols, opts, true)
+}
+
+func newRealConvolver2D[F Float, C Complex](
+	rows, cols int, kernel []F, kRows, kCols int, opts Conv2DOptions, correlate bool,
+) (*RealConvolver2D[F, C], error) {
+	geom, err := newConv2DGeometry(rows, cols, kernel == nil, len(kernel), kRows, kCols, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := newReal2DPlan[F, C](geom.fftRows, geom.fftCols)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &RealConvolver2D[F, C]{
+		geom:       geom,
+		plan:       plan,
+		kernelFreq: make([]C, plan.SpectrumLen()),
+		freq:       make([]C, plan.SpectrumLen()),
+		work:       make([]F, geom.fftRows*geom.fftCols),
+	}
+
+	if correlate {
+		kernel = slices.Clone(kernel)
+		slices.Reverse(kernel)
+	}
+
+	placeKernel2D(c.work, geom.fftCols, kernel, kRows, kCols)
+
+	err = plan.Forward(c.kernelFreq, c.work)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// OutputDims returns the dimensions of the result written by Apply.
+func (c *RealConvolver2D[F, C]) OutputDims() (rows, cols int) {
+	return c.geom.outRows, c.geom.outCols
+}
+
+// Apply convolves the row-major img with the kernel and writes the
+// OutputDims() result to dst. It does not allocate.
+func (c *RealConvolver2D[F, C]) Apply(dst, img []F) error {
+	err := c.geom.validate(len(dst), len(img), dst == nil || img == nil)
+	if err != nil {
+		return err
+	}
+
+	fillPadded2D(&c.geom, c.work, img)
+
+	err = c.plan.Forward(c.freq, c.work)
+	if err != nil {
+		return err
+	}
+
+	complexMulInPlace(c.freq, c.kernelFreq)
+
+	err = c.plan.Inverse(c.work, c.freq)
+	if err != nil {
+		return err
+	}
+
+	extract2D(&c.geom, dst, c.work)
+
+	return nil
+}
+
+// conv2DGeometry describes how an image is padded into the FFT buffer and
+// where the requested output lies in the result.
+//
+// For wrap and reflect boundaries the image is extended by kRows-1 rows and
+// kCols-1 columns on every side before a zero-padded linear convolution;
+// full-mode output (i, j) is then result (i+padRows, j+padCols).
+type conv2DGeometry struct {
+	rows, cols       int
+	boundary         Boundary
+	padRows, padCols int
+	fftRows, fftCols int
+	outRows, outCols int
+	offRows, offCols int
+}
+
+func newConv2DGeometry(rows, cols int, kernelNil bool, kernelLen, kRows, kCols int, opts Conv2DOptions) (conv2DGeometry, error) {
+	if kernelNil {
+		return conv2DGeometry{}, ErrNilSlice
+	}
+
+	if opts.Mode > ConvValid || opts.Boundary > BoundaryReflect {
+		return conv2DGeometry{}, ErrInvalidMode
+	}
+
+	if rows < 1 || cols < 1 || kRows < 1 || kCols < 1 {
+		return conv2DGeometry{}, ErrInvalidLength
+	}
+
+	if kernelLen != kRows*kCols {
+		return conv2DGeometry{}, ErrLengthMismatch
+	}
+
+	g := conv2DGeometry{
+		rows:     rows,
+		cols:     cols,
+		boundary: opts.Boundary,
+		outRows:  ConvOutputLen(rows, kRows, opts.Mode),
+		outCols:  ConvOutputLen(cols, kCols, opts.Mode),
+	}
+
+	if opts.Boundary != BoundaryZero {
+		g.padRows = kRows - 1
+		g.padCols = kCols - 1
+	}
+
+	// Real 2D plans need an even column count; the row count is a complex
+	// column transform either way.
+	g.fftRows = NextFastLen(rows+2*g.padRows+kRows-1, ComplexTransform)
+	g.fftCols = NextFastLen(cols+2*g.padCols+kCols-1, RealTransform)
+	g.offRows = g.padRows + convModeOffset(rows, kRows, opts.Mode)
+	g.offCols = g.padCols + convModeOffset(cols, kCols, opts.Mode)
+
+	return g, nil
+}
+
+func (g *conv2DGeometry) validate(dstLen, imgLen int, isNil bool) error {
+	if isNil {
+		return ErrNilSlice
+	}
+
+	if imgLen != g.rows*g.cols || dstLen != g.outRows*g.outCols {
+		return ErrLengthMismatch
+	}
+
+	return nil
+}
+
+// fillPadded2D writes the boundary-extended image into the top-left corner
+// of the fftRows×fftCols buffer and zeros the rest.
+func fillPadded2D[S Scalar](g *conv2DGeometry, buf, img []S) {
+	clear(buf)
+
+	paddedRows := g.rows + 2*g.padRows
+	paddedCols := g.cols + 2*g.padCols
+
+	for r := range paddedRows {
+		srcRow := img[boundaryIndex(r-g.padRows, g.rows, g.boundary)*g.cols:]
+		dstRow := buf[r*g.fftCols : r*g.fftCols+paddedCols]
+
+		if g.padCols == 0 {
+			copy(dstRow, srcRow[:g.cols])
+			continue
+		}
+
+		for c := range dstRow {
+			dstRow[c] = srcRow[boundaryIndex(c-g.padCols, g.cols, g.boundary)]
+		}
+	}
+}
+
+// extract2D copies the requested output window out of the FFT buffer.
+func extract2D[S Scalar](g *conv2DGeometry, dst, buf []S) {
+	for r := range g.outRows {
+		src := buf[(g.offRows+r)*g.fftCols+g.offCols:]
+		copy(dst[r*g.outCols:(r+1)*g.outCols], src[:g.outCols])
+	}
+}
+
+// boundaryIndex maps an index outside [0, n) back into the image according
+// to the boundary rule. Zero boundaries never pad, so i is always in range.
+func boundaryIndex(i, n int, b Boundary) int {
+	switch b {
+	case BoundaryWrap:
+		i %= n
+		if i < 0 {
+			i += n
+		}
+	case BoundaryReflect:
+		period := 2 * n
+
+		i %= period
+		if i < 0 {
+			i += period
+		}
+
+		if i >= n {
+			i = period - 1 - i
+		}
+	}
+
+	return i
+}
+
+// placeKernel2D zeros buf and copies the kRows×kCols kernel into its
+// top-left corner.
+func placeKernel2D[S Scalar](buf []S, fftCols int, kernel []S, kRows, kCols int) {
+	clear(buf)
+
+	for r := range kRows {
+		copy(buf[r*fftCols:r*fftCols+kCols], kernel[r*kCols:(r+1)*kCols])
+	}
+}
+
+// real2DPlan is the part of a 2D real FFT plan used by RealConvolver2D.
+type real2DPlan[F Float, C Complex] interface {
+	Forward(dst []C, src []F) error
+	Inverse(dst []F, src []C) error
+	SpectrumLen() int
+}
+
+// newReal2DPlan returns PlanReal2D for float32 data and a row-column plan
+// built from PlanRealT and Plan for float64 data.
+func newReal2DPlan[F Float, C Complex](rows, cols int) (real2DPlan[F, C], error) {
+	var zero F
+
+	if _, ok := any(zero).(float32); ok {
+		plan, err := NewPlanReal2D(rows, cols)
+		if err != nil {
+			return nil, err
+		}
+
+		if p, ok := any(plan).(real2DPlan[F, C]); ok {
+			return p, nil
+		}
+	}
+
+	return newRowColumnReal2D[F, C](rows, cols)
+}
+
+// rowColumnReal2D is a compact-spectrum 2D real FFT: real FFTs along rows,
+// then complex FFTs along the cols/2+1 spectrum columns.
+type rowColumnReal2D[F Float, C Complex] struct {
+	rows, cols, halfCols int
+
+	rowPlan *PlanRealT[F, C]
+	colPlan *Plan[C]
+	column  []C
+}
+
+func newRowColumnReal2D[F Float, C Complex](rows, cols int) (*rowColumnReal2D[F, C], error) {
+	rowPlan, err := NewPlanRealT[F, C](cols)
+	if err != nil {
+		return nil, err
+	}
+
+	colPlan, err := NewPlanT[C](rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rowColumnReal2D[F, C]{
+		rows:     rows,
+		cols:     cols,
+		halfCols: rowPlan.SpectrumLen(),
+		rowPlan:  rowPlan,
+		colPlan:  colPlan,
+		column:   make([]C, rows),
+	}, nil
+}
+
+func (p *rowColumnReal2D[F, C]) SpectrumLen() int {
+	return p.rows * p.halfCols
+}
+
+func (p *rowColumnReal2D[F, C]) Forward(dst []C, src []F) error {
+	for r := range p.rows {
+		err := p.rowPlan.Forward(dst[r*p.halfCols:(r+1)*p.halfCols], src[r*p.cols:(r+1)*p.cols])
+		if err != nil {
+			return err
+		}
+	}
+
+	return p.transformColumns(dst, false)
+}
+
+// Inverse overwrites src with intermediate results.
+func (p *rowColumnReal2D[F, C]) Inverse(dst []F, src []C) error {
+	err := p.transformColumns(src, true)
+	if err != nil {
+		return err
+	}
+
+	for r := range p.rows {
+		err := p.rowPlan.Inverse(dst[r*p.cols:(r+1)*p.cols], src[r*p.halfCols:(r+1)*p.halfCols])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *rowColumnReal2D[F, C]) transformColumns(data []C, inverse bool) error {
+	for c := range p.halfCols {
+		for r := range p.rows {
+			p.column[r] = data[r*p.halfCols+c]
+		}
+
+		var err error
+		if inverse {
+			err = p.colPlan.InverseInPlace(p.column)
+		} else {
+			err = p.colPlan.InPlace(p.column)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for r := range p.rows {
+			data[r*p.halfCols+c] = p.column[r]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,298 @@
+package algofft
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+// naiveConvolve2D computes a 2D convolution with boundary extension directly
+// from the definition, then trims it to the requested mode.
+func naiveConvolve2D(img []complex128, rows, cols int, kernel []complex128, kRows, kCols int, opts Conv2DOptions) []complex128 {
+	fullRows, fullCols := rows+kRows-1, cols+kCols-1
+	full := make([]complex128, fullRows*fullCols)
+
+	for i := range fullRows {
+		for j := range fullCols {
+			var sum complex128
+
+			for k := range kRows {
+				for l := range kCols {
+					r, c := i-k, j-l
+					if opts.Boundary == BoundaryZero && (r < 0 || r >= rows || c < 0 || c >= cols) {
+						continue
+					}
+
+					r = naiveBoundaryIndex(r, rows, opts.Boundary)
+					c = naiveBoundaryIndex(c, cols, opts.Boundary)
+					sum += img[r*cols+c] * kernel[k*kCols+l]
+				}
+			}
+
+			full[i*fullCols+j] = sum
+		}
+	}
+
+	outRows := ConvOutputLen(rows, kRows, opts.Mode)
+	outCols := ConvOutputLen(cols, kCols, opts.Mode)
+	offRows := convModeOffset(rows, kRows, opts.Mode)
+	offCols := convModeOffset(cols, kCols, opts.Mode)
+
+	out := make([]complex128, outRows*outCols)
+	for r := range outRows {
+		copy(out[r*outCols:(r+1)*outCols], full[(offRows+r)*fullCols+offCols:])
+	}
+
+	return out
+}
+
+// naiveBoundaryIndex folds an out-of-range index back into [0, n) one
+// period or one mirror at a time, independently of boundaryIndex.
+func naiveBoundaryIndex(i, n int, b Boundary) int {
+	for i < 0 || i >= n {
+		switch {
+		case b == BoundaryWrap && i < 0:
+			i += n
+		case b == BoundaryWrap:
+			i -= n
+		case i < 0:
+			// Mirror about the edge between samples -1 and 0.
+			i = -1 - i
+		default:
+			// Mirror about the edge between samples n-1 and n.
+			i = 2*n - 1 - i
+		}
+	}
+
+	return i
+}
+
+func TestBoundaryIndexKnownValues(t *testing.T) {
+	t.Parallel()
+
+	// Indices -4..6 for n = 3, as numpy.pad(mode="wrap") and
+	// numpy.pad(mode="symmetric") extend [0, 1, 2].
+	want := map[Boundary][]int{
+		BoundaryWrap:    {2, 0, 1, 2, 0, 1, 2, 0, 1, 2, 0},
+		BoundaryReflect: {2, 2, 1, 0, 0, 1, 2, 2, 1, 0, 0},
+	}
+
+	for b, indices := range want {
+		for k, w := range indices {
+			i := k - 4
+			if got := boundaryIndex(i, 3, b); got != w {
+				t.Fatalf("boundaryIndex(%d, 3, %v) = %d, want %d", i, b, got, w)
+			}
+
+			if got := naiveBoundaryIndex(i, 3, b); got != w {
+				t.Fatalf("naiveBoundaryIndex(%d, 3, %v) = %d, want %d", i, b, got, w)
+			}
+		}
+	}
+}
+
+func allConv2DOptions() []Conv2DOptions {
+	var all []Conv2DOptions
+
+	for _, mode := range []ConvMode{ConvFull, ConvSame, ConvValid} {
+		for _, boundary := range []Boundary{BoundaryZero, BoundaryWrap, BoundaryReflect} {
+			all = append(all, Conv2DOptions{Mode: mode, Boundary: boundary})
+		}
+	}
+
+	return all
+}
+
+func TestConvolve2DMatchesNaive(t *testing.T) {
+	t.Parallel()
+
+	const rows, cols, kRows, kCols = 9, 12, 4, 3
+
+	rng := rand.New(rand.NewSource(35))
+	img := make([]complex128, rows*cols)
+	kernel := make([]complex128, kRows*kCols)
+
+	for i := range img {
+		img[i] = complex(rng.Float64()*2-1, rng.Float64()*2-1)
+	}
+
+	for i := range kernel {
+		kernel[i] = complex(rng.Float64()*2-1, rng.Float64()*2-1)
+	}
+
+	for _, opts := range allConv2DOptions() {
+		want := naiveConvolve2D(img, rows, cols, kernel, kRows, kCols, opts)
+		got := make([]complex128, len(want))
+
+		err := Convolve2D(got, img, rows, cols, kernel, kRows, kCols, opts)
+		if err != nil {
+			t.Fatalf("Convolve2D(%v, %v) returned error: %v", opts.Mode, opts.Boundary, err)
+		}
+
+		for i := range want {
+			assertApproxComplex128Tolf(t, got[i], want[i], 1e-10, "%v/%v got[%d]", opts.Mode, opts.Boundary, i)
+		}
+	}
+}
+
+func TestConvolveReal2DMatchesNaive(t *testing.T) {
+	t.Parallel()
+
+	const rows, cols, kRows, kCols = 7, 10, 3, 5
+
+	rng := rand.New(rand.NewSource(36))
+	img := randomFloat64s(rng, rows*cols)
+	kernel := randomFloat64s(rng, kRows*kCols)
+
+	for _, opts := range allConv2DOptions() {
+		want := naiveConvolve2D(toComplex128(img), rows, cols, toComplex128(kernel), kRows, kCols, opts)
+
+		got64 := make([]float64, len(want))
+
+		err := ConvolveReal2D64(got64, img, rows, cols, kernel, kRows, kCols, opts)
+		if err != nil {
+			t.Fatalf("ConvolveReal2D64(%v, %v) returned error: %v", opts.Mode, opts.Boundary, err)
+		}
+
+		got32 := make([]float32, len(want))
+
+		err = ConvolveReal2D(got32, toFloat32(img), rows, cols, toFloat32(kernel), kRows, kCols, opts)
+		if err != nil {
+			t.Fatalf("ConvolveReal2D(%v, %v) returned error: %v", opts.Mode, opts.Boundary, err)
+		}
+
+		for i := range want {
+			assertApproxFloat64(t, got64[i], real(want[i]), 1e-10, "%v/%v float64 got[%d]", opts.Mode, opts.Boundary, i)
+			assertApproxFloat64(t, float64(got32[i]), real(want[i]), 1e-4, "%v/%v float32 got[%d]", opts.Mode, opts.Boundary, i)
+		}
+	}
+}
+
+func TestCrossCorrelateReal2DFindsTemplate(t *testing.T) {
+	t.Parallel()
+
+	const rows, cols, kRows, kCols = 20, 24, 4, 5
+
+	rng := rand.New(rand.NewSource(37))
+	img := make([]float64, rows*cols)
+	template := randomFloat64s(rng, kRows*kCols)
+
+	// Plant the template at (11, 6) in an otherwise empty image.
+	const r0, c0 = 11, 6
+	for r := range kRows {
+		copy(img[(r0+r)*cols+c0:], template[r*kCols:(r+1)*kCols])
+	}
+
+	opts := Conv2DOptions{Mode: ConvValid}
+	outRows, outCols := ConvOutputLen(rows, kRows, ConvValid), ConvOutputLen(cols, kCols, ConvValid)
+	got := make([]float64, outRows*outCols)
+
+	err := CrossCorrelateReal2D64(got, img, rows, cols, template, kRows, kCols, opts)
+	if err != nil {
+		t.Fatalf("CrossCorrelateReal2D64() returned error: %v", err)
+	}
+
+	best := 0
+	for i := range got {
+		if got[i] > got[best] {
+			best = i
+		}
+	}
+
+	if best/outCols != r0 || best%outCols != c0 {
+		t.Fatalf("correlation peak at (%d, %d), want (%d, %d)", best/outCols, best%outCols, r0, c0)
+	}
+
+	// The complex correlation of the same data must agree.
+	gotComplex := make([]complex128, len(got))
+
+	err = CrossCorrelate2D(gotComplex, toComplex128(img), rows, cols, toComplex128(template), kRows, kCols, opts)
+	if err != nil {
+		t.Fatalf("CrossCorrelate2D() returned error: %v", err)
+	}
+
+	for i := range got {
+		assertApproxComplex128Tolf(t, gotComplex[i], complex(got[i], 0), 1e-10, "complex got[%d]", i)
+	}
+}
+
+func TestConvolve2DErrors(t *testing.T) {
+	t.Parallel()
+
+	img := make([]float32, 12)
+	kernel := make([]float32, 4)
+
+	err := ConvolveReal2D(make([]float32, 12), img, 3, 4, kernel, 2, 3, Conv2DOptions{Mode: ConvSame})
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("kernel size mismatch error = %v, want ErrLengthMismatch", err)
+	}
+
+	err = ConvolveReal2D(make([]float32, 12), img, 3, 4, kernel, 2, 2, Conv2DOptions{Boundary: Boundary(9)})
+	if !errors.Is(err, ErrInvalidMode) {
+		t.Fatalf("invalid boundary error = %v, want ErrInvalidMode", err)
+	}
+
+	err = ConvolveReal2D(make([]float32, 11), img, 3, 4, kernel, 2, 2, Conv2DOptions{Mode: ConvSame})
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("dst size mismatch error = %v, want ErrLengthMismatch", err)
+	}
+
+	_, err = NewConvolver2D[complex64](3, 4, nil, 2, 2, Conv2DOptions{})
+	if !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil kernel error = %v, want ErrNilSlice", err)
+	}
+
+	if got := BoundaryReflect.String(); got != "reflect" {
+		t.Fatalf("BoundaryReflect.String() = %q, want reflect", got)
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestConvolver2DApplyNoAllocs(t *testing.T) {
+	const rows, cols = 32, 40
+
+	kernel := make([]float32, 5*5)
+	for i := range kernel {
+		kernel[i] = 1.0 / 25
+	}
+
+	opts := Conv2DOptions{Mode: ConvSame, Boundary: BoundaryReflect}
+
+	conv, err := NewRealConvolver2D[float32, complex64](rows, cols, kernel, 5, 5, opts)
+	if err != nil {
+		t.Fatalf("NewRealConvolver2D() returned error: %v", err)
+	}
+
+	outRows, outCols := conv.OutputDims()
+	if outRows != rows || outCols != cols {
+		t.Fatalf("OutputDims() = %dx%d, want %dx%d", outRows, outCols, rows, cols)
+	}
+
+	img := make([]float32, rows*cols)
+	dst := make([]float32, rows*cols)
+
+	assertNoAllocs(t, "RealConvolver2D.Apply", func() error {
+		return conv.Apply(dst, img)
+	})
+
+	complexConv, err := NewConvolver2D(rows, cols, toComplex128(toFloat64s(kernel)), 5, 5, opts)
+	if err != nil {
+		t.Fatalf("NewConvolver2D() returned error: %v", err)
+	}
+
+	complexImg := make([]complex128, rows*cols)
+	complexDst := make([]complex128, rows*cols)
+
+	assertNoAllocs(t, "Convolver2D.Apply", func() error {
+		return complexConv.Apply(complexDst, complexImg)
+	})
+}
+
+func toFloat64s(x []float32) []float64 {
+	out := make([]float64, len(x))
+	for i, v := range x {
+		out[i] = float64(v)
+	}
+
+	return out
+}
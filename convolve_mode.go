@@ -0,0 +1,85 @@
+package algofft
+
+// ConvMode selects which part of a linear convolution or correlation is
+// returned. The modes follow numpy.convolve and scipy.signal.convolve.
+type ConvMode uint8
+
+const (
+	// ConvFull returns the complete result of len(a)+len(b)-1 samples.
+	ConvFull ConvMode = iota
+
+	// ConvSame returns len(a) samples centred on the full result, matching
+	// scipy.signal.convolve(a, b, mode="same"). This equals numpy's "same"
+	// mode whenever len(a) >= len(b).
+	ConvSame
+
+	// ConvValid returns only the samples computed without zero padding:
+	// max(len(a), len(b)) - min(len(a), len(b)) + 1 samples.
+	ConvValid
+)
+
+// String returns the NumPy/SciPy name of the mode.
+func (m ConvMode) String() string {
+	switch m {
+	case ConvFull:
+		return "full"
+	case ConvSame:
+		return "same"
+	case ConvValid:
+		return "valid"
+	default:
+		return "unknown"
+	}
+}
+
+// ConvOutputLen returns the output length of a convolution or correlation of
+// inputs with lengths lenA and lenB in the given mode. It returns 0 if either
+// length is not positive or the mode is unknown.
+func ConvOutputLen(lenA, lenB int, mode ConvMode) int {
+	if lenA <= 0 || lenB <= 0 {
+		return 0
+	}
+
+	switch mode {
+	case ConvFull:
+		return lenA + lenB - 1
+	case ConvSame:
+		return lenA
+	case ConvValid:
+		return max(lenA, lenB) - min(lenA, lenB) + 1
+	default:
+		return 0
+	}
+}
+
+// convModeOffset returns the index into the full result at which the output
+// of the given mode starts.
+func convModeOffset(lenA, lenB int, mode ConvMode) int {
+	switch mode {
+	case ConvSame:
+		return (lenB - 1) / 2
+	case ConvValid:
+		return min(lenA, lenB) - 1
+	default:
+		return 0
+	}
+}
+
+// validateConvMode checks the arguments shared by all mode-aware convolution
+// and correlation helpers and returns the full and trimmed output lengths.
+func validateConvMode(dstLen, lenA, lenB int, mode ConvMode) (int, int, error) {
+	if mode > ConvValid {
+		return 0, 0, ErrInvalidMode
+	}
+
+	if lenA == 0 || lenB == 0 {
+		return 0, 0, ErrInvalidLength
+	}
+
+	outLen := ConvOutputLen(lenA, lenB, mode)
+	if dstLen != outLen {
+		return 0, 0, ErrLengthMismatch
+	}
+
+	return lenA + lenB - 1, outLen, nil
+}
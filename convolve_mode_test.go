@@ -0,0 +1,178 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// Expected values computed with numpy.convolve / scipy.signal.convolve.
+var convModeCases = []struct {
+	name string
+	a, b []float64
+	mode ConvMode
+	want []float64
+}{
+	{"full", []float64{1, 2, 3, 4, 5}, []float64{1, 0, -1}, ConvFull, []float64{1, 2, 2, 2, 2, -4, -5}},
+	{"same", []float64{1, 2, 3, 4, 5}, []float64{1, 0, -1}, ConvSame, []float64{2, 2, 2, 2, -4}},
+	{"valid", []float64{1, 2, 3, 4, 5}, []float64{1, 0, -1}, ConvValid, []float64{2, 2, 2}},
+	{"same-even-kernel", []float64{1, 2, 3, 4}, []float64{1, 1}, ConvSame, []float64{1, 3, 5, 7}},
+	{"valid-even-kernel", []float64{1, 2, 3, 4}, []float64{1, 1}, ConvValid, []float64{3, 5, 7}},
+	{"same-short-a", []float64{1, 2}, []float64{1, 1, 1, 1}, ConvSame, []float64{3, 3}},
+	{"valid-short-a", []float64{1, 2}, []float64{1, 1, 1, 1}, ConvValid, []float64{3, 3, 3}},
+}
+
+func TestConvolveWithModeMatchesNumPy(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range convModeCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ConvOutputLen(len(tc.a), len(tc.b), tc.mode); got != len(tc.want) {
+				t.Fatalf("ConvOutputLen() = %d, want %d", got, len(tc.want))
+			}
+
+			a64, b64 := toComplex64(tc.a), toComplex64(tc.b)
+			got64 := make([]complex64, len(tc.want))
+
+			err := ConvolveWithMode(got64, a64, b64, tc.mode)
+			if err != nil {
+				t.Fatalf("ConvolveWithMode() returned error: %v", err)
+			}
+
+			a128, b128 := toComplex128(tc.a), toComplex128(tc.b)
+			got128 := make([]complex128, len(tc.want))
+
+			err = Convolve128WithMode(got128, a128, b128, tc.mode)
+			if err != nil {
+				t.Fatalf("Convolve128WithMode() returned error: %v", err)
+			}
+
+			gotReal := make([]float32, len(tc.want))
+
+			err = ConvolveRealWithMode(gotReal, toFloat32(tc.a), toFloat32(tc.b), tc.mode)
+			if err != nil {
+				t.Fatalf("ConvolveRealWithMode() returned error: %v", err)
+			}
+
+			conv, err := NewRealConvolver[float32, complex64](toFloat32(tc.b), len(tc.a))
+			if err != nil {
+				t.Fatalf("NewRealConvolver() returned error: %v", err)
+			}
+
+			gotConv := make([]float32, len(tc.want))
+
+			err = conv.ApplyWithMode(gotConv, toFloat32(tc.a), tc.mode)
+			if err != nil {
+				t.Fatalf("ApplyWithMode() returned error: %v", err)
+			}
+
+			for i, w := range tc.want {
+				assertApproxComplex64f(t, got64[i], complex(float32(w), 0), 1e-4, "complex64 got[%d]", i)
+				assertApproxComplex128Tolf(t, got128[i], complex(w, 0), 1e-10, "complex128 got[%d]", i)
+
+				if math.Abs(float64(gotReal[i])-w) > 1e-4 {
+					t.Fatalf("real got[%d]=%v want %v", i, gotReal[i], w)
+				}
+
+				if math.Abs(float64(gotConv[i])-w) > 1e-4 {
+					t.Fatalf("convolver got[%d]=%v want %v", i, gotConv[i], w)
+				}
+			}
+		})
+	}
+}
+
+func TestCrossCorrelateWithModeMatchesSciPy(t *testing.T) {
+	t.Parallel()
+
+	// scipy.signal.correlate([1, 2, 3], [0, 1, 0.5], mode)
+	a := []complex128{1, 2, 3}
+	b := []complex128{0, 1, 0.5}
+
+	tests := []struct {
+		mode ConvMode
+		want []complex128
+	}{
+		{ConvFull, []complex128{0.5, 2, 3.5, 3, 0}},
+		{ConvSame, []complex128{2, 3.5, 3}},
+		{ConvValid, []complex128{3.5}},
+	}
+
+	for _, tc := range tests {
+		got := make([]complex128, len(tc.want))
+
+		err := CrossCorrelate128WithMode(got, a, b, tc.mode)
+		if err != nil {
+			t.Fatalf("CrossCorrelate128WithMode(%v) returned error: %v", tc.mode, err)
+		}
+
+		for i := range tc.want {
+			assertApproxComplex128Tolf(t, got[i], tc.want[i], 1e-12, "%v got[%d]", tc.mode, i)
+		}
+
+		got64 := make([]complex64, len(tc.want))
+
+		err = CrossCorrelateWithMode(got64, toComplex64([]float64{1, 2, 3}), toComplex64([]float64{0, 1, 0.5}), tc.mode)
+		if err != nil {
+			t.Fatalf("CrossCorrelateWithMode(%v) returned error: %v", tc.mode, err)
+		}
+
+		for i := range tc.want {
+			assertApproxComplex64f(t, got64[i], complex64(tc.want[i]), 1e-5, "%v got[%d]", tc.mode, i)
+		}
+	}
+}
+
+func TestConvolveWithModeErrors(t *testing.T) {
+	t.Parallel()
+
+	a := []complex64{1, 2, 3}
+	b := []complex64{1, 1}
+
+	err := ConvolveWithMode(make([]complex64, 3), a, b, ConvMode(7))
+	if !errors.Is(err, ErrInvalidMode) {
+		t.Fatalf("invalid mode error = %v, want ErrInvalidMode", err)
+	}
+
+	err = ConvolveWithMode(make([]complex64, 4), a, b, ConvSame)
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("same with full-size dst error = %v, want ErrLengthMismatch", err)
+	}
+
+	if got := ConvMode(7).String(); got != "unknown" {
+		t.Fatalf("ConvMode(7).String() = %q, want unknown", got)
+	}
+
+	if got := ConvValid.String(); got != "valid" {
+		t.Fatalf("ConvValid.String() = %q, want valid", got)
+	}
+}
+
+func toComplex64(x []float64) []complex64 {
+	out := make([]complex64, len(x))
+	for i, v := range x {
+		out[i] = complex(float32(v), 0)
+	}
+
+	return out
+}
+
+func toComplex128(x []float64) []complex128 {
+	out := make([]complex128, len(x))
+	for i, v := range x {
+		out[i] = complex(v, 0)
+	}
+
+	return out
+}
+
+func toFloat32(x []float64) []float32 {
+	out := make([]float32, len(x))
+	for i, v := range x {
+		out[i] = float32(v)
+	}
+
+	return out
+}
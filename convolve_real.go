@@ -1,43 +1,54 @@
 package algofft
 
-import m "github.com/MeKo-Christian/algo-fft/internal/math"
-
 // ConvolveReal computes the linear convolution of a and b using real FFTs.
 // The dst slice must have length len(a)+len(b)-1.
-//
-//nolint:cyclop
 func ConvolveReal(dst, a, b []float32) error {
+	return convolveReal[float32, complex64](dst, a, b, ConvFull)
+}
+
+// ConvolveRealWithMode computes the linear convolution of a and b using real
+// FFTs and writes the part selected by mode to dst. The dst slice must have
+// length ConvOutputLen(len(a), len(b), mode).
+func ConvolveRealWithMode(dst, a, b []float32, mode ConvMode) error {
+	return convolveReal[float32, complex64](dst, a, b, mode)
+}
+
+// ConvolveReal64 computes the linear convolution of a and b using float64
+// real FFTs. The dst slice must have length len(a)+len(b)-1.
+func ConvolveReal64(dst, a, b []float64) error {
+	return convolveReal[float64, complex128](dst, a, b, ConvFull)
+}
+
+// ConvolveReal64WithMode is the float64 variant of ConvolveRealWithMode.
+func ConvolveReal64WithMode(dst, a, b []float64, mode ConvMode) error {
+	return convolveReal[float64, complex128](dst, a, b, mode)
+}
+
+func convolveReal[F Float, C Complex](dst, a, b []F, mode ConvMode) error {
 	if dst == nil || a == nil || b == nil {
 		return ErrNilSlice
 	}
 
-	if len(a) == 0 || len(b) == 0 {
-		return ErrInvalidLength
-	}
-
-	convLen := len(a) + len(b) - 1
-	if len(dst) != convLen {
-		return ErrLengthMismatch
+	convLen, outLen, err := validateConvMode(len(dst), len(a), len(b), mode)
+	if err != nil {
+		return err
 	}
 
-	fftLen := m.NextPowerOfTwo(convLen)
-	if fftLen < 2 {
-		fftLen = 2
-	}
+	fftLen := NextFastLen(convLen, RealTransform)
 
-	plan, err := NewPlanReal(fftLen)
+	plan, err := NewPlanRealT[F, C](fftLen)
 	if err != nil {
 		return err
 	}
 
-	aPadded := make([]float32, fftLen)
-	bPadded := make([]float32, fftLen)
+	aPadded := make([]F, fftLen)
+	bPadded := make([]F, fftLen)
 
 	copy(aPadded, a)
 	copy(bPadded, b)
 
-	aFreq := make([]complex64, plan.SpectrumLen())
-	bFreq := make([]complex64, plan.SpectrumLen())
+	aFreq := make([]C, plan.SpectrumLen())
+	bFreq := make([]C, plan.SpectrumLen())
 
 	err = plan.Forward(aFreq, aPadded)
 	if err != nil {
@@ -49,18 +60,16 @@ func ConvolveReal(dst, a, b []float32) error {
 		return err
 	}
 
-	for i := range aFreq {
-		aFreq[i] *= bFreq[i]
-	}
-
-	time := make([]float32, fftLen)
+	complexMulInPlace(aFreq, bFreq)
 
-	err = plan.Inverse(time, aFreq)
+	// The padded input is no longer needed and doubles as the time buffer.
+	err = plan.Inverse(aPadded, aFreq)
 	if err != nil {
 		return err
 	}
 
-	copy(dst, time[:convLen])
+	start := convModeOffset(len(a), len(b), mode)
+	copy(dst, aPadded[start:start+outLen])
 
 	return nil
 }
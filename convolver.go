@@ -0,0 +1,226 @@
+package algofft
+
+// Convolver convolves signals against a fixed kernel using FFTs.
+//
+// The kernel spectrum, the FFT plan and all workspace are computed once at
+// construction, so Apply performs no allocations. A Convolver is the reusable
+// counterpart of Convolve/Convolve128 for convolving many signals against the
+// same kernel.
+//
+// A Convolver owns mutable workspace and must not be used by multiple
+// goroutines at the same time; create one Convolver per goroutine instead.
+type Convolver[T Complex] struct {
+	kernelLen    int
+	maxSignalLen int
+
+	plan       *Plan[T]
+	kernelFreq []T
+	work       []T
+	freq       []T
+}
+
+// NewConvolver creates a Convolver for kernel that accepts signals of up to
+// maxSignalLen samples. The kernel is copied; later changes to the slice do
+// not affect the Convolver.
+func NewConvolver[T Complex](kernel []T, maxSignalLen int) (*Convolver[T], error) {
+	if kernel == nil {
+		return nil, ErrNilSlice
+	}
+
+	if len(kernel) == 0 || maxSignalLen < 1 {
+		return nil, ErrInvalidLength
+	}
+
+	fftLen := NextFastLen(len(kernel)+maxSignalLen-1, ComplexTransform)
+
+	plan, err := NewPlanT[T](fftLen)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Convolver[T]{
+		kernelLen:    len(kernel),
+		maxSignalLen: maxSignalLen,
+		plan:         plan,
+		kernelFreq:   make([]T, fftLen),
+		work:         make([]T, fftLen),
+		freq:         make([]T, fftLen),
+	}
+
+	copy(c.work, kernel)
+
+	err = plan.Forward(c.kernelFreq, c.work)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// KernelLen returns the length of the kernel.
+func (c *Convolver[T]) KernelLen() int {
+	return c.kernelLen
+}
+
+// MaxSignalLen returns the longest signal accepted by Apply.
+func (c *Convolver[T]) MaxSignalLen() int {
+	return c.maxSignalLen
+}
+
+// Apply computes the linear convolution of signal with the kernel.
+// The signal length must be in [1, MaxSignalLen()] and dst must have length
+// len(signal)+KernelLen()-1. Apply does not allocate.
+func (c *Convolver[T]) Apply(dst, signal []T) error {
+	return c.ApplyWithMode(dst, signal, ConvFull)
+}
+
+// ApplyWithMode convolves signal with the kernel and writes the part selected
+// by mode to dst, which must have length
+// ConvOutputLen(len(signal), KernelLen(), mode). It does not allocate.
+func (c *Convolver[T]) ApplyWithMode(dst, signal []T, mode ConvMode) error {
+	if dst == nil || signal == nil {
+		return ErrNilSlice
+	}
+
+	if len(signal) > c.maxSignalLen {
+		return ErrInvalidLength
+	}
+
+	_, outLen, err := validateConvMode(len(dst), len(signal), c.kernelLen, mode)
+	if err != nil {
+		return err
+	}
+
+	copy(c.work, signal)
+	clear(c.work[len(signal):])
+
+	err = c.plan.Forward(c.freq, c.work)
+	if err != nil {
+		return err
+	}
+
+	complexMulInPlace(c.freq, c.kernelFreq)
+
+	err = c.plan.Inverse(c.work, c.freq)
+	if err != nil {
+		return err
+	}
+
+	start := convModeOffset(len(signal), c.kernelLen, mode)
+	copy(dst, c.work[start:start+outLen])
+
+	return nil
+}
+
+// RealConvolver convolves real-valued signals against a fixed real kernel
+// using real FFTs.
+//
+// Like Convolver, it precomputes the kernel's half-spectrum and owns all
+// workspace, so Apply performs no allocations. It must not be used by
+// multiple goroutines at the same time.
+type RealConvolver[F Float, C Complex] struct {
+	kernelLen    int
+	maxSignalLen int
+
+	plan       *PlanRealT[F, C]
+	kernelFreq []C
+	work       []F
+	freq       []C
+}
+
+// NewRealConvolver creates a RealConvolver for kernel that accepts signals of
+// up to maxSignalLen samples. The complex type C must match F
+// (float32→complex64, float64→complex128).
+//
+// Example:
+//
+//	conv, err := algofft.NewRealConvolver[float32, complex64](taps, 4096)
+func NewRealConvolver[F Float, C Complex](kernel []F, maxSignalLen int) (*RealConvolver[F, C], error) {
+	if kernel == nil {
+		return nil, ErrNilSlice
+	}
+
+	if len(kernel) == 0 || maxSignalLen < 1 {
+		return nil, ErrInvalidLength
+	}
+
+	fftLen := NextFastLen(len(kernel)+maxSignalLen-1, RealTransform)
+
+	plan, err := NewPlanRealT[F, C](fftLen)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &RealConvolver[F, C]{
+		kernelLen:    len(kernel),
+		maxSignalLen: maxSignalLen,
+		plan:         plan,
+		kernelFreq:   make([]C, plan.SpectrumLen()),
+		work:         make([]F, fftLen),
+		freq:         make([]C, plan.SpectrumLen()),
+	}
+
+	copy(c.work, kernel)
+
+	err = plan.Forward(c.kernelFreq, c.work)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// KernelLen returns the length of the kernel.
+func (c *RealConvolver[F, C]) KernelLen() int {
+	return c.kernelLen
+}
+
+// MaxSignalLen returns the longest signal accepted by Apply.
+func (c *RealConvolver[F, C]) MaxSignalLen() int {
+	return c.maxSignalLen
+}
+
+// Apply computes the linear convolution of signal with the kernel.
+// The signal length must be in [1, MaxSignalLen()] and dst must have length
+// len(signal)+KernelLen()-1. Apply does not allocate.
+func (c *RealConvolver[F, C]) Apply(dst, signal []F) error {
+	return c.ApplyWithMode(dst, signal, ConvFull)
+}
+
+// ApplyWithMode convolves signal with the kernel and writes the part selected
+// by mode to dst, which must have length
+// ConvOutputLen(len(signal), KernelLen(), mode). It does not allocate.
+func (c *RealConvolver[F, C]) ApplyWithMode(dst, signal []F, mode ConvMode) error {
+	if dst == nil || signal == nil {
+		return ErrNilSlice
+	}
+
+	if len(signal) > c.maxSignalLen {
+		return ErrInvalidLength
+	}
+
+	_, outLen, err := validateConvMode(len(dst), len(signal), c.kernelLen, mode)
+	if err != nil {
+		return err
+	}
+
+	copy(c.work, signal)
+	clear(c.work[len(signal):])
+
+	err = c.plan.Forward(c.freq, c.work)
+	if err != nil {
+		return err
+	}
+
+	complexMulInPlace(c.freq, c.kernelFreq)
+
+	err = c.plan.Inverse(c.work, c.freq)
+	if err != nil {
+		return err
+	}
+
+	start := convModeOffset(len(signal), c.kernelLen, mode)
+	copy(dst, c.work[start:start+outLen])
+
+	return nil
+}
@@ -0,0 +1,199 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestConvolverMatchesNaive(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(31))
+	kernel := make([]complex128, 9)
+
+	for i := range kernel {
+		kernel[i] = complex(rng.Float64()*2-1, rng.Float64()*2-1)
+	}
+
+	conv, err := NewConvolver(kernel, 40)
+	if err != nil {
+		t.Fatalf("NewConvolver() returned error: %v", err)
+	}
+
+	// Reuse the same Convolver with signals of different lengths.
+	for _, n := range []int{1, 7, 33, 40} {
+		signal := make([]complex128, n)
+		for i := range signal {
+			signal[i] = complex(rng.Float64()*2-1, rng.Float64()*2-1)
+		}
+
+		want := naiveConvolveComplex128(signal, kernel)
+		got := make([]complex128, len(want))
+
+		err := conv.Apply(got, signal)
+		if err != nil {
+			t.Fatalf("Apply(len=%d) returned error: %v", n, err)
+		}
+
+		for i := range want {
+			assertApproxComplex128Tolf(t, got[i], want[i], 1e-10, "n=%d got[%d]", n, i)
+		}
+	}
+}
+
+func TestConvolverComplex64MatchesConvolve(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(32))
+	kernel := make([]complex64, 5)
+	signal := make([]complex64, 20)
+
+	for i := range kernel {
+		kernel[i] = complex(rng.Float32()*2-1, rng.Float32()*2-1)
+	}
+
+	for i := range signal {
+		signal[i] = complex(rng.Float32()*2-1, rng.Float32()*2-1)
+	}
+
+	conv, err := NewConvolver(kernel, len(signal))
+	if err != nil {
+		t.Fatalf("NewConvolver() returned error: %v", err)
+	}
+
+	want := make([]complex64, len(signal)+len(kernel)-1)
+	got := make([]complex64, len(want))
+
+	err = Convolve(want, signal, kernel)
+	if err != nil {
+		t.Fatalf("Convolve() returned error: %v", err)
+	}
+
+	err = conv.Apply(got, signal)
+	if err != nil {
+		t.Fatalf("Apply() returned error: %v", err)
+	}
+
+	for i := range want {
+		assertApproxComplex64f(t, got[i], want[i], 1e-4, "got[%d]", i)
+	}
+}
+
+func TestRealConvolverMatchesNaive(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(33))
+	kernel := make([]float32, 6)
+
+	for i := range kernel {
+		kernel[i] = rng.Float32()*2 - 1
+	}
+
+	conv, err := NewRealConvolver[float32, complex64](kernel, 64)
+	if err != nil {
+		t.Fatalf("NewRealConvolver() returned error: %v", err)
+	}
+
+	if conv.KernelLen() != 6 || conv.MaxSignalLen() != 64 {
+		t.Fatalf("KernelLen()=%d MaxSignalLen()=%d, want 6 and 64", conv.KernelLen(), conv.MaxSignalLen())
+	}
+
+	for _, n := range []int{1, 10, 64} {
+		signal := make([]float32, n)
+		for i := range signal {
+			signal[i] = rng.Float32()*2 - 1
+		}
+
+		want := naiveConvolveReal(signal, kernel)
+		got := make([]float32, len(want))
+
+		err := conv.Apply(got, signal)
+		if err != nil {
+			t.Fatalf("Apply(len=%d) returned error: %v", n, err)
+		}
+
+		for i := range want {
+			if diff := math.Abs(float64(got[i] - want[i])); diff > 1e-4 {
+				t.Fatalf("n=%d got[%d]=%v want %v (diff=%v)", n, i, got[i], want[i], diff)
+			}
+		}
+	}
+}
+
+func TestConvolverErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewConvolver[complex64](nil, 8)
+	if !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("NewConvolver(nil) error = %v, want ErrNilSlice", err)
+	}
+
+	_, err = NewConvolver([]complex64{}, 8)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("NewConvolver(empty) error = %v, want ErrInvalidLength", err)
+	}
+
+	_, err = NewRealConvolver[float64, complex128]([]float64{1}, 0)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("NewRealConvolver(maxSignalLen=0) error = %v, want ErrInvalidLength", err)
+	}
+
+	conv, err := NewConvolver([]complex64{1, 2}, 4)
+	if err != nil {
+		t.Fatalf("NewConvolver() returned error: %v", err)
+	}
+
+	err = conv.Apply(make([]complex64, 6), make([]complex64, 5))
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("Apply(too long) error = %v, want ErrInvalidLength", err)
+	}
+
+	err = conv.Apply(make([]complex64, 4), make([]complex64, 4))
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("Apply(short dst) error = %v, want ErrLengthMismatch", err)
+	}
+
+	err = conv.Apply(nil, make([]complex64, 4))
+	if !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("Apply(nil dst) error = %v, want ErrNilSlice", err)
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestConvolverApplyNoAllocs(t *testing.T) {
+	kernel := make([]complex64, 31)
+	for i := range kernel {
+		kernel[i] = complex(float32(i%5), float32(-i%3))
+	}
+
+	conv, err := NewConvolver(kernel, 1000)
+	if err != nil {
+		t.Fatalf("NewConvolver() returned error: %v", err)
+	}
+
+	signal := make([]complex64, 1000)
+	dst := make([]complex64, len(signal)+len(kernel)-1)
+
+	assertNoAllocs(t, "Convolver.Apply", func() error {
+		return conv.Apply(dst, signal)
+	})
+
+	realKernel := make([]float64, 31)
+	for i := range realKernel {
+		realKernel[i] = float64(i % 7)
+	}
+
+	realConv, err := NewRealConvolver[float64, complex128](realKernel, 1000)
+	if err != nil {
+		t.Fatalf("NewRealConvolver() returned error: %v", err)
+	}
+
+	realSignal := make([]float64, 1000)
+	realDst := make([]float64, len(realSignal)+len(realKernel)-1)
+
+	assertNoAllocs(t, "RealConvolver.Apply", func() error {
+		return realConv.Apply(realDst, realSignal)
+	})
+}
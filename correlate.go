@@ -11,25 +11,14 @@ func Correlate(dst, a, b []complex64) error {
 // The dst slice must have length len(a)+len(b)-1.
 // Output index k corresponds to lag k-(len(b)-1).
 func CrossCorrelate(dst, a, b []complex64) error {
-	if dst == nil || a == nil || b == nil {
-		return ErrNilSlice
-	}
-
-	if len(a) == 0 || len(b) == 0 {
-		return ErrInvalidLength
-	}
-
-	if len(dst) != len(a)+len(b)-1 {
-		return ErrLengthMismatch
-	}
-
-	bRevConj := make([]complex64, len(b))
-	for i := range b {
-		v := b[len(b)-1-i]
-		bRevConj[i] = complex(real(v), -imag(v))
-	}
+	return crossCorrelateComplex(dst, a, b, ConvFull)
+}
 
-	return Convolve(dst, a, bRevConj)
+// CrossCorrelateWithMode computes the cross-correlation of a and b and writes
+// the part selected by mode to dst, matching scipy.signal.correlate.
+// The dst slice must have length ConvOutputLen(len(a), len(b), mode).
+func CrossCorrelateWithMode(dst, a, b []complex64, mode ConvMode) error {
+	return crossCorrelateComplex(dst, a, b, mode)
 }
 
 // AutoCorrelate computes the full auto-correlation of a.
@@ -50,30 +39,141 @@ func Correlate128(dst, a, b []complex128) error {
 // The dst slice must have length len(a)+len(b)-1.
 // Output index k corresponds to lag k-(len(b)-1).
 func CrossCorrelate128(dst, a, b []complex128) error {
+	return crossCorrelateComplex(dst, a, b, ConvFull)
+}
+
+// CrossCorrelate128WithMode computes the cross-correlation of a and b and
+// writes the part selected by mode to dst, matching scipy.signal.correlate.
+// The dst slice must have length ConvOutputLen(len(a), len(b), mode).
+func CrossCorrelate128WithMode(dst, a, b []complex128, mode ConvMode) error {
+	return crossCorrelateComplex(dst, a, b, mode)
+}
+
+// AutoCorrelate128 computes the full auto-correlation of a.
+// The dst slice must have length 2*len(a)-1.
+// Output index k corresponds to lag k-(len(a)-1).
+func AutoCorrelate128(dst, a []complex128) error {
+	return CrossCorrelate128(dst, a, a)
+}
+
+// crossCorrelateComplex multiplies the spectrum of a with the conjugate
+// spectrum of b, which yields the circular cross-correlation, and reads the
+// requested lags from it. Auto-correlation needs a single forward transform.
+func crossCorrelateComplex[T Complex](dst, a, b []T, mode ConvMode) error {
 	if dst == nil || a == nil || b == nil {
 		return ErrNilSlice
 	}
 
-	if len(a) == 0 || len(b) == 0 {
-		return ErrInvalidLength
+	convLen, _, err := validateConvMode(len(dst), len(a), len(b), mode)
+	if err != nil {
+		return err
+	}
+
+	fftLen := NextFastLen(convLen, ComplexTransform)
+
+	plan, err := NewPlanT[T](fftLen)
+	if err != nil {
+		return err
+	}
+
+	padded := make([]T, fftLen)
+	copy(padded, a)
+
+	aFreq := make([]T, fftLen)
+
+	err = plan.Forward(aFreq, padded)
+	if err != nil {
+		return err
 	}
 
-	if len(dst) != len(a)+len(b)-1 {
-		return ErrLengthMismatch
+	bFreq := aFreq
+	if !sameSlice(a, b) {
+		clear(padded)
+		copy(padded, b)
+
+		bFreq = make([]T, fftLen)
+
+		err = plan.Forward(bFreq, padded)
+		if err != nil {
+			return err
+		}
 	}
 
-	bRevConj := make([]complex128, len(b))
-	for i := range b {
-		v := b[len(b)-1-i]
-		bRevConj[i] = complex(real(v), -imag(v))
+	complexMulConjInPlace(aFreq, bFreq)
+
+	err = plan.Inverse(padded, aFreq)
+	if err != nil {
+		return err
 	}
 
-	return Convolve128(dst, a, bRevConj)
+	copyCorrelationLags(dst, padded, len(b), convModeOffset(len(a), len(b), mode))
+
+	return nil
 }
 
-// AutoCorrelate128 computes the full auto-correlation of a.
-// The dst slice must have length 2*len(a)-1.
-// Output index k corresponds to lag k-(len(a)-1).
-func AutoCorrelate128(dst, a []complex128) error {
-	return CrossCorrelate128(dst, a, a)
+// copyCorrelationLags fills dst from a circular cross-correlation. Full
+// output index k holds lag k-(lenB-1); negative lags sit at the end of circ.
+// start is the first full output index to copy.
+func copyCorrelationLags[S Scalar](dst, circ []S, lenB, start int) {
+	idx := start - (lenB - 1)
+	if idx < 0 {
+		idx += len(circ)
+	}
+
+	for i := range dst {
+		dst[i] = circ[idx]
+
+		idx++
+		if idx == len(circ) {
+			idx = 0
+		}
+	}
+}
+
+// complexMulConjInPlace computes dst[i] *= conj(src[i]).
+func complexMulConjInPlace[T Complex](dst, src []T) {
+	switch d := any(dst).(type) {
+	case []complex64:
+		s := any(src).([]complex64)
+		for i := range d {
+			d[i] *= complex(real(s[i]), -imag(s[i]))
+		}
+	case []complex128:
+		s := any(src).([]complex128)
+		for i := range d {
+			d[i] *= complex(real(s[i]), -imag(s[i]))
+		}
+	}
+}
+
+// conjInPlace conjugates every element of x.
+func conjInPlace[T Complex](x []T) {
+	switch v := any(x).(type) {
+	case []complex64:
+		for i, c := range v {
+			v[i] = complex(real(c), -imag(c))
+		}
+	case []complex128:
+		for i, c := range v {
+			v[i] = complex(real(c), -imag(c))
+		}
+	}
+}
+
+// reverseConj writes the time-reversed complex conjugate of src to dst.
+func reverseConj[T Complex](dst, src []T) {
+	switch d := any(dst).(type) {
+	case []complex64:
+		s := any(src).([]complex64)
+		for i := range s {
+			v := s[len(s)-1-i]
+			d[i] = complex(real(v), -imag(v))
+		}
+	case []complex128:
+		s := any(src).([]complex128)
+		for i := range s {
+			v := s[len(s)-1-i]
+			d[i] = complex(real(v), -imag(v))
+		}
+	}
 }
@@ -0,0 +1,105 @@
+package algofft
+
+// CrossCorrelateReal computes the full cross-correlation of real signals a
+// and b using real FFTs. The dst slice must have length len(a)+len(b)-1.
+// Output index k corresponds to lag k-(len(b)-1).
+func CrossCorrelateReal(dst, a, b []float32) error {
+	return crossCorrelateReal[float32, complex64](dst, a, b, ConvFull)
+}
+
+// CrossCorrelateRealWithMode computes the cross-correlation of real signals
+// a and b and writes the part selected by mode to dst, matching
+// scipy.signal.correlate. The dst slice must have length
+// ConvOutputLen(len(a), len(b), mode).
+func CrossCorrelateRealWithMode(dst, a, b []float32, mode ConvMode) error {
+	return crossCorrelateReal[float32, complex64](dst, a, b, mode)
+}
+
+// AutoCorrelateReal computes the full auto-correlation of a real signal.
+// The dst slice must have length 2*len(a)-1.
+// Output index k corresponds to lag k-(len(a)-1).
+func AutoCorrelateReal(dst, a []float32) error {
+	return crossCorrelateReal[float32, complex64](dst, a, a, ConvFull)
+}
+
+// CrossCorrelateReal64 computes the full cross-correlation of real signals
+// a and b using float64 real FFTs. The dst slice must have length
+// len(a)+len(b)-1. Output index k corresponds to lag k-(len(b)-1).
+func CrossCorrelateReal64(dst, a, b []float64) error {
+	return crossCorrelateReal[float64, complex128](dst, a, b, ConvFull)
+}
+
+// CrossCorrelateReal64WithMode is the float64 variant of
+// CrossCorrelateRealWithMode.
+func CrossCorrelateReal64WithMode(dst, a, b []float64, mode ConvMode) error {
+	return crossCorrelateReal[float64, complex128](dst, a, b, mode)
+}
+
+// AutoCorrelateReal64 computes the full auto-correlation of a real signal
+// using float64 real FFTs. The dst slice must have length 2*len(a)-1.
+// Output index k corresponds to lag k-(len(a)-1).
+func AutoCorrelateReal64(dst, a []float64) error {
+	return crossCorrelateReal[float64, complex128](dst, a, a, ConvFull)
+}
+
+// crossCorrelateReal multiplies the half-spectrum of a with the conjugate
+// half-spectrum of b and reads the requested lags from the circular result.
+// For auto-correlation (a and b the same slice) only one forward transform
+// is needed.
+func crossCorrelateReal[F Float, C Complex](dst, a, b []F, mode ConvMode) error {
+	if dst == nil || a == nil || b == nil {
+		return ErrNilSlice
+	}
+
+	convLen, _, err := validateConvMode(len(dst), len(a), len(b), mode)
+	if err != nil {
+		return err
+	}
+
+	fftLen := NextFastLen(convLen, RealTransform)
+
+	plan, err := NewPlanRealT[F, C](fftLen)
+	if err != nil {
+		return err
+	}
+
+	padded := make([]F, fftLen)
+	copy(padded, a)
+
+	aFreq := make([]C, plan.SpectrumLen())
+
+	err = plan.Forward(aFreq, padded)
+	if err != nil {
+		return err
+	}
+
+	bFreq := aFreq
+	if !sameSlice(a, b) {
+		clear(padded)
+		copy(padded, b)
+
+		bFreq = make([]C, plan.SpectrumLen())
+
+		err = plan.Forward(bFreq, padded)
+		if err != nil {
+			return err
+		}
+	}
+
+	complexMulConjInPlace(aFreq, bFreq)
+
+	err = plan.Inverse(padded, aFreq)
+	if err != nil {
+		return err
+	}
+
+	copyCorrelationLags(dst, padded, len(b), convModeOffset(len(a), len(b), mode))
+
+	return nil
+}
+
+// sameSlice reports whether a and b share the same backing array start and
+// length.
+func sameSlice[S any](a, b []S) bool {
+	return len(a) == len(b) && (len(a) == 0 || &a[0] == &b[0])
+}
@@ -0,0 +1,178 @@
+package algofft
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func naiveCrossCorrelateFloat64(a, b []float64) []float64 {
+	out := make([]float64, len(a)+len(b)-1)
+
+	for k := range out {
+		lag := k - (len(b) - 1)
+		for n := range b {
+			if i := n + lag; i >= 0 && i < len(a) {
+				out[k] += a[i] * b[n]
+			}
+		}
+	}
+
+	return out
+}
+
+func TestCrossCorrelateRealMatchesNaive(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(36))
+
+	for _, sizes := range [][2]int{{1, 1}, {9, 4}, {4, 9}, {33, 33}, {100, 7}} {
+		a := randomFloat64s(rng, sizes[0])
+		b := randomFloat64s(rng, sizes[1])
+		want := naiveCrossCorrelateFloat64(a, b)
+
+		got64 := make([]float64, len(want))
+
+		err := CrossCorrelateReal64(got64, a, b)
+		if err != nil {
+			t.Fatalf("CrossCorrelateReal64(%v) returned error: %v", sizes, err)
+		}
+
+		got32 := make([]float32, len(want))
+
+		err = CrossCorrelateReal(got32, toFloat32(a), toFloat32(b))
+		if err != nil {
+			t.Fatalf("CrossCorrelateReal(%v) returned error: %v", sizes, err)
+		}
+
+		for i := range want {
+			assertApproxFloat64(t, got64[i], want[i], 1e-10, "%v float64 got[%d]", sizes, i)
+			assertApproxFloat64(t, float64(got32[i]), want[i], 1e-4, "%v float32 got[%d]", sizes, i)
+		}
+	}
+}
+
+func TestCrossCorrelateRealWithModeMatchesSciPy(t *testing.T) {
+	t.Parallel()
+
+	// scipy.signal.correlate([1, 2, 3], [0, 1, 0.5], mode)
+	a := []float64{1, 2, 3}
+	b := []float64{0, 1, 0.5}
+
+	tests := []struct {
+		mode ConvMode
+		want []float64
+	}{
+		{ConvFull, []float64{0.5, 2, 3.5, 3, 0}},
+		{ConvSame, []float64{2, 3.5, 3}},
+		{ConvValid, []float64{3.5}},
+	}
+
+	for _, tc := range tests {
+		got := make([]float64, len(tc.want))
+
+		err := CrossCorrelateReal64WithMode(got, a, b, tc.mode)
+		if err != nil {
+			t.Fatalf("CrossCorrelateReal64WithMode(%v) returned error: %v", tc.mode, err)
+		}
+
+		got32 := make([]float32, len(tc.want))
+
+		err = CrossCorrelateRealWithMode(got32, toFloat32(a), toFloat32(b), tc.mode)
+		if err != nil {
+			t.Fatalf("CrossCorrelateRealWithMode(%v) returned error: %v", tc.mode, err)
+		}
+
+		for i := range tc.want {
+			assertApproxFloat64(t, got[i], tc.want[i], 1e-12, "%v got[%d]", tc.mode, i)
+			assertApproxFloat64(t, float64(got32[i]), tc.want[i], 1e-5, "%v float32 got[%d]", tc.mode, i)
+		}
+	}
+}
+
+func TestAutoCorrelateReal(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(37))
+	a := randomFloat64s(rng, 25)
+	want := naiveCrossCorrelateFloat64(a, a)
+
+	got := make([]float64, len(want))
+
+	err := AutoCorrelateReal64(got, a)
+	if err != nil {
+		t.Fatalf("AutoCorrelateReal64() returned error: %v", err)
+	}
+
+	got32 := make([]float32, len(want))
+
+	err = AutoCorrelateReal(got32, toFloat32(a))
+	if err != nil {
+		t.Fatalf("AutoCorrelateReal() returned error: %v", err)
+	}
+
+	for i := range want {
+		assertApproxFloat64(t, got[i], want[i], 1e-10, "got[%d]", i)
+		assertApproxFloat64(t, float64(got32[i]), want[i], 1e-4, "float32 got[%d]", i)
+	}
+
+	// Zero lag holds the energy and the sequence is symmetric.
+	for k := range len(a) - 1 {
+		assertApproxFloat64(t, got[k], got[len(got)-1-k], 1e-10, "symmetry at %d", k)
+	}
+}
+
+func TestConvolveReal64MatchesNaive(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(38))
+	a := randomFloat64s(rng, 31)
+	b := randomFloat64s(rng, 12)
+	want := naiveConvolveFloat64(a, b)
+
+	got := make([]float64, len(want))
+
+	err := ConvolveReal64(got, a, b)
+	if err != nil {
+		t.Fatalf("ConvolveReal64() returned error: %v", err)
+	}
+
+	for i := range want {
+		assertApproxFloat64(t, got[i], want[i], 1e-10, "got[%d]", i)
+	}
+
+	valid := make([]float64, ConvOutputLen(len(a), len(b), ConvValid))
+
+	err = ConvolveReal64WithMode(valid, a, b, ConvValid)
+	if err != nil {
+		t.Fatalf("ConvolveReal64WithMode() returned error: %v", err)
+	}
+
+	for i := range valid {
+		assertApproxFloat64(t, valid[i], want[len(b)-1+i], 1e-10, "valid[%d]", i)
+	}
+}
+
+func TestCrossCorrelateRealErrors(t *testing.T) {
+	t.Parallel()
+
+	err := CrossCorrelateReal(nil, []float32{1}, []float32{1})
+	if !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil dst error = %v, want ErrNilSlice", err)
+	}
+
+	err = CrossCorrelateReal64(make([]float64, 2), []float64{1, 2}, []float64{1})
+	if err != nil {
+		t.Fatalf("CrossCorrelateReal64() returned error: %v", err)
+	}
+
+	err = AutoCorrelateReal64(make([]float64, 2), []float64{1, 2})
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("short dst error = %v, want ErrLengthMismatch", err)
+	}
+
+	err = CrossCorrelateReal64(make([]float64, 1), []float64{}, []float64{1})
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("empty input error = %v, want ErrInvalidLength", err)
+	}
+}
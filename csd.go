@@ -0,0 +1,221 @@
+package algofft
+
+import "math/cmplx"
+
+// TransferEstimator selects how TransferFunction estimates a frequency
+// response from averaged cross-spectra.
+type TransferEstimator uint8
+
+const (
+	// TransferH1 estimates H = Pxy/Pxx, which is unbiased by uncorrelated
+	// noise on the output y. It is the default.
+	TransferH1 TransferEstimator = iota
+
+	// TransferH2 estimates H = Pyy/Pyx, which is unbiased by uncorrelated
+	// noise on the input x.
+	TransferH2
+)
+
+// String returns the name of the estimator.
+func (e TransferEstimator) String() string {
+	switch e {
+	case TransferH1:
+		return "H1"
+	case TransferH2:
+		return "H2"
+	default:
+		return "unknown"
+	}
+}
+
+// CSD estimates the cross power spectral density Pxy = E[conj(X)·Y] of x
+// and y with the Welch segmentation of PSDOptions, following
+// scipy.signal.csd. Both signals must have the same length. CSD(x, x)
+// equals Welch(x).
+func CSD(x, y []float32, opts PSDOptions) ([]float64, []complex64, error) {
+	return crossSpectrum[float32, complex64](x, y, opts)
+}
+
+// CSD64 is the float64 variant of CSD.
+func CSD64(x, y []float64, opts PSDOptions) ([]float64, []complex128, error) {
+	return crossSpectrum[float64, complex128](x, y, opts)
+}
+
+// CSDMatrix estimates the cross power spectral densities between every
+// pair of the given equal-length channels in one pass, transforming all
+// channels of a segment in one batch. For N channels it returns N×N
+// Hermitian matrices, one per frequency: element (i, j) at bin k, the CSD
+// of channels i and j, is at index (k*N+i)*N+j.
+func CSDMatrix(channels [][]float32, opts PSDOptions) ([]float64, []complex64, error) {
+	return csdMatrixAs[float32, complex64](channels, opts)
+}
+
+// CSDMatrix64 is the float64 variant of CSDMatrix.
+func CSDMatrix64(channels [][]float64, opts PSDOptions) ([]float64, []complex128, error) {
+	return csdMatrixAs[float64, complex128](channels, opts)
+}
+
+// Coherence estimates the magnitude-squared coherence
+// |Pxy|²/(Pxx·Pyy) of x and y, which lies in [0, 1] and measures how much
+// of y is explained linearly by x at each frequency, following
+// scipy.signal.coherence. Bins where either signal has no power are zero.
+func Coherence(x, y []float32, opts PSDOptions) ([]float64, []float32, error) {
+	return coherence[float32, complex64](x, y, opts)
+}
+
+// Coherence64 is the float64 variant of Coherence.
+func Coherence64(x, y []float64, opts PSDOptions) ([]float64, []float64, error) {
+	return coherence[float64, complex128](x, y, opts)
+}
+
+// TransferFunction estimates the frequency response of the linear system
+// that maps the input x to the output y, using the H1 or H2 estimator.
+// Bins where the denominator vanishes are zero.
+func TransferFunction(x, y []float32, opts PSDOptions, estimator TransferEstimator) ([]float64, []complex64, error) {
+	return transferFunction[float32, complex64](x, y, opts, estimator)
+}
+
+// TransferFunction64 is the float64 variant of TransferFunction.
+func TransferFunction64(x, y []float64, opts PSDOptions, estimator TransferEstimator) ([]float64, []complex128, error) {
+	return transferFunction[float64, complex128](x, y, opts, estimator)
+}
+
+func crossSpectrum[F Float, C Complex](x, y []F, opts PSDOptions) ([]float64, []C, error) {
+	freqs, m, err := csdMatrix[F, C]([][]F{x, y}, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pxy := make([]C, len(freqs))
+	for k := range pxy {
+		pxy[k] = C(m[4*k+1])
+	}
+
+	return freqs, pxy, nil
+}
+
+func csdMatrixAs[F Float, C Complex](channels [][]F, opts PSDOptions) ([]float64, []C, error) {
+	freqs, m, err := csdMatrix[F, C](channels, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := make([]C, len(m))
+	for i, v := range m {
+		out[i] = C(v)
+	}
+
+	return freqs, out, nil
+}
+
+func coherence[F Float, C Complex](x, y []F, opts PSDOptions) ([]float64, []F, error) {
+	freqs, m, err := csdMatrix[F, C]([][]F{x, y}, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	coh := make([]F, len(freqs))
+
+	for k := range coh {
+		pxx, pxy, pyy := real(m[4*k]), m[4*k+1], real(m[4*k+3])
+		if pxx > 0 && pyy > 0 {
+			mag := cmplx.Abs(pxy)
+			coh[k] = F(min(mag*mag/(pxx*pyy), 1))
+		}
+	}
+
+	return freqs, coh, nil
+}
+
+func transferFunction[F Float, C Complex](x, y []F, opts PSDOptions, estimator TransferEstimator) ([]float64, []C, error) {
+	if estimator > TransferH2 {
+		return nil, nil, ErrInvalidMode
+	}
+
+	freqs, m, err := csdMatrix[F, C]([][]F{x, y}, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h := make([]C, len(freqs))
+
+	for k := range h {
+		pxx, pxy, pyy := m[4*k], m[4*k+1], m[4*k+3]
+
+		num, den := pxy, pxx
+		if estimator == TransferH2 {
+			num, den = pyy, cmplx.Conj(pxy)
+		}
+
+		if den != 0 {
+			h[k] = C(num / den)
+		}
+	}
+
+	return freqs, h, nil
+}
+
+// csdMatrix averages conj(X_i)·X_j over the Welch segments of all channel
+// pairs and returns the scaled bins×N×N matrices.
+func csdMatrix[F Float, C Complex](channels [][]F, opts PSDOptions) ([]float64, []complex128, error) {
+	if len(channels) == 0 {
+		return nil, nil, ErrInvalidLength
+	}
+
+	n := len(channels[0])
+
+	for _, ch := range channels {
+		if ch == nil {
+			return nil, nil, ErrNilSlice
+		}
+
+		if len(ch) != n {
+			return nil, nil, ErrLengthMismatch
+		}
+	}
+
+	count := len(channels)
+
+	est, err := newSpectralEstimator[F, C](n, count, opts, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := make([]complex128, est.bins*count*count)
+	segments := est.segments(n)
+
+	for s := range segments {
+		err = est.transform(channels, s)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for k := range est.bins {
+			block := m[k*count*count : (k+1)*count*count]
+
+			for i := range count {
+				xi := cmplx.Conj(complex128(est.spec[i*est.fftLen+k]))
+				for j := i; j < count; j++ {
+					block[i*count+j] += xi * complex128(est.spec[j*est.fftLen+k])
+				}
+			}
+		}
+	}
+
+	for k := range est.bins {
+		scale := complex(est.binScale(k, segments), 0)
+		block := m[k*count*count : (k+1)*count*count]
+
+		for i := range count {
+			for j := i; j < count; j++ {
+				block[i*count+j] *= scale
+				block[j*count+i] = cmplx.Conj(block[i*count+j])
+			}
+
+			// Auto-spectra are real by construction.
+			block[i*count+i] = complex(real(block[i*count+i]), 0)
+		}
+	}
+
+	return est.frequencies(), m, nil
+}
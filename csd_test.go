@@ -0,0 +1,272 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+// causalFilter returns y[n] = Σ h[m]·x[n-m] with x = 0 before the start.
+func causalFilter(x, h []float64) []float64 {
+	y := make([]float64, len(x))
+	for i := range y {
+		for m, hm := range h {
+			if i >= m {
+				y[i] += hm * x[i-m]
+			}
+		}
+	}
+
+	return y
+}
+
+// filterResponse evaluates the frequency response of h at f cycles per
+// sample.
+func filterResponse(h []float64, f float64) complex128 {
+	var r complex128
+	for m, hm := range h {
+		r += complex(hm, 0) * cmplx.Exp(complex(0, -2*math.Pi*f*float64(m)))
+	}
+
+	return r
+}
+
+func TestCSDConsistentWithWelch(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(4))
+	x := randomFloat64s(rng, 2000)
+	y := randomFloat64s(rng, 2000)
+
+	opts := PSDOptions{SegmentLen: 128, Detrend: DetrendLinear}
+
+	_, pxx, err := Welch64(x, opts)
+	if err != nil {
+		t.Fatalf("Welch64() returned error: %v", err)
+	}
+
+	_, auto, err := CSD64(x, x, opts)
+	if err != nil {
+		t.Fatalf("CSD64() returned error: %v", err)
+	}
+
+	for k := range pxx {
+		if cmplx.Abs(auto[k]-complex(pxx[k], 0)) > 1e-12 {
+			t.Fatalf("CSD(x, x)[%d] = %v, want %v", k, auto[k], pxx[k])
+		}
+	}
+
+	_, pxy, err := CSD64(x, y, opts)
+	if err != nil {
+		t.Fatalf("CSD64() returned error: %v", err)
+	}
+
+	_, pyx, err := CSD64(y, x, opts)
+	if err != nil {
+		t.Fatalf("CSD64() returned error: %v", err)
+	}
+
+	for k := range pxy {
+		if cmplx.Abs(pxy[k]-cmplx.Conj(pyx[k])) > 1e-12 {
+			t.Fatalf("CSD(x, y)[%d] = %v, want conj(CSD(y, x)) = %v", k, pxy[k], cmplx.Conj(pyx[k]))
+		}
+	}
+
+	// The matrix form holds the same spectra for every channel pair, with
+	// the odd FFT length exercising the complex transform path.
+	z := randomFloat64s(rng, 2000)
+	matOpts := PSDOptions{SegmentLen: 99, TwoSided: true}
+
+	freqs, m, err := CSDMatrix64([][]float64{x, y, z}, matOpts)
+	if err != nil {
+		t.Fatalf("CSDMatrix64() returned error: %v", err)
+	}
+
+	channels := [][]float64{x, y, z}
+
+	for i := range channels {
+		for j := range channels {
+			_, want, err := CSD64(channels[i], channels[j], matOpts)
+			if err != nil {
+				t.Fatalf("CSD64() returned error: %v", err)
+			}
+
+			for k := range freqs {
+				got := m[(k*3+i)*3+j]
+				if cmplx.Abs(got-want[k]) > 1e-12 {
+					t.Fatalf("matrix (%d, %d) bin %d = %v, want %v", i, j, k, got, want[k])
+				}
+			}
+		}
+	}
+
+	_, m32, err := CSDMatrix([][]float32{toFloat32(x), toFloat32(y), toFloat32(z)}, matOpts)
+	if err != nil {
+		t.Fatalf("CSDMatrix() returned error: %v", err)
+	}
+
+	for i, v := range m {
+		if cmplx.Abs(complex128(m32[i])-v) > 1e-4 {
+			t.Fatalf("float32 matrix [%d] = %v, want %v", i, m32[i], v)
+		}
+	}
+}
+
+func TestTransferFunctionRecoversLinearSystem(t *testing.T) {
+	t.Parallel()
+
+	const n, fs = 1 << 15, 1.0
+
+	rng := rand.New(rand.NewSource(8))
+	h := []float64{0.5, 1, -0.3, 0.2, 0.05}
+	x := make([]float64, n)
+
+	for i := range x {
+		x[i] = rng.NormFloat64()
+	}
+
+	y := causalFilter(x, h)
+	opts := PSDOptions{SegmentLen: 256}
+
+	// Noise-free: both estimators recover H and the coherence is 1.
+	for _, est := range []TransferEstimator{TransferH1, TransferH2} {
+		freqs, got, err := TransferFunction64(x, y, opts, est)
+		if err != nil {
+			t.Fatalf("%v: TransferFunction64() returned error: %v", est, err)
+		}
+
+		for k, f := range freqs {
+			want := filterResponse(h, f/fs)
+			if cmplx.Abs(got[k]-want) > 0.02 {
+				t.Fatalf("%v: H(%v) = %v, want %v", est, f, got[k], want)
+			}
+		}
+	}
+
+	_, coh, err := Coherence64(x, y, opts)
+	if err != nil {
+		t.Fatalf("Coherence64() returned error: %v", err)
+	}
+
+	for k, c := range coh {
+		if c < 0.99 || c > 1 {
+			t.Fatalf("noise-free coherence[%d] = %v, want ~1", k, c)
+		}
+	}
+
+	// Output noise of the same power as y: H1 stays unbiased, H2 is
+	// inflated, and the coherence drops to |H|²/(|H|²+σ²).
+	noisy := append([]float64(nil), y...)
+
+	var power float64
+	for _, v := range y {
+		power += v * v / n
+	}
+
+	sigma := math.Sqrt(power)
+	for i := range noisy {
+		noisy[i] += sigma * rng.NormFloat64()
+	}
+
+	freqs, h1, err := TransferFunction64(x, noisy, opts, TransferH1)
+	if err != nil {
+		t.Fatalf("TransferFunction64(H1) returned error: %v", err)
+	}
+
+	_, h2, err := TransferFunction64(x, noisy, opts, TransferH2)
+	if err != nil {
+		t.Fatalf("TransferFunction64(H2) returned error: %v", err)
+	}
+
+	_, coh, err = Coherence64(x, noisy, opts)
+	if err != nil {
+		t.Fatalf("Coherence64() returned error: %v", err)
+	}
+
+	var h1Err, h2Ratio, cohErr float64
+
+	for k, f := range freqs {
+		want := filterResponse(h, f/fs)
+		gain := cmplx.Abs(want) * cmplx.Abs(want)
+
+		h1Err = max(h1Err, cmplx.Abs(h1[k]-want)/cmplx.Abs(want))
+		h2Ratio += cmplx.Abs(h2[k]) / cmplx.Abs(want) / float64(len(freqs))
+		cohErr = max(cohErr, math.Abs(coh[k]-gain/(gain+sigma*sigma)))
+	}
+
+	if h1Err > 0.2 {
+		t.Fatalf("H1 relative error with output noise = %v, want < 0.2", h1Err)
+	}
+
+	if h2Ratio < 1.5 {
+		t.Fatalf("mean |H2/H| with output noise = %v, want inflated above 1.5", h2Ratio)
+	}
+
+	if cohErr > 0.1 {
+		t.Fatalf("coherence deviates by %v from |H|²/(|H|²+σ²)", cohErr)
+	}
+
+	// Input noise: H2 stays unbiased while H1 is attenuated.
+	measured := append([]float64(nil), x...)
+	for i := range measured {
+		measured[i] += rng.NormFloat64()
+	}
+
+	_, h2, err = TransferFunction64(measured, y, opts, TransferH2)
+	if err != nil {
+		t.Fatalf("TransferFunction64(H2) returned error: %v", err)
+	}
+
+	_, h1In, err := TransferFunction(toFloat32(measured), toFloat32(y), opts, TransferH1)
+	if err != nil {
+		t.Fatalf("TransferFunction(H1) returned error: %v", err)
+	}
+
+	for k, f := range freqs {
+		want := filterResponse(h, f/fs)
+
+		if cmplx.Abs(h2[k]-want)/cmplx.Abs(want) > 0.2 {
+			t.Fatalf("H2(%v) with input noise = %v, want %v", f, h2[k], want)
+		}
+
+		if ratio := cmplx.Abs(complex128(h1In[k])) / cmplx.Abs(want); ratio > 0.65 {
+			t.Fatalf("|H1/H|(%v) with input noise = %v, want about 0.5", f, ratio)
+		}
+	}
+}
+
+func TestCSDErrors(t *testing.T) {
+	t.Parallel()
+
+	x := make([]float64, 64)
+
+	if _, _, err := CSD64(x, x[:63], PSDOptions{}); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("length mismatch error = %v, want ErrLengthMismatch", err)
+	}
+
+	if _, _, err := Coherence(nil, make([]float32, 4), PSDOptions{}); !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil channel error = %v, want ErrNilSlice", err)
+	}
+
+	if _, _, err := CSDMatrix64(nil, PSDOptions{}); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("no channels error = %v, want ErrInvalidLength", err)
+	}
+
+	if _, _, err := TransferFunction64(x, x, PSDOptions{}, TransferEstimator(5)); !errors.Is(err, ErrInvalidMode) {
+		t.Fatalf("estimator error = %v, want ErrInvalidMode", err)
+	}
+
+	// A silent channel has zero coherence rather than NaN.
+	_, coh, err := Coherence64(x, randomFloat64s(rand.New(rand.NewSource(1)), 64), PSDOptions{SegmentLen: 16})
+	if err != nil {
+		t.Fatalf("Coherence64() returned error: %v", err)
+	}
+
+	for k, c := range coh {
+		if c != 0 {
+			t.Fatalf("coherence with a silent channel [%d] = %v, want 0", k, c)
+		}
+	}
+}
@@ -0,0 +1,438 @@
+package algofft
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// DeconvMethod selects the regularization used by frequency-domain
+// deconvolution.
+type DeconvMethod uint8
+
+const (
+	// DeconvWiener applies the Wiener filter conj(H)/(|H|²+NSR), where the
+	// noise-to-signal power ratio NSR comes from DeconvOptions.SNR or is
+	// estimated from DeconvOptions.NoisePower. It is the default.
+	DeconvWiener DeconvMethod = iota
+
+	// DeconvTikhonov minimizes ||h*x-y||² + Lambda·||∇²x||², the
+	// constrained least-squares filter conj(H)/(|H|²+Lambda·|P|²) where P is
+	// the spectrum of the discrete Laplacian. It suppresses high-frequency
+	// noise without needing a noise estimate.
+	DeconvTikhonov
+)
+
+// String returns the name of the method.
+func (d DeconvMethod) String() string {
+	switch d {
+	case DeconvWiener:
+		return "wiener"
+	case DeconvTikhonov:
+		return "tikhonov"
+	default:
+		return "unknown"
+	}
+}
+
+// DeconvOptions configures frequency-domain deconvolution.
+type DeconvOptions struct {
+	// Method selects Wiener (default) or Tikhonov regularization.
+	Method DeconvMethod
+
+	// Mode describes how the observed signal was produced from the unknown
+	// one: ConvFull (default) when it is the complete linear convolution,
+	// so the result has len(observed)-len(kernel)+1 samples, or ConvSame
+	// when it was cropped to the input size, so the result has the same
+	// size as the observation. Samples cropped away by ConvSame are treated
+	// as zero, which blurs the result slightly near the edges.
+	Mode ConvMode
+
+	// SNR is the signal-to-noise power ratio for DeconvWiener. Exactly one
+	// of SNR and NoisePower must be set for DeconvWiener.
+	SNR float64
+
+	// NoisePower is the per-sample variance of additive white noise in the
+	// observation. DeconvWiener then estimates the SNR of every observation
+	// from its power and the kernel energy.
+	NoisePower float64
+
+	// Lambda is the smoothness weight for DeconvTikhonov and must be
+	// positive.
+	Lambda float64
+}
+
+// deconvMinGain is the fraction of the largest kernel power below which
+// spectral magnitudes are clamped, so spectral zeros of the kernel cannot
+// produce infinite gain.
+const deconvMinGain = 1e-10
+
+// Deconvolve estimates x from observed = kernel*x (+ noise) for real 1D
+// signals using regularized inversion in the frequency domain. The dst
+// slice must have length len(observed)-len(kernel)+1 for ConvFull or
+// len(observed) for ConvSame.
+//
+// To deconvolve many signals with the same kernel, use NewDeconvolver,
+// which keeps the plan and kernel spectrum.
+func Deconvolve(dst, observed, kernel []float32, opts DeconvOptions) error {
+	d, err := NewDeconvolver[float32, complex64](kernel, len(observed), opts)
+	if err != nil {
+		return err
+	}
+
+	return d.Apply(dst, observed)
+}
+
+// Deconvolve64 is the float64 variant of Deconvolve.
+func Deconvolve64(dst, observed, kernel []float64, opts DeconvOptions) error {
+	d, err := NewDeconvolver[float64, complex128](kernel, len(observed), opts)
+	if err != nil {
+		return err
+	}
+
+	return d.Apply(dst, observed)
+}
+
+// Deconvolve2D estimates a real image from a rows×cols observation blurred
+// by a kRows×kCols kernel. For ConvFull the dst slice holds
+// (rows-kRows+1)×(cols-kCols+1) values, for ConvSame rows×cols.
+func Deconvolve2D(
+	dst, observed []float32, rows, cols int, kernel []float32, kRows, kCols int, opts DeconvOptions,
+) error {
+	d, err := NewDeconvolver2D[float32, complex64](rows, cols, kernel, kRows, kCols, opts)
+	if err != nil {
+		return err
+	}
+
+	return d.Apply(dst, observed)
+}
+
+// Deconvolve2D64 is the float64 variant of Deconvolve2D.
+func Deconvolve2D64(
+	dst, observed []float64, rows, cols int, kernel []float64, kRows, kCols int, opts DeconvOptions,
+) error {
+	d, err := NewDeconvolver2D[float64, complex128](rows, cols, kernel, kRows, kCols, opts)
+	if err != nil {
+		return err
+	}
+
+	return d.Apply(dst, observed)
+}
+
+// Deconvolver deconvolves real signals of a fixed observed length by a
+// fixed kernel. The kernel spectrum and, unless DeconvOptions.NoisePower
+// is used, the complete inverse filter are computed once, so Apply costs
+// one forward and one inverse real FFT and does not allocate.
+//
+// A Deconvolver must not be used by multiple goroutines at the same time.
+type Deconvolver[F Float, C Complex] struct {
+	engine deconvEngine[F, C]
+}
+
+// NewDeconvolver creates a Deconvolver for observations of observedLen
+// samples.
+func NewDeconvolver[F Float, C Complex](kernel []F, observedLen int, opts DeconvOptions) (*Deconvolver[F, C], error) {
+	geom, err := newDeconvGeometry(1, observedLen, kernel == nil, len(kernel), 1, len(kernel), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := NewPlanRealT[F, C](geom.fftCols)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Deconvolver[F, C]{}
+
+	err = d.engine.init(geom, plan, kernel, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// OutputLen returns the number of samples written by Apply.
+func (d *Deconvolver[F, C]) OutputLen() int {
+	return d.engine.geom.outCols
+}
+
+// Apply deconvolves observed and writes OutputLen() samples to dst.
+func (d *Deconvolver[F, C]) Apply(dst, observed []F) error {
+	return d.engine.apply(dst, observed)
+}
+
+// Deconvolver2D deconvolves real rows×cols observations by a fixed kernel,
+// reusing the plan and kernel spectrum across calls. Apply does not
+// allocate.
+//
+// A Deconvolver2D must not be used by multiple goroutines at the same time.
+type Deconvolver2D[F Float, C Complex] struct {
+	engine deconvEngine[F, C]
+}
+
+// NewDeconvolver2D creates a Deconvolver2D for rows×cols observations and
+// a row-major kRows×kCols kernel.
+func NewDeconvolver2D[F Float, C Complex](
+	rows, cols int, kernel []F, kRows, kCols int, opts DeconvOptions,
+) (*Deconvolver2D[F, C], error) {
+	geom, err := newDeconvGeometry(rows, cols, kernel == nil, len(kernel), kRows, kCols, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := newReal2DPlan[F, C](geom.fftRows, geom.fftCols)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Deconvolver2D[F, C]{}
+
+	err = d.engine.init(geom, plan, kernel, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// OutputDims returns the dimensions of the result written by Apply.
+func (d *Deconvolver2D[F, C]) OutputDims() (rows, cols int) {
+	return d.engine.geom.outRows, d.engine.geom.outCols
+}
+
+// Apply deconvolves the row-major observation and writes the OutputDims()
+// result to dst.
+func (d *Deconvolver2D[F, C]) Apply(dst, observed []F) error {
+	return d.engine.apply(dst, observed)
+}
+
+// deconvGeometry places a rows×cols observation into the FFT buffer. The
+// 1D case is a single row.
+type deconvGeometry struct {
+	rows, cols       int
+	kRows, kCols     int
+	fftRows, fftCols int
+	outRows, outCols int
+	offRows, offCols int
+}
+
+func newDeconvGeometry(
+	rows, cols int, kernelNil bool, kernelLen, kRows, kCols int, opts DeconvOptions,
+) (deconvGeometry, error) {
+	if kernelNil {
+		return deconvGeometry{}, ErrNilSlice
+	}
+
+	if opts.Method > DeconvTikhonov || (opts.Mode != ConvFull && opts.Mode != ConvSame) {
+		return deconvGeometry{}, ErrInvalidMode
+	}
+
+	if rows < 1 || cols < 1 || kRows < 1 || kCols < 1 {
+		return deconvGeometry{}, ErrInvalidLength
+	}
+
+	if kernelLen != kRows*kCols {
+		return deconvGeometry{}, ErrLengthMismatch
+	}
+
+	switch opts.Method {
+	case DeconvWiener:
+		if opts.SNR < 0 || opts.NoisePower < 0 || (opts.SNR > 0) == (opts.NoisePower > 0) {
+			return deconvGeometry{}, ErrInvalidParameter
+		}
+	case DeconvTikhonov:
+		if !(opts.Lambda > 0) {
+			return deconvGeometry{}, ErrInvalidParameter
+		}
+	}
+
+	g := deconvGeometry{rows: rows, cols: cols, kRows: kRows, kCols: kCols}
+
+	// fullRows×fullCols is the extent of the linear convolution that
+	// produced the observation.
+	fullRows, fullCols := rows, cols
+
+	if opts.Mode == ConvFull {
+		if kRows > rows || kCols > cols {
+			return deconvGeometry{}, ErrLengthMismatch
+		}
+
+		g.outRows, g.outCols = rows-kRows+1, cols-kCols+1
+	} else {
+		g.outRows, g.outCols = rows, cols
+		g.offRows, g.offCols = convModeOffset(rows, kRows, ConvSame), convModeOffset(cols, kCols, ConvSame)
+		fullRows, fullCols = rows+kRows-1, cols+kCols-1
+	}
+
+	g.fftRows = NextFastLen(fullRows, ComplexTransform)
+	g.fftCols = NextFastLen(fullCols, RealTransform)
+
+	return g, nil
+}
+
+// deconvEngine holds the kernel spectrum and buffers shared by Deconvolver
+// and Deconvolver2D.
+type deconvEngine[F Float, C Complex] struct {
+	geom deconvGeometry
+	plan real2DPlan[F, C]
+
+	// filter is the complete inverse filter when the regularization weight
+	// is fixed; otherwise conj(H).
+	filter []C
+
+	// kernelPower is |H|², clamped away from zero. It is only kept for
+	// per-call noise estimation.
+	kernelPower  []float64
+	kernelEnergy float64
+	noisePower   float64
+
+	work []F
+	freq []C
+}
+
+func (e *deconvEngine[F, C]) init(geom deconvGeometry, plan real2DPlan[F, C], kernel []F, opts DeconvOptions) error {
+	e.geom = geom
+	e.plan = plan
+	e.work = make([]F, geom.fftRows*geom.fftCols)
+	e.freq = make([]C, plan.SpectrumLen())
+	e.filter = make([]C, plan.SpectrumLen())
+
+	for _, v := range kernel {
+		e.kernelEnergy += float64(v) * float64(v)
+	}
+
+	if e.kernelEnergy == 0 {
+		return ErrInvalidParameter
+	}
+
+	placeKernel2D(e.work, geom.fftCols, kernel, geom.kRows, geom.kCols)
+
+	err := plan.Forward(e.filter, e.work)
+	if err != nil {
+		return err
+	}
+
+	power := make([]float64, len(e.filter))
+	maxPower := 0.0
+
+	for i, h := range e.filter {
+		hc := complex128(h)
+		power[i] = real(hc)*real(hc) + imag(hc)*imag(hc)
+		maxPower = max(maxPower, power[i])
+		e.filter[i] = C(cmplx.Conj(hc))
+	}
+
+	for i := range power {
+		power[i] = max(power[i], deconvMinGain*maxPower)
+	}
+
+	switch {
+	case opts.Method == DeconvTikhonov:
+		e.setFilter(power, opts.Lambda, true)
+	case opts.SNR > 0:
+		e.setFilter(power, 1/opts.SNR, false)
+	default:
+		e.kernelPower = power
+		e.noisePower = opts.NoisePower
+	}
+
+	return nil
+}
+
+// setFilter turns the conj(H) spectrum in e.filter into
+// conj(H)/(|H|² + lambda·R), where R is the Laplacian power for Tikhonov
+// and 1 for Wiener.
+func (e *deconvEngine[F, C]) setFilter(power []float64, lambda float64, laplacian bool) {
+	halfCols := len(e.filter) / e.geom.fftRows
+
+	for i := range e.filter {
+		reg := 1.0
+		if laplacian {
+			reg = laplacianPower(i/halfCols, i%halfCols, e.geom.fftRows, e.geom.fftCols)
+		}
+
+		e.filter[i] *= C(complex(1/(power[i]+lambda*reg), 0))
+	}
+}
+
+// laplacianPower returns |P|² at frequency bin (r, c) of an fftRows×fftCols
+// transform, where P is the spectrum of the 5-point Laplacian (the 3-point
+// second difference for a single row).
+func laplacianPower(r, c, fftRows, fftCols int) float64 {
+	p := 2 - 2*math.Cos(2*math.Pi*float64(c)/float64(fftCols))
+	if fftRows > 1 {
+		p += 2 - 2*math.Cos(2*math.Pi*float64(r)/float64(fftRows))
+	}
+
+	return p * p
+}
+
+func (e *deconvEngine[F, C]) apply(dst, observed []F) error {
+	g := &e.geom
+
+	if dst == nil || observed == nil {
+		return ErrNilSlice
+	}
+
+	if len(observed) != g.rows*g.cols || len(dst) != g.outRows*g.outCols {
+		return ErrLengthMismatch
+	}
+
+	clear(e.work)
+
+	for r := range g.rows {
+		row := (r + g.offRows) * g.fftCols
+		copy(e.work[row+g.offCols:row+g.offCols+g.cols], observed[r*g.cols:(r+1)*g.cols])
+	}
+
+	err := e.plan.Forward(e.freq, e.work)
+	if err != nil {
+		return err
+	}
+
+	if e.kernelPower == nil {
+		complexMulInPlace(e.freq, e.filter)
+	} else {
+		nsr, ok := e.estimateNSR(observed)
+		if !ok {
+			// The observation carries no more power than the noise.
+			clear(dst)
+
+			return nil
+		}
+
+		for i := range e.freq {
+			e.freq[i] *= e.filter[i] * C(complex(1/(e.kernelPower[i]+nsr), 0))
+		}
+	}
+
+	err = e.plan.Inverse(e.work, e.freq)
+	if err != nil {
+		return err
+	}
+
+	for r := range g.outRows {
+		copy(dst[r*g.outCols:(r+1)*g.outCols], e.work[r*g.fftCols:r*g.fftCols+g.outCols])
+	}
+
+	return nil
+}
+
+// estimateNSR estimates the noise-to-signal power ratio of the unknown
+// signal from the observation power P_y ≈ P_x·Σh² + σ², assuming a white
+// signal and white noise of variance σ².
+func (e *deconvEngine[F, C]) estimateNSR(observed []F) (float64, bool) {
+	var power float64
+	for _, v := range observed {
+		power += float64(v) * float64(v)
+	}
+
+	power /= float64(len(observed))
+
+	signalPower := (power - e.noisePower) / e.kernelEnergy
+	if signalPower <= 0 {
+		return 0, false
+	}
+
+	return e.noisePower / signalPower, true
+}
@@ -0,0 +1,232 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func rmsError(got, want []float64) float64 {
+	var sum float64
+	for i := range want {
+		d := got[i] - want[i]
+		sum += d * d
+	}
+
+	return math.Sqrt(sum / float64(len(want)))
+}
+
+func TestDeconvolveRecoversNoiseFreeSignal(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(39))
+	x := randomFloat64s(rng, 200)
+	kernel := []float64{0.5, 1, 0.25, -0.1}
+	observed := naiveConvolveFloat64(x, kernel)
+
+	for _, opts := range []DeconvOptions{
+		{SNR: 1e12},
+		{Method: DeconvTikhonov, Lambda: 1e-12},
+	} {
+		got := make([]float64, len(x))
+
+		err := Deconvolve64(got, observed, kernel, opts)
+		if err != nil {
+			t.Fatalf("Deconvolve64(%v) returned error: %v", opts.Method, err)
+		}
+
+		for i := range x {
+			assertApproxFloat64(t, got[i], x[i], 1e-5, "%v got[%d]", opts.Method, i)
+		}
+
+		got32 := make([]float32, len(x))
+
+		err = Deconvolve(got32, toFloat32(observed), toFloat32(kernel), opts)
+		if err != nil {
+			t.Fatalf("Deconvolve(%v) returned error: %v", opts.Method, err)
+		}
+
+		for i := range x {
+			assertApproxFloat64(t, float64(got32[i]), x[i], 1e-3, "%v got32[%d]", opts.Method, i)
+		}
+	}
+}
+
+func TestDeconvolveRegularizationSuppressesNoise(t *testing.T) {
+	t.Parallel()
+
+	const sigma = 0.05
+
+	rng := rand.New(rand.NewSource(40))
+	x := randomFloat64s(rng, 1000)
+
+	// A box blur has spectral zeros, so plain inversion amplifies noise.
+	kernel := []float64{0.2, 0.2, 0.2, 0.2, 0.2}
+	observed := naiveConvolveFloat64(x, kernel)
+
+	for i := range observed {
+		observed[i] += sigma * rng.NormFloat64()
+	}
+
+	errFor := func(opts DeconvOptions) float64 {
+		got := make([]float64, len(x))
+
+		err := Deconvolve64(got, observed, kernel, opts)
+		if err != nil {
+			t.Fatalf("Deconvolve64(%+v) returned error: %v", opts, err)
+		}
+
+		return rmsError(got, x)
+	}
+
+	inverse := errFor(DeconvOptions{SNR: 1e12})
+	noise := errFor(DeconvOptions{NoisePower: sigma * sigma})
+	tikhonov := errFor(DeconvOptions{Method: DeconvTikhonov, Lambda: 0.05})
+
+	if noise >= inverse/5 || tikhonov >= inverse/5 {
+		t.Fatalf("rms error: inverse=%v noise-power Wiener=%v Tikhonov=%v; want regularized << inverse",
+			inverse, noise, tikhonov)
+	}
+
+	// The Wiener estimate must also beat the blurred observation itself.
+	if blurred := rmsError(observed[2:], x); noise >= blurred {
+		t.Fatalf("Wiener rms error %v not below blurred %v", noise, blurred)
+	}
+}
+
+func TestDeconvolve2DFullAndSame(t *testing.T) {
+	t.Parallel()
+
+	const rows, cols, kRows, kCols = 24, 31, 3, 4
+
+	rng := rand.New(rand.NewSource(41))
+	img := randomFloat64s(rng, rows*cols)
+	kernel := []float64{
+		0.1, 0.3, 0.2, 0.05,
+		0.2, 1.0, 0.4, 0.1,
+		0.05, 0.2, 0.1, 0.02,
+	}
+
+	full := make([]float64, (rows+kRows-1)*(cols+kCols-1))
+
+	err := ConvolveReal2D64(full, img, rows, cols, kernel, kRows, kCols, Conv2DOptions{})
+	if err != nil {
+		t.Fatalf("ConvolveReal2D64() returned error: %v", err)
+	}
+
+	got := make([]float64, rows*cols)
+
+	err = Deconvolve2D64(got, full, rows+kRows-1, cols+kCols-1, kernel, kRows, kCols, DeconvOptions{SNR: 1e12})
+	if err != nil {
+		t.Fatalf("Deconvolve2D64(full) returned error: %v", err)
+	}
+
+	for i := range img {
+		assertApproxFloat64(t, got[i], img[i], 1e-6, "full got[%d]", i)
+	}
+
+	// Same-size blur: edge information is lost, so only require a clear
+	// improvement over the blurred image.
+	blurred := make([]float64, rows*cols)
+
+	err = ConvolveReal2D64(blurred, img, rows, cols, kernel, kRows, kCols, Conv2DOptions{Mode: ConvSame})
+	if err != nil {
+		t.Fatalf("ConvolveReal2D64(same) returned error: %v", err)
+	}
+
+	d, err := NewDeconvolver2D[float32, complex64](rows, cols, toFloat32(kernel), kRows, kCols,
+		DeconvOptions{Method: DeconvTikhonov, Mode: ConvSame, Lambda: 1e-3})
+	if err != nil {
+		t.Fatalf("NewDeconvolver2D() returned error: %v", err)
+	}
+
+	if r, c := d.OutputDims(); r != rows || c != cols {
+		t.Fatalf("OutputDims() = %d×%d, want %d×%d", r, c, rows, cols)
+	}
+
+	got32 := make([]float32, rows*cols)
+
+	err = d.Apply(got32, toFloat32(blurred))
+	if err != nil {
+		t.Fatalf("Apply() returned error: %v", err)
+	}
+
+	if e, b := rmsError(toFloat64s(got32), img), rmsError(blurred, img); e >= b/4 {
+		t.Fatalf("same-mode rms error %v, blurred %v; want a clear improvement", e, b)
+	}
+}
+
+func TestDeconvolveBelowNoiseFloorIsZero(t *testing.T) {
+	t.Parallel()
+
+	got := []float64{9, 9, 9}
+
+	err := Deconvolve64(got, []float64{0.01, -0.01, 0.01, 0.01}, []float64{1, 1}, DeconvOptions{NoisePower: 1})
+	if err != nil {
+		t.Fatalf("Deconvolve64() returned error: %v", err)
+	}
+
+	for i, v := range got {
+		if v != 0 {
+			t.Fatalf("got[%d] = %v, want 0", i, v)
+		}
+	}
+}
+
+func TestDeconvolveErrors(t *testing.T) {
+	t.Parallel()
+
+	observed := []float64{1, 2, 3, 4}
+	dst := make([]float64, 3)
+
+	tests := []struct {
+		name   string
+		kernel []float64
+		opts   DeconvOptions
+		want   error
+	}{
+		{"nil kernel", nil, DeconvOptions{SNR: 1}, ErrNilSlice},
+		{"empty kernel", []float64{}, DeconvOptions{SNR: 1}, ErrInvalidLength},
+		{"zero kernel", []float64{0, 0}, DeconvOptions{SNR: 1}, ErrInvalidParameter},
+		{"no snr", []float64{1, 1}, DeconvOptions{}, ErrInvalidParameter},
+		{"snr and noise", []float64{1, 1}, DeconvOptions{SNR: 1, NoisePower: 1}, ErrInvalidParameter},
+		{"zero lambda", []float64{1, 1}, DeconvOptions{Method: DeconvTikhonov}, ErrInvalidParameter},
+		{"valid mode", []float64{1, 1}, DeconvOptions{SNR: 1, Mode: ConvValid}, ErrInvalidMode},
+		{"bad method", []float64{1, 1}, DeconvOptions{Method: DeconvMethod(7)}, ErrInvalidMode},
+		{"long kernel", make([]float64, 5), DeconvOptions{SNR: 1}, ErrLengthMismatch},
+	}
+
+	for _, tc := range tests {
+		err := Deconvolve64(dst, observed, tc.kernel, tc.opts)
+		if !errors.Is(err, tc.want) {
+			t.Errorf("%s: error = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+
+	err := Deconvolve64(make([]float64, 4), observed, []float64{1, 1}, DeconvOptions{SNR: 1})
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("wrong dst error = %v, want ErrLengthMismatch", err)
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestDeconvolverApplyNoAllocs(t *testing.T) {
+	for _, opts := range []DeconvOptions{{SNR: 100}, {NoisePower: 0.01}} {
+		d, err := NewDeconvolver[float32, complex64]([]float32{1, 0.5, 0.25}, 300, opts)
+		if err != nil {
+			t.Fatalf("NewDeconvolver() returned error: %v", err)
+		}
+
+		observed := make([]float32, 300)
+		for i := range observed {
+			observed[i] = float32(i%7) - 3
+		}
+
+		dst := make([]float32, d.OutputLen())
+
+		assertNoAllocs(t, "Deconvolver.Apply", func() error {
+			return d.Apply(dst, observed)
+		})
+	}
+}
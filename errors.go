@@ -24,6 +24,24 @@ var (
 	// expected symmetry constraints (e.g., non-real DC or Nyquist bins).
 	ErrInvalidSpectrum = errors.New("algo-fft: invalid spectrum")
 
+	// ErrInvalidRadices is returned when PlanOptions.Radices does not
+	// describe a usable mixed-radix schedule for the plan size (the product
+	// differs from the size or a radix is unsupported).
+	ErrInvalidRadices = errors.New("algo-fft: invalid radix schedule")
+
+	// ErrInvalidMode is returned when an output mode argument (such as a
+	// ConvMode) is not one of the defined constants.
+	ErrInvalidMode = errors.New("algo-fft: invalid mode")
+
+	// ErrInvalidParameter is returned when a numeric option is out of range,
+	// such as a non-positive regularization weight.
+	ErrInvalidParameter = errors.New("algo-fft: invalid parameter")
+
+	// ErrNotInvertible is returned when a transform configuration cannot be
+	// inverted, such as an STFT window and hop that violate the
+	// nonzero overlap-add (NOLA) condition.
+	ErrNotInvertible = errors.New("algo-fft: transform is not invertible")
+
 	// ErrNotImplemented is returned for features that are not yet implemented.
 	// This is a temporary error used during development.
 	ErrNotImplemented = errors.New("algo-fft: not implemented")
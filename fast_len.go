@@ -0,0 +1,221 @@
+package algofft
+
+import (
+	"math"
+
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	"github.com/MeKo-Christian/algo-fft/internal/fft"
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+// TransformKind distinguishes complex and real transforms when choosing
+// transform lengths.
+type TransformKind uint8
+
+const (
+	// ComplexTransform selects lengths for complex-to-complex plans.
+	ComplexTransform TransformKind = iota
+
+	// RealTransform selects lengths for real plans, which must be even.
+	RealTransform
+)
+
+// fastLenPenalties is the cost of each odd radix in a mixed-radix
+// transform, as a factor on n·log2(n) relative to a power-of-two transform.
+// Power-of-two lengths run on SIMD codelets and radix-2/4 kernels; any
+// other length runs on the generic mixed-radix kernel, which costs about
+// fastLenMixedPenalty times as much per butterfly even for its radix-2
+// stages. The factors were calibrated against complex64 Forward timings;
+// radices above 5 are only considered with wisdom, and their factors are
+// extrapolated.
+var fastLenPenalties = [...]fastLenPrime{
+	{3, 1.1},
+	{5, 1.15},
+	{7, 1.3},
+	{11, 1.5},
+	{13, 1.6},
+}
+
+// fastLenPrime is an odd radix and its cost factor.
+type fastLenPrime struct {
+	prime  int
+	factor float64
+}
+
+const (
+	// fastLenMixedPenalty is the base cost factor of the mixed-radix
+	// kernel over power-of-two transforms.
+	fastLenMixedPenalty = 1.3
+
+	// fastLenMargin is the fraction of the power-of-two cost that a smooth
+	// length must undercut: estimates within the margin are too close to
+	// call, and the power of two is the safer choice.
+	fastLenMargin = 0.85
+
+	// fastLenMinMixed is the smallest complex transform length for which a
+	// mixed-radix plan is considered. Below it the power-of-two codelets
+	// beat every mixed-radix length, and only other codelet lengths are
+	// candidates.
+	fastLenMinMixed = 1024
+)
+
+// NextFastLen returns the transform length >= n with the lowest estimated
+// cost, for zero-padding convolutions and other transforms whose length is
+// free to grow.
+//
+// The default is the next power of two. A 2^a·3^b·5^c length, which the
+// planner executes with codelets or mixed-radix kernels instead of
+// Bluestein's algorithm, replaces it only when its estimated cost is
+// clearly lower, which in practice means a length with a codelet or one
+// well below the power of two, such as 9·2^k. Lengths whose prime factors
+// are at most 13 are also considered when the global wisdom cache (see
+// ImportWisdom) records a non-Bluestein algorithm for them. For
+// RealTransform only even lengths are returned, priced by their n/2-point
+// complex transform.
+func NextFastLen(n int, kind TransformKind) int {
+	n = max(n, 1)
+	if kind == RealTransform {
+		n = max(n, 2)
+	}
+
+	features := cpu.DetectFeatures()
+
+	limit := m.NextPowerOfTwo(n)
+	best := limit
+	bestCost := fastLenMargin * fastLenCost(complexLen(limit, kind), features)
+
+	consider := func(v int) {
+		if kind == RealTransform && v%2 != 0 {
+			return
+		}
+
+		if cost := fastLenCost(complexLen(v, kind), features); cost < bestCost || (cost == bestCost && v < best) {
+			best, bestCost = v, cost
+		}
+	}
+
+	// Every odd part has exactly one multiple by a power of two in
+	// [n, limit), because limit < 2n, so enumerating the odd smooth
+	// numbers below limit visits each candidate once: O(log^k limit)
+	// lengths instead of every length in the range.
+	candidate := func(odd int) (int, bool) {
+		v := odd
+		for v < n {
+			v *= 2
+		}
+
+		// An odd length is not a valid real length, but its double may be.
+		if v%2 != 0 && kind == RealTransform {
+			v *= 2
+		}
+
+		return v, v < limit
+	}
+
+	fastLenOddSmooth(limit, fastLenPenalties[:2], func(odd int) {
+		if v, ok := candidate(odd); ok {
+			consider(v)
+		}
+	})
+
+	if fft.DefaultWisdom.Len() > 0 {
+		mask := fft.CPUFeatureMask(features.HasSSE2, features.HasAVX2, features.HasAVX512, features.HasNEON)
+
+		fastLenOddSmooth(limit, fastLenPenalties[:], func(odd int) {
+			v, ok := candidate(odd)
+			if ok && !m.IsHighlyComposite(v) && fastLenInWisdom(complexLen(v, kind), mask) {
+				consider(v)
+			}
+		})
+	}
+
+	return best
+}
+
+// complexLen returns the length of the complex transform behind a
+// length-n transform of the given kind.
+func complexLen(n int, kind TransformKind) int {
+	if kind == RealTransform {
+		return max(n/2, 1)
+	}
+
+	return n
+}
+
+// fastLenCost estimates the work of a length-n complex transform as
+// n·log2(n) times the penalty of its kernel: 1 for powers of two and
+// codelets, and the mixed-radix penalty of its odd factors otherwise.
+// Lengths with other prime factors, and mixed-radix lengths below
+// fastLenMinMixed, cost +Inf.
+func fastLenCost(n int, features cpu.Features) float64 {
+	if n < 2 {
+		return 0
+	}
+
+	work := float64(n) * math.Log2(float64(n))
+
+	if m.IsPowerOf2(n) || fft.HasCodelet[complex64](n, features) {
+		return work
+	}
+
+	if n < fastLenMinMixed {
+		return math.Inf(1)
+	}
+
+	rest := n
+	for rest%2 == 0 {
+		rest /= 2
+	}
+
+	penalty := fastLenMixedPenalty
+
+	for _, p := range fastLenPenalties {
+		for rest%p.prime == 0 {
+			rest /= p.prime
+			penalty *= p.factor
+		}
+	}
+
+	if rest != 1 {
+		return math.Inf(1)
+	}
+
+	return work * penalty
+}
+
+// fastLenOddSmooth calls fn for every odd number below limit whose prime
+// factors are all in primes, including 1.
+func fastLenOddSmooth(limit int, primes []fastLenPrime, fn func(int)) {
+	var walk func(v, from int)
+
+	walk = func(v, from int) {
+		fn(v)
+
+		// The primes are sorted, so the first product past limit ends
+		// the branch.
+		for i := from; i < len(primes); i++ {
+			next := v * primes[i].prime
+			if next >= limit {
+				break
+			}
+
+			walk(next, i)
+		}
+	}
+
+	walk(1, 0)
+}
+
+// fastLenInWisdom reports whether the global wisdom cache records a
+// non-Bluestein algorithm for a length-n complex transform, in either
+// precision, for the CPU feature mask.
+func fastLenInWisdom(n int, mask uint64) bool {
+	for precision := range uint8(2) {
+		algorithm, ok := fft.DefaultWisdom.LookupWisdom(n, precision, mask)
+		if ok && algorithm != "bluestein" {
+			return true
+		}
+	}
+
+	return false
+}
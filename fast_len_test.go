@@ -0,0 +1,98 @@
+package algofft
+
+import (
+	"testing"
+
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+func TestNextFastLen(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n    int
+		kind TransformKind
+		want int
+	}{
+		{0, ComplexTransform, 1},
+		{1, ComplexTransform, 1},
+		{1, RealTransform, 2},
+		{7, ComplexTransform, 8},
+		{17, ComplexTransform, 20},     // codelet
+		{40, RealTransform, 40},        // 20-point complex codelet
+		{1000, ComplexTransform, 1024}, // 2^10 is cheaper than 2^3·5^3
+		{513, ComplexTransform, 1024},  // power-of-two codelets win below 1024
+		{1025, ComplexTransform, 1152}, // 9·2^7 clearly beats doubling to 2048
+		{1025, RealTransform, 2048},    // the 576-point complex half is too short
+		{1031, ComplexTransform, 1152}, // 1031 is prime
+		{8193, ComplexTransform, 9216},
+		{10001, ComplexTransform, 16384},   // 5·2^11 is not clearly cheaper
+		{100001, ComplexTransform, 131072}, // nor is 25·2^12
+		{243, ComplexTransform, 256},       // five radix-3 stages cost more than 2^8
+	}
+
+	for _, tc := range tests {
+		if got := NextFastLen(tc.n, tc.kind); got != tc.want {
+			t.Errorf("NextFastLen(%d, %d) = %d, want %d", tc.n, tc.kind, got, tc.want)
+		}
+	}
+}
+
+func TestNextFastLenProperties(t *testing.T) {
+	t.Parallel()
+
+	for n := 1; n <= 3000; n++ {
+		for _, kind := range []TransformKind{ComplexTransform, RealTransform} {
+			got := NextFastLen(n, kind)
+
+			if got < n || got > max(m.NextPowerOfTwo(n), 2) {
+				t.Fatalf("NextFastLen(%d, %d) = %d, outside [n, NextPowerOfTwo(n)]", n, kind, got)
+			}
+
+			if !m.IsHighlyComposite(got) && got != 1 {
+				t.Fatalf("NextFastLen(%d, %d) = %d, not 5-smooth", n, kind, got)
+			}
+
+			if kind == RealTransform && got%2 != 0 {
+				t.Fatalf("NextFastLen(%d, RealTransform) = %d, want even", n, got)
+			}
+		}
+	}
+}
+
+func TestFastLenOddSmoothEnumeratesCandidates(t *testing.T) {
+	t.Parallel()
+
+	const limit = 5000
+
+	seen := make(map[int]bool)
+
+	fastLenOddSmooth(limit, fastLenPenalties[:], func(v int) {
+		if seen[v] {
+			t.Fatalf("odd smooth number %d visited twice", v)
+		}
+
+		seen[v] = true
+	})
+
+	for v := 1; v < limit; v += 2 {
+		rest := v
+		for _, p := range fastLenPenalties {
+			for rest%p.prime == 0 {
+				rest /= p.prime
+			}
+		}
+
+		if want := rest == 1; seen[v] != want {
+			t.Fatalf("fastLenOddSmooth visited %d = %v, want %v", v, seen[v], want)
+		}
+	}
+
+	// The wisdom scan stays small even for the largest lengths.
+	count := 0
+	fastLenOddSmooth(1<<30, fastLenPenalties[:], func(int) { count++ })
+
+	if count > 10000 {
+		t.Fatalf("fastLenOddSmooth visited %d candidates below 2^30", count)
+	}
+}
@@ -0,0 +1,222 @@
+package algofft
+
+import "math"
+
+// FilterbankScale selects the perceptual frequency scale on which the
+// triangular filters of a Filterbank are evenly spaced.
+type FilterbankScale uint8
+
+const (
+	// ScaleMelHTK is the mel scale of HTK, 2595·log10(1 + f/700). It is the
+	// default.
+	ScaleMelHTK FilterbankScale = iota
+
+	// ScaleMelSlaney is the mel scale of Slaney's Auditory Toolbox and
+	// librosa: linear below 1 kHz and logarithmic above.
+	ScaleMelSlaney
+
+	// ScaleBark is Traunmüller's Bark scale, 26.81·f/(1960 + f) - 0.53.
+	ScaleBark
+)
+
+// String returns the name of the scale.
+func (s FilterbankScale) String() string {
+	switch s {
+	case ScaleMelHTK:
+		return "mel-htk"
+	case ScaleMelSlaney:
+		return "mel-slaney"
+	case ScaleBark:
+		return "bark"
+	default:
+		return "unknown"
+	}
+}
+
+// ToScale converts a frequency in Hz to the scale.
+func (s FilterbankScale) ToScale(hz float64) float64 {
+	switch s {
+	case ScaleMelSlaney:
+		if hz < slaneyBreakHz {
+			return hz / slaneyLinearHz
+		}
+
+		return slaneyBreakHz/slaneyLinearHz + math.Log(hz/slaneyBreakHz)/slaneyLogStep
+	case ScaleBark:
+		return 26.81*hz/(1960+hz) - 0.53
+	default:
+		return 2595 * math.Log10(1+hz/700)
+	}
+}
+
+// ToHz converts a value of the scale to a frequency in Hz.
+func (s FilterbankScale) ToHz(v float64) float64 {
+	switch s {
+	case ScaleMelSlaney:
+		if v < slaneyBreakHz/slaneyLinearHz {
+			return v * slaneyLinearHz
+		}
+
+		return slaneyBreakHz * math.Exp(slaneyLogStep*(v-slaneyBreakHz/slaneyLinearHz))
+	case ScaleBark:
+		return 1960 * (v + 0.53) / (26.28 - v)
+	default:
+		return 700 * (math.Pow(10, v/2595) - 1)
+	}
+}
+
+// Constants of the Slaney mel scale: 200/3 Hz per mel up to 1 kHz, then
+// 27 mels per factor of 6.4.
+const (
+	slaneyLinearHz = 200.0 / 3
+	slaneyBreakHz  = 1000.0
+)
+
+var slaneyLogStep = math.Log(6.4) / 27
+
+// defaultFilterbankFilters is the default number of filters.
+const defaultFilterbankFilters = 40
+
+// FilterbankOptions configures a Filterbank.
+type FilterbankOptions struct {
+	// Scale selects the mel (HTK, default, or Slaney) or Bark scale.
+	Scale FilterbankScale
+
+	// Filters is the number of triangular filters. Zero selects 40.
+	Filters int
+
+	// MinFreq and MaxFreq bound the filterbank in Hz. A zero MaxFreq
+	// selects the Nyquist frequency.
+	MinFreq, MaxFreq float64
+
+	// AreaNormalize scales each filter to unit area in Hz, as Slaney and
+	// librosa do, so that wide high-frequency filters do not dominate.
+	// Otherwise every filter peaks at 1, as in HTK.
+	AreaNormalize bool
+}
+
+// Filterbank is a bank of overlapping triangular filters that maps the
+// fftLen/2+1 bins of a PlanRealT power spectrum to band energies. Filter m
+// rises from edge m to its centre at edge m+1 and falls to edge m+2, with
+// Filters()+2 edges evenly spaced on the chosen scale.
+//
+// A Filterbank is immutable and safe for concurrent use.
+type Filterbank[F Float] struct {
+	bins    int
+	edges   []float64   // filter edges in Hz
+	start   []int       // first bin of each filter
+	weights [][]float64 // weights of each filter from its first bin
+}
+
+// NewFilterbank creates a filterbank for the power spectra of a real FFT
+// of length fftLen at the given sample rate in Hz.
+//
+// Example:
+//
+//	fb, err := algofft.NewFilterbank[float32](512, 16000, algofft.FilterbankOptions{Filters: 26})
+func NewFilterbank[F Float](fftLen int, sampleRate float64, opts FilterbankOptions) (*Filterbank[F], error) {
+	if fftLen < 1 || opts.Filters < 0 {
+		return nil, ErrInvalidLength
+	}
+
+	if opts.Scale > ScaleBark {
+		return nil, ErrInvalidMode
+	}
+
+	maxFreq := opts.MaxFreq
+	if maxFreq == 0 {
+		maxFreq = sampleRate / 2
+	}
+
+	if !(sampleRate > 0) || !(opts.MinFreq >= 0) || !(maxFreq > opts.MinFreq) || maxFreq > sampleRate/2 {
+		return nil, ErrInvalidParameter
+	}
+
+	filters := opts.Filters
+	if filters == 0 {
+		filters = defaultFilterbankFilters
+	}
+
+	lo, hi := opts.Scale.ToScale(opts.MinFreq), opts.Scale.ToScale(maxFreq)
+
+	fb := &Filterbank[F]{
+		bins:    fftLen/2 + 1,
+		edges:   make([]float64, filters+2),
+		start:   make([]int, filters),
+		weights: make([][]float64, filters),
+	}
+
+	for i := range fb.edges {
+		fb.edges[i] = opts.Scale.ToHz(lo + (hi-lo)*float64(i)/float64(filters+1))
+	}
+
+	binHz := sampleRate / float64(fftLen)
+
+	for m := range filters {
+		left, centre, right := fb.edges[m], fb.edges[m+1], fb.edges[m+2]
+
+		gain := 1.0
+		if opts.AreaNormalize {
+			gain = 2 / (right - left)
+		}
+
+		first := min(int(math.Ceil(left/binHz)), fb.bins)
+		last := min(int(math.Floor(right/binHz)), fb.bins-1)
+
+		fb.start[m] = first
+
+		for k := first; k <= last; k++ {
+			f := float64(k) * binHz
+			w := min((f-left)/(centre-left), (right-f)/(right-centre))
+			fb.weights[m] = append(fb.weights[m], max(w, 0)*gain)
+		}
+	}
+
+	return fb, nil
+}
+
+// Filters returns the number of filters.
+func (fb *Filterbank[F]) Filters() int {
+	return len(fb.start)
+}
+
+// Bins returns the number of spectrum bins, fftLen/2+1.
+func (fb *Filterbank[F]) Bins() int {
+	return fb.bins
+}
+
+// Centers returns the centre frequency of every filter in Hz.
+func (fb *Filterbank[F]) Centers() []float64 {
+	return append([]float64(nil), fb.edges[1:len(fb.edges)-1]...)
+}
+
+// Weights returns the weight of filter m at every spectrum bin.
+func (fb *Filterbank[F]) Weights(m int) []float64 {
+	w := make([]float64, fb.bins)
+	copy(w[fb.start[m]:], fb.weights[m])
+
+	return w
+}
+
+// Apply writes the energy of power, a spectrum of Bins() values, in every
+// filter to dst, which must have Filters() values.
+func (fb *Filterbank[F]) Apply(dst, power []F) error {
+	if dst == nil || power == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != len(fb.start) || len(power) != fb.bins {
+		return ErrLengthMismatch
+	}
+
+	for m, weights := range fb.weights {
+		var sum float64
+		for i, w := range weights {
+			sum += w * float64(power[fb.start[m]+i])
+		}
+
+		dst[m] = F(sum)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestFilterbankScales(t *testing.T) {
+	t.Parallel()
+
+	assertApproxFloat64(t, ScaleMelHTK.ToScale(700), 2595*math.Log10(2), 1e-9, "HTK mel(700)")
+	assertApproxFloat64(t, ScaleMelSlaney.ToScale(500), 7.5, 1e-12, "Slaney mel(500)")
+	assertApproxFloat64(t, ScaleMelSlaney.ToScale(1000), 15, 1e-12, "Slaney mel(1000)")
+	assertApproxFloat64(t, ScaleMelSlaney.ToScale(2000), 15+27*math.Ln2/math.Log(6.4), 1e-12, "Slaney mel(2000)")
+	assertApproxFloat64(t, ScaleBark.ToScale(1000), 26.81*1000/2960-0.53, 1e-12, "Bark(1000)")
+
+	for _, s := range []FilterbankScale{ScaleMelHTK, ScaleMelSlaney, ScaleBark} {
+		for _, hz := range []float64{0, 100, 999, 1000, 4000, 22050} {
+			assertApproxFloat64(t, s.ToHz(s.ToScale(hz)), hz, 1e-9*max(hz, 1), "%v round trip %g Hz", s, hz)
+		}
+	}
+}
+
+func TestFilterbankTriangles(t *testing.T) {
+	t.Parallel()
+
+	const n, fs = 1024, 16000.0
+
+	for _, scale := range []FilterbankScale{ScaleMelHTK, ScaleMelSlaney, ScaleBark} {
+		fb, err := NewFilterbank[float64](n, fs, FilterbankOptions{Scale: scale, Filters: 24, MinFreq: 50})
+		if err != nil {
+			t.Fatalf("NewFilterbank(%v) returned error: %v", scale, err)
+		}
+
+		if fb.Filters() != 24 || fb.Bins() != n/2+1 {
+			t.Fatalf("%v: Filters() = %d, Bins() = %d", scale, fb.Filters(), fb.Bins())
+		}
+
+		centers := fb.Centers()
+		for m := 1; m < len(centers); m++ {
+			if centers[m] <= centers[m-1] {
+				t.Fatalf("%v: centres not increasing at %d: %v", scale, m, centers)
+			}
+		}
+
+		// Between the first and last centres the triangles sum to 1.
+		sum := make([]float64, fb.Bins())
+		for m := range fb.Filters() {
+			for k, w := range fb.Weights(m) {
+				sum[k] += w
+			}
+		}
+
+		for k, v := range sum {
+			f := float64(k) * fs / n
+			if f >= centers[0] && f <= centers[len(centers)-1] {
+				assertApproxFloat64(t, v, 1, 1e-9, "%v: partition of unity at %g Hz", scale, f)
+			}
+		}
+
+		// Apply agrees with the dense weights.
+		power := make([]float64, fb.Bins())
+		for k := range power {
+			power[k] = 1 + math.Sin(float64(k))
+		}
+
+		got := make([]float64, fb.Filters())
+		if err := fb.Apply(got, power); err != nil {
+			t.Fatalf("Apply() returned error: %v", err)
+		}
+
+		for m := range got {
+			var want float64
+			for k, w := range fb.Weights(m) {
+				want += w * power[k]
+			}
+
+			assertApproxFloat64(t, got[m], want, 1e-9, "%v: Apply [%d]", scale, m)
+		}
+	}
+}
+
+func TestFilterbankAreaNormalize(t *testing.T) {
+	t.Parallel()
+
+	const n, fs = 4096, 22050.0
+
+	fb, err := NewFilterbank[float32](n, fs, FilterbankOptions{Scale: ScaleMelSlaney, Filters: 40, AreaNormalize: true})
+	if err != nil {
+		t.Fatalf("NewFilterbank() returned error: %v", err)
+	}
+
+	for m := range fb.Filters() {
+		var area float64
+		for _, w := range fb.Weights(m) {
+			area += w * fs / n
+		}
+
+		assertApproxFloat64(t, area, 1, 0.05, "area of filter %d", m)
+	}
+}
+
+func TestFilterbankErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewFilterbank[float32](0, 16000, FilterbankOptions{}); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("zero FFT length error = %v, want ErrInvalidLength", err)
+	}
+
+	if _, err := NewFilterbank[float32](512, 16000, FilterbankOptions{Scale: ScaleBark + 1}); !errors.Is(err, ErrInvalidMode) {
+		t.Fatalf("bad scale error = %v, want ErrInvalidMode", err)
+	}
+
+	if _, err := NewFilterbank[float32](512, 0, FilterbankOptions{}); !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("zero sample rate error = %v, want ErrInvalidParameter", err)
+	}
+
+	if _, err := NewFilterbank[float32](512, 16000, FilterbankOptions{MaxFreq: 9000}); !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("MaxFreq above Nyquist error = %v, want ErrInvalidParameter", err)
+	}
+
+	fb, err := NewFilterbank[float32](512, 16000, FilterbankOptions{})
+	if err != nil {
+		t.Fatalf("NewFilterbank() returned error: %v", err)
+	}
+
+	if fb.Filters() != 40 {
+		t.Fatalf("default Filters() = %d, want 40", fb.Filters())
+	}
+
+	if err := fb.Apply(make([]float32, 40), make([]float32, 256)); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("short power error = %v, want ErrLengthMismatch", err)
+	}
+
+	if got := ScaleBark.String(); got != "bark" {
+		t.Fatalf("ScaleBark.String() = %q", got)
+	}
+}
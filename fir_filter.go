@@ -0,0 +1,508 @@
+package algofft
+
+import (
+	"math"
+
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+)
+
+// FIRMethod selects the block convolution scheme used by a streaming FIR
+// filter.
+type FIRMethod uint8
+
+const (
+	// FIROverlapSave keeps the last len(taps)-1 input samples as history and
+	// discards the wrapped part of each circular convolution. It is the
+	// default.
+	FIROverlapSave FIRMethod = iota
+
+	// FIROverlapAdd convolves zero-padded input blocks and adds the
+	// len(taps)-1 sample tail of each block to the next one.
+	FIROverlapAdd
+)
+
+// String returns the name of the method.
+func (f FIRMethod) String() string {
+	switch f {
+	case FIROverlapSave:
+		return "overlap-save"
+	case FIROverlapAdd:
+		return "overlap-add"
+	default:
+		return "unknown"
+	}
+}
+
+// FIROptions configures a streaming FIR filter.
+type FIROptions struct {
+	// Method selects overlap-save (default) or overlap-add.
+	Method FIRMethod
+
+	// BlockSize is the minimum number of new input samples per FFT block.
+	// The actual block size is rounded up so that the FFT length is fast
+	// (see NextFastLen); use BlockSize() to query it. Zero selects the
+	// block size with the lowest cost per output sample that the cost
+	// model of NextFastLen estimates for the tap count.
+	BlockSize int
+
+	// ZeroLatency returns every output sample in the Process call that
+	// delivers its input sample. A trailing partial block is then
+	// transformed on every call, so small chunks cost a full block
+	// transform each. By default, partial blocks are buffered and the
+	// output is delayed by one block (see Latency), so every block is
+	// transformed exactly once whatever the chunk sizes.
+	ZeroLatency bool
+
+	// PlanOptions is passed to the FFT plan constructor, so planner modes
+	// and wisdom apply to the block transforms. Batch and Stride are
+	// ignored: the filter transforms one block at a time.
+	PlanOptions PlanOptions
+}
+
+// FIRFilter is a stateful FFT-based FIR filter for real-valued sample
+// streams.
+//
+// Process accepts chunks of any length and writes one output sample per
+// input sample, delayed by Latency() samples: the concatenated outputs
+// equal ConvolveReal over the concatenated input stream, preceded by
+// Latency() zeros, and Flush returns the remaining Latency()+len(taps)-1
+// samples. By default Latency() is BlockSize(), so the output is exactly
+// ConvolveReal shifted by one block; FIROptions.ZeroLatency removes the
+// shift.
+//
+// A block transform runs whenever a block fills up. With
+// FIROptions.ZeroLatency the latency is zero, and a transform also runs
+// once per Process call for a trailing partial block; feeding chunks that
+// are a multiple of BlockSize() avoids the extra transform.
+//
+// Process does not allocate. A FIRFilter must not be used by multiple
+// goroutines at the same time.
+type FIRFilter[F Float, C Complex] struct {
+	engine firEngine[F]
+
+	plan      *PlanRealT[F, C]
+	tapsFreq  []C
+	blockFreq []C
+}
+
+// NewFIRFilter creates an overlap-save FIRFilter for taps with an
+// automatically chosen block size and one block of latency.
+//
+// Example:
+//
+//	fir, err := algofft.NewFIRFilter[float32, complex64](taps)
+func NewFIRFilter[F Float, C Complex](taps []F) (*FIRFilter[F, C], error) {
+	return NewFIRFilterWithOptions[F, C](taps, FIROptions{})
+}
+
+// NewFIRFilterWithOptions creates a FIRFilter for taps with explicit options.
+func NewFIRFilterWithOptions[F Float, C Complex](taps []F, opts FIROptions) (*FIRFilter[F, C], error) {
+	fftLen, err := validateFIR(len(taps), taps == nil, opts, RealTransform)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := NewPlanRealTWithOptions[F, C](fftLen, firPlanOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	f := &FIRFilter[F, C]{
+		plan:      plan,
+		tapsFreq:  make([]C, plan.SpectrumLen()),
+		blockFreq: make([]C, plan.SpectrumLen()),
+	}
+	f.engine.init(len(taps), fftLen, opts, f.convolveBlock)
+
+	copy(f.engine.in, taps)
+
+	err = plan.Forward(f.tapsFreq, f.engine.in)
+	if err != nil {
+		return nil, err
+	}
+
+	clear(f.engine.in)
+
+	return f, nil
+}
+
+// TapsLen returns the number of filter taps.
+func (f *FIRFilter[F, C]) TapsLen() int {
+	return f.engine.taps
+}
+
+// BlockSize returns the number of new input samples per FFT block.
+func (f *FIRFilter[F, C]) BlockSize() int {
+	return f.engine.block
+}
+
+// Latency returns the delay of the output in samples: BlockSize(), or zero
+// with FIROptions.ZeroLatency.
+func (f *FIRFilter[F, C]) Latency() int {
+	return f.engine.latency()
+}
+
+// Process filters src and writes len(src) output samples to dst, delayed
+// by Latency() samples, carrying state across calls. dst and src may be
+// the same slice.
+func (f *FIRFilter[F, C]) Process(dst, src []F) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != len(src) {
+		return ErrLengthMismatch
+	}
+
+	return f.engine.process(dst, src)
+}
+
+// Flush writes the remaining Latency()+TapsLen()-1 output samples to dst,
+// as if the stream were followed by zeros, and resets the filter.
+func (f *FIRFilter[F, C]) Flush(dst []F) error {
+	return f.engine.flush(dst)
+}
+
+// Reset clears the filter history so the next Process call starts a new
+// stream.
+func (f *FIRFilter[F, C]) Reset() {
+	f.engine.reset()
+}
+
+func (f *FIRFilter[F, C]) convolveBlock(out, in []F) error {
+	err := f.plan.Forward(f.blockFreq, in)
+	if err != nil {
+		return err
+	}
+
+	complexMulInPlace(f.blockFreq, f.tapsFreq)
+
+	return f.plan.Inverse(out, f.blockFreq)
+}
+
+// ComplexFIRFilter is the complex-valued counterpart of FIRFilter: a
+// stateful FFT-based FIR filter with complex taps for complex sample
+// streams. It follows the same streaming, latency and allocation rules.
+type ComplexFIRFilter[T Complex] struct {
+	engine firEngine[T]
+
+	plan      *Plan[T]
+	tapsFreq  []T
+	blockFreq []T
+}
+
+// NewComplexFIRFilter creates an overlap-save ComplexFIRFilter for taps with
+// an automatically chosen block size.
+func NewComplexFIRFilter[T Complex](taps []T) (*ComplexFIRFilter[T], error) {
+	return NewComplexFIRFilterWithOptions(taps, FIROptions{})
+}
+
+// NewComplexFIRFilterWithOptions creates a ComplexFIRFilter for taps with
+// explicit options.
+func NewComplexFIRFilterWithOptions[T Complex](taps []T, opts FIROptions) (*ComplexFIRFilter[T], error) {
+	fftLen, err := validateFIR(len(taps), taps == nil, opts, ComplexTransform)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := NewPlanWithOptions[T](fftLen, firPlanOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	f := &ComplexFIRFilter[T]{
+		plan:      plan,
+		tapsFreq:  make([]T, fftLen),
+		blockFreq: make([]T, fftLen),
+	}
+	f.engine.init(len(taps), fftLen, opts, f.convolveBlock)
+
+	copy(f.engine.in, taps)
+
+	err = plan.Forward(f.tapsFreq, f.engine.in)
+	if err != nil {
+		return nil, err
+	}
+
+	clear(f.engine.in)
+
+	return f, nil
+}
+
+// TapsLen returns the number of filter taps.
+func (f *ComplexFIRFilter[T]) TapsLen() int {
+	return f.engine.taps
+}
+
+// BlockSize returns the number of new input samples per FFT block.
+func (f *ComplexFIRFilter[T]) BlockSize() int {
+	return f.engine.block
+}
+
+// Latency returns the delay of the output in samples: BlockSize(), or zero
+// with FIROptions.ZeroLatency.
+func (f *ComplexFIRFilter[T]) Latency() int {
+	return f.engine.latency()
+}
+
+// Process filters src and writes len(src) output samples to dst, delayed
+// by Latency() samples, carrying state across calls. dst and src may be
+// the same slice.
+func (f *ComplexFIRFilter[T]) Process(dst, src []T) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != len(src) {
+		return ErrLengthMismatch
+	}
+
+	return f.engine.process(dst, src)
+}
+
+// Flush writes the remaining Latency()+TapsLen()-1 output samples to dst,
+// as if the stream were followed by zeros, and resets the filter.
+func (f *ComplexFIRFilter[T]) Flush(dst []T) error {
+	return f.engine.flush(dst)
+}
+
+// Reset clears the filter history so the next Process call starts a new
+// stream.
+func (f *ComplexFIRFilter[T]) Reset() {
+	f.engine.reset()
+}
+
+func (f *ComplexFIRFilter[T]) convolveBlock(out, in []T) error {
+	err := f.plan.Forward(f.blockFreq, in)
+	if err != nil {
+		return err
+	}
+
+	complexMulInPlace(f.blockFreq, f.tapsFreq)
+
+	return f.plan.Inverse(out, f.blockFreq)
+}
+
+// firEngine implements the block bookkeeping shared by the real and complex
+// FIR filters. The transform-specific part is the convolve callback, which
+// circularly convolves an fftLen-sample block with the taps.
+//
+// Samples in in past the current fill position are always zero, so with
+// zero latency a partially filled block can be transformed to emit the
+// outputs that are already determined.
+type firEngine[S Scalar] struct {
+	taps        int
+	block       int
+	method      FIRMethod
+	zeroLatency bool
+
+	in      []S // overlap-save: taps-1 history samples, then the block
+	out     []S // circular convolution of in with the taps
+	overlap []S // overlap-add: tail carried into the current block
+	delayed []S // outputs of the last completed block, returned one block late
+
+	fill    int // input samples in the current block
+	emitted int // zero latency: output samples of the current block already returned
+
+	convolve func(out, in []S) error
+}
+
+func (e *firEngine[S]) init(taps, fftLen int, opts FIROptions, convolve func(out, in []S) error) {
+	e.taps = taps
+	e.block = fftLen - taps + 1
+	e.method = opts.Method
+	e.zeroLatency = opts.ZeroLatency
+	e.in = make([]S, fftLen)
+	e.out = make([]S, fftLen)
+	e.convolve = convolve
+
+	if e.method == FIROverlapAdd {
+		e.overlap = make([]S, fftLen)
+	}
+
+	if !e.zeroLatency {
+		e.delayed = make([]S, e.block)
+	}
+}
+
+func (e *firEngine[S]) latency() int {
+	if e.zeroLatency {
+		return 0
+	}
+
+	return e.block
+}
+
+// offset returns the position of the current block in in.
+func (e *firEngine[S]) offset() int {
+	if e.method == FIROverlapSave {
+		return e.taps - 1
+	}
+
+	return 0
+}
+
+// process filters src into dst. A nil src feeds zeros.
+func (e *firEngine[S]) process(dst, src []S) error {
+	offset := e.offset()
+
+	for pos := 0; pos < len(dst); {
+		n := min(e.block-e.fill, len(dst)-pos)
+
+		// Read the input before writing the output so dst may alias src.
+		if src != nil {
+			copy(e.in[offset+e.fill:offset+e.fill+n], src[pos:pos+n])
+		}
+
+		if !e.zeroLatency {
+			copy(dst[pos:pos+n], e.delayed[e.fill:e.fill+n])
+		}
+
+		e.fill += n
+
+		if e.zeroLatency {
+			err := e.convolve(e.out, e.in)
+			if err != nil {
+				return err
+			}
+
+			e.emit(dst[pos:pos+n], e.emitted, e.fill)
+			e.emitted = e.fill
+		} else if e.fill == e.block {
+			err := e.convolve(e.out, e.in)
+			if err != nil {
+				return err
+			}
+
+			e.emit(e.delayed, 0, e.block)
+		}
+
+		pos += n
+
+		if e.fill == e.block {
+			e.advance()
+		}
+	}
+
+	return nil
+}
+
+// emit writes the outputs from..to of the current block to dst.
+func (e *firEngine[S]) emit(dst []S, from, to int) {
+	offset := e.offset()
+
+	for i := from; i < to; i++ {
+		v := e.out[offset+i]
+		if e.overlap != nil {
+			v += e.overlap[i]
+		}
+
+		dst[i-from] = v
+	}
+}
+
+// advance moves the state from a completed block to the next one.
+func (e *firEngine[S]) advance() {
+	tail := e.taps - 1
+
+	if e.method == FIROverlapSave {
+		copy(e.in[:tail], e.in[e.block:e.block+tail])
+		clear(e.in[tail:])
+	} else {
+		for j := range tail {
+			e.overlap[j] = e.overlap[e.block+j] + e.out[e.block+j]
+		}
+
+		clear(e.overlap[tail:])
+		clear(e.in)
+	}
+
+	e.fill = 0
+	e.emitted = 0
+}
+
+func (e *firEngine[S]) flush(dst []S) error {
+	if dst == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != e.latency()+e.taps-1 {
+		return ErrLengthMismatch
+	}
+
+	err := e.process(dst, nil)
+	if err != nil {
+		return err
+	}
+
+	e.reset()
+
+	return nil
+}
+
+func (e *firEngine[S]) reset() {
+	clear(e.in)
+	clear(e.out)
+	clear(e.overlap)
+	clear(e.delayed)
+
+	e.fill = 0
+	e.emitted = 0
+}
+
+// validateFIR checks the filter arguments and returns the FFT length.
+func validateFIR(taps int, isNil bool, opts FIROptions, kind TransformKind) (int, error) {
+	if isNil {
+		return 0, ErrNilSlice
+	}
+
+	if taps == 0 || opts.BlockSize < 0 {
+		return 0, ErrInvalidLength
+	}
+
+	if opts.Method > FIROverlapAdd {
+		return 0, ErrInvalidMode
+	}
+
+	return firFFTLen(taps, opts.BlockSize, kind), nil
+}
+
+// firPlanOptions returns the plan options for the block transforms, which
+// run one block per call, without the caller's Batch and Stride.
+func firPlanOptions(opts FIROptions) PlanOptions {
+	planOpts := opts.PlanOptions
+	planOpts.Batch = 0
+	planOpts.Stride = 0
+
+	return planOpts
+}
+
+// firMinFFTLen keeps the automatic block size from degenerating into tiny
+// transforms for short filters, where per-call overhead dominates.
+const firMinFFTLen = 64
+
+// firFFTLen returns the FFT length for a filter with the given tap count.
+// With blockSize > 0 it is the fast length holding a block of blockSize
+// samples. Otherwise it is the NextFastLen candidate, from 2·taps up to 32
+// times that, with the lowest cost per output sample under the cost model
+// of NextFastLen: fastLenCost(N) / (N-taps+1).
+func firFFTLen(taps, blockSize int, kind TransformKind) int {
+	if blockSize > 0 {
+		return NextFastLen(blockSize+taps-1, kind)
+	}
+
+	features := cpu.DetectFeatures()
+	first := max(2*taps, firMinFFTLen)
+	best, bestCost := 0, math.Inf(1)
+
+	for n := first; n <= first<<5; n += max(n/8, 1) {
+		v := NextFastLen(n, kind)
+
+		cost := fastLenCost(complexLen(v, kind), features) / float64(v-taps+1)
+		if cost < bestCost {
+			best, bestCost = v, cost
+		}
+	}
+
+	return best
+}
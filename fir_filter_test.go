@@ -0,0 +1,346 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// streamChunks feeds src through process in random-length chunks and
+// returns the concatenated output.
+func streamChunks[S any](t *testing.T, rng *rand.Rand, src []S, process func(dst, src []S) error) []S {
+	t.Helper()
+
+	out := make([]S, len(src))
+
+	for pos := 0; pos < len(src); {
+		n := min(1+rng.Intn(150), len(src)-pos)
+
+		err := process(out[pos:pos+n], src[pos:pos+n])
+		if err != nil {
+			t.Fatalf("Process(len=%d) returned error: %v", n, err)
+		}
+
+		pos += n
+	}
+
+	return out
+}
+
+func TestFIRFilterMatchesConvolveReal(t *testing.T) {
+	t.Parallel()
+
+	for _, method := range []FIRMethod{FIROverlapSave, FIROverlapAdd} {
+		for _, opts := range []FIROptions{
+			{Method: method},
+			{Method: method, BlockSize: 16},
+			{Method: method, ZeroLatency: true},
+			{Method: method, BlockSize: 16, ZeroLatency: true},
+		} {
+			rng := rand.New(rand.NewSource(33))
+			taps := make([]float32, 37)
+			signal := make([]float32, 1000)
+
+			for i := range taps {
+				taps[i] = rng.Float32()*2 - 1
+			}
+
+			for i := range signal {
+				signal[i] = rng.Float32()*2 - 1
+			}
+
+			want := make([]float32, len(signal)+len(taps)-1)
+
+			err := ConvolveReal(want, signal, taps)
+			if err != nil {
+				t.Fatalf("ConvolveReal() returned error: %v", err)
+			}
+
+			fir, err := NewFIRFilterWithOptions[float32, complex64](taps, opts)
+			if err != nil {
+				t.Fatalf("NewFIRFilterWithOptions(%+v) returned error: %v", opts, err)
+			}
+
+			latency := fir.Latency()
+			if want := fir.BlockSize(); opts.ZeroLatency {
+				want = 0
+			} else if latency != want {
+				t.Fatalf("%+v: Latency() = %d, want %d", opts, latency, want)
+			}
+
+			got := streamChunks(t, rng, signal, fir.Process)
+			tail := make([]float32, latency+fir.TapsLen()-1)
+
+			err = fir.Flush(tail)
+			if err != nil {
+				t.Fatalf("Flush() returned error: %v", err)
+			}
+
+			got = append(got, tail...)
+
+			for i := range latency {
+				if got[i] != 0 {
+					t.Fatalf("%+v: got[%d]=%v before the latency elapsed, want 0", opts, i, got[i])
+				}
+			}
+
+			for i := range want {
+				if diff := math.Abs(float64(got[latency+i] - want[i])); diff > 1e-4 {
+					t.Fatalf("%+v block=%d: got[%d]=%v want %v (diff=%v)",
+						opts, fir.BlockSize(), latency+i, got[latency+i], want[i], diff)
+				}
+			}
+		}
+	}
+}
+
+func TestFIRFilterDefaultIsConvolveRealShiftedByLatency(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(34))
+	taps := randomFloat64s(rng, 21)
+	signal := randomFloat64s(rng, 500)
+
+	want := make([]float64, len(signal)+len(taps)-1)
+	if err := ConvolveReal64(want, signal, taps); err != nil {
+		t.Fatalf("ConvolveReal64() returned error: %v", err)
+	}
+
+	// Batch and Stride do not apply to the per-block transforms.
+	fir, err := NewFIRFilterWithOptions[float64, complex128](taps, FIROptions{
+		PlanOptions: PlanOptions{Batch: 4, Stride: 1024},
+	})
+	if err != nil {
+		t.Fatalf("NewFIRFilterWithOptions(Batch) returned error: %v", err)
+	}
+
+	if fir.Latency() != fir.BlockSize() {
+		t.Fatalf("default Latency() = %d, want BlockSize() = %d", fir.Latency(), fir.BlockSize())
+	}
+
+	got := make([]float64, len(signal)+fir.Latency()+len(taps)-1)
+	if err := fir.Process(got[:len(signal)], signal); err != nil {
+		t.Fatalf("Process() returned error: %v", err)
+	}
+
+	if err := fir.Flush(got[len(signal):]); err != nil {
+		t.Fatalf("Flush() returned error: %v", err)
+	}
+
+	for i, v := range got {
+		expected := 0.0
+		if i >= fir.Latency() {
+			expected = want[i-fir.Latency()]
+		}
+
+		assertApproxFloat64(t, v, expected, 1e-10, "got[%d]", i)
+	}
+
+	c, err := NewComplexFIRFilterWithOptions([]complex64{1, 2}, FIROptions{
+		PlanOptions: PlanOptions{Batch: 4},
+	})
+	if err != nil || c.Process(make([]complex64, 10), make([]complex64, 10)) != nil {
+		t.Fatalf("ComplexFIRFilter with Batch plan options failed: %v", err)
+	}
+}
+
+func TestFIRFilterFloat64ResetStartsNewStream(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(34))
+	taps := make([]float64, 100)
+	signal := make([]float64, 700)
+
+	for i := range taps {
+		taps[i] = rng.Float64()*2 - 1
+	}
+
+	for i := range signal {
+		signal[i] = rng.Float64()*2 - 1
+	}
+
+	want := naiveConvolveFloat64(signal, taps)
+
+	fir, err := NewFIRFilterWithOptions[float64, complex128](taps, FIROptions{Method: FIROverlapAdd, ZeroLatency: true})
+	if err != nil {
+		t.Fatalf("NewFIRFilterWithOptions() returned error: %v", err)
+	}
+
+	// Pollute the state, then reset.
+	_ = streamChunks(t, rng, signal[:123], fir.Process)
+	fir.Reset()
+
+	got := streamChunks(t, rng, signal, fir.Process)
+
+	for i := range got {
+		assertApproxFloat64(t, got[i], want[i], 1e-10, "got[%d]", i)
+	}
+}
+
+func TestComplexFIRFilterMatchesConvolve128(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(35))
+	taps := make([]complex128, 20)
+	signal := make([]complex128, 500)
+
+	for i := range taps {
+		taps[i] = complex(rng.Float64()*2-1, rng.Float64()*2-1)
+	}
+
+	for i := range signal {
+		signal[i] = complex(rng.Float64()*2-1, rng.Float64()*2-1)
+	}
+
+	want := naiveConvolveComplex128(signal, taps)
+
+	fir, err := NewComplexFIRFilter(taps)
+	if err != nil {
+		t.Fatalf("NewComplexFIRFilter() returned error: %v", err)
+	}
+
+	// Process in place to check that dst may alias src.
+	got := append([]complex128(nil), signal...)
+	_ = streamChunks(t, rng, got, func(dst, src []complex128) error {
+		return fir.Process(src, src)
+	})
+
+	tail := make([]complex128, fir.Latency()+len(taps)-1)
+
+	err = fir.Flush(tail)
+	if err != nil {
+		t.Fatalf("Flush() returned error: %v", err)
+	}
+
+	got = append(got, tail...)[fir.Latency():]
+
+	for i := range want {
+		assertApproxComplex128Tolf(t, got[i], want[i], 1e-10, "got[%d]", i)
+	}
+}
+
+func TestFIRFilterErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewFIRFilter[float32, complex64](nil)
+	if !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("NewFIRFilter(nil) error = %v, want ErrNilSlice", err)
+	}
+
+	_, err = NewFIRFilterWithOptions[float32, complex64]([]float32{1}, FIROptions{Method: FIRMethod(9)})
+	if !errors.Is(err, ErrInvalidMode) {
+		t.Fatalf("invalid method error = %v, want ErrInvalidMode", err)
+	}
+
+	_, err = NewComplexFIRFilter([]complex64{})
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("NewComplexFIRFilter(empty) error = %v, want ErrInvalidLength", err)
+	}
+
+	fir, err := NewFIRFilter[float32, complex64]([]float32{1, 2, 3})
+	if err != nil {
+		t.Fatalf("NewFIRFilter() returned error: %v", err)
+	}
+
+	err = fir.Process(make([]float32, 3), make([]float32, 4))
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("Process(mismatch) error = %v, want ErrLengthMismatch", err)
+	}
+
+	err = fir.Flush(make([]float32, 2))
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("Flush(wrong length) error = %v, want ErrLengthMismatch", err)
+	}
+}
+
+func TestFIRFFTLen(t *testing.T) {
+	t.Parallel()
+
+	if got, want := firFFTLen(37, 16, RealTransform), NextFastLen(52, RealTransform); got != want {
+		t.Fatalf("firFFTLen(37, 16) = %d, want %d", got, want)
+	}
+
+	for _, kind := range []TransformKind{ComplexTransform, RealTransform} {
+		for _, taps := range []int{1, 10, 255, 4000} {
+			n := firFFTLen(taps, 0, kind)
+			if n < 2*taps || n < firMinFFTLen || NextFastLen(n, kind) != n {
+				t.Fatalf("firFFTLen(%d, 0, %d) = %d, want a fast length >= max(2*taps, %d)", taps, kind, n, firMinFFTLen)
+			}
+		}
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestFIRFilterProcessNoAllocs(t *testing.T) {
+	taps := make([]float32, 64)
+	for i := range taps {
+		taps[i] = float32(i%5) - 2
+	}
+
+	fir, err := NewFIRFilter[float32, complex64](taps)
+	if err != nil {
+		t.Fatalf("NewFIRFilter() returned error: %v", err)
+	}
+
+	buf := make([]float32, 333)
+
+	assertNoAllocs(t, "FIRFilter.Process", func() error {
+		return fir.Process(buf, buf)
+	})
+
+	fir, err = NewFIRFilterWithOptions[float32, complex64](taps, FIROptions{ZeroLatency: true})
+	if err != nil {
+		t.Fatalf("NewFIRFilterWithOptions() returned error: %v", err)
+	}
+
+	assertNoAllocs(t, "FIRFilter.Process (zero latency)", func() error {
+		return fir.Process(buf, buf)
+	})
+}
+
+func TestFIRFilterSmallChunksTransformOncePerBlock(t *testing.T) {
+	t.Parallel()
+
+	taps := []float64{0.5, -1, 0.25, 2}
+	signal := randomFloat64s(rand.New(rand.NewSource(36)), 300)
+
+	fir, err := NewFIRFilterWithOptions[float64, complex128](taps, FIROptions{BlockSize: 29})
+	if err != nil {
+		t.Fatalf("NewFIRFilterWithOptions() returned error: %v", err)
+	}
+
+	transforms := 0
+	convolve := fir.engine.convolve
+	fir.engine.convolve = func(out, in []float64) error {
+		transforms++
+		return convolve(out, in)
+	}
+
+	got := make([]float64, len(signal))
+	for i := range signal {
+		if err := fir.Process(got[i:i+1], signal[i:i+1]); err != nil {
+			t.Fatalf("Process(sample %d) returned error: %v", i, err)
+		}
+	}
+
+	if want := len(signal) / fir.BlockSize(); transforms != want {
+		t.Fatalf("%d one-sample calls ran %d block transforms, want %d", len(signal), transforms, want)
+	}
+
+	want := naiveConvolveFloat64(signal, taps)
+	for i := fir.Latency(); i < len(got); i++ {
+		assertApproxFloat64(t, got[i], want[i-fir.Latency()], 1e-12, "got[%d]", i)
+	}
+}
+
+func naiveConvolveFloat64(a, b []float64) []float64 {
+	out := make([]float64, len(a)+len(b)-1)
+	for i := range a {
+		for j := range b {
+			out[i+j] += a[i] * b[j]
+		}
+	}
+
+	return out
+}
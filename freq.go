@@ -0,0 +1,45 @@
+package algofft
+
+// FFTFreq returns the frequencies of the n bins of a length-n FFT with
+// sample spacing d, in FFT order: 0, 1, …, ceil(n/2)-1, -floor(n/2), …, -1,
+// divided by n·d, as numpy.fft.fftfreq. For odd n there is no Nyquist bin;
+// for even n the Nyquist bin is reported as negative. Pass d = 1/fs for
+// frequencies in Hz. It returns nil for n < 1.
+func FFTFreq(n int, d float64) []float64 {
+	if n < 1 {
+		return nil
+	}
+
+	freqs := make([]float64, n)
+	scale := float64(n) * d
+
+	for k := range freqs {
+		m := k
+		if k > (n-1)/2 {
+			m = k - n
+		}
+
+		freqs[k] = float64(m) / scale
+	}
+
+	return freqs
+}
+
+// RFFTFreq returns the frequencies of the n/2+1 bins of a length-n real
+// FFT with sample spacing d: 0, 1, …, n/2, divided by n·d, as
+// numpy.fft.rfftfreq. The last bin is the Nyquist frequency only for even
+// n. It returns nil for n < 1.
+func RFFTFreq(n int, d float64) []float64 {
+	if n < 1 {
+		return nil
+	}
+
+	freqs := make([]float64, n/2+1)
+	scale := float64(n) * d
+
+	for k := range freqs {
+		freqs[k] = float64(k) / scale
+	}
+
+	return freqs
+}
@@ -45,6 +45,9 @@ var (
 	DefaultWisdom           = planner.DefaultWisdom
 	NewWisdom               = planner.NewWisdom
 	CPUFeatureMask          = planner.CPUFeatureMask
+	ValidMixedRadixSchedule = planner.ValidMixedRadixSchedule
+	MixedRadixAlgorithmName = planner.MixedRadixAlgorithmName
+	FormatRadices           = planner.FormatRadices
 )
 
 // Wrapper functions for generic planner functions.
@@ -63,6 +66,12 @@ type DecomposeStrategy = transform.DecomposeStrategy
 // Note: PlanDecomposition is non-generic so can be assigned directly.
 var PlanDecomposition = transform.PlanDecomposition
 
+// RecursiveCodeletSizes and RecursiveCacheSize are the decomposition inputs
+// plans use for KernelRecursive (leaf codelet sizes, L1 cache estimate).
+var RecursiveCodeletSizes = []int{4, 8, 16, 32, 64, 128, 256, 512}
+
+const RecursiveCacheSize = 32768
+
 func TwiddleFactorsRecursive[T Complex](strategy *DecomposeStrategy) []T {
 	return transform.TwiddleFactorsRecursive[T](strategy)
 }
@@ -2,6 +2,7 @@ package fft
 
 import (
 	"runtime"
+	"slices"
 	"sort"
 	"time"
 
@@ -31,10 +32,26 @@ type WisdomRecorder interface {
 // MeasureResult holds the benchmark result for a single strategy.
 type MeasureResult struct {
 	Strategy  KernelStrategy
+	Radices   []int // Mixed-radix factor order (nil for other strategies)
 	Algorithm string
 	NsPerOp   float64
 }
 
+// measureCandidate is one implementation the planner times for a size:
+// a kernel strategy, or a mixed-radix kernel with an explicit factor order.
+type measureCandidate struct {
+	strategy KernelStrategy
+	radices  []int
+}
+
+func (c measureCandidate) algorithm() string {
+	if c.radices != nil {
+		return MixedRadixAlgorithmName(c.radices)
+	}
+
+	return planner.StrategyToAlgorithmName(c.strategy)
+}
+
 // measureConfig holds configuration for benchmarking based on planner mode.
 type measureConfig struct {
 	warmup int // Number of warmup iterations
@@ -60,10 +77,19 @@ func getMeasureConfig(mode PlannerMode) measureConfig {
 }
 
 // selectStrategiesToTest returns the strategies to benchmark based on planner mode.
+//
+// For non-power-of-two sizes whose prime factors all fit a mixed-radix
+// butterfly, KernelDIT stands for the mixed-radix kernel (expanded into
+// several factor orders by mixedRadixOrders) and is compared against
+// recursive decomposition and Bluestein.
 func selectStrategiesToTest(mode PlannerMode, n int) []KernelStrategy {
-	// For non-power-of-two sizes, only Bluestein is available
-	if !m.IsPowerOf2(n) && !m.IsHighlyComposite(n) {
-		return []KernelStrategy{KernelBluestein}
+	if !m.IsPowerOf2(n) {
+		if mixedRadixPrimes(n) == nil {
+			// Only Bluestein handles large prime factors efficiently
+			return []KernelStrategy{KernelBluestein}
+		}
+
+		return []KernelStrategy{KernelDIT, KernelRecursive, KernelBluestein}
 	}
 
 	switch mode {
@@ -84,6 +110,117 @@ func selectStrategiesToTest(mode PlannerMode, n int) []KernelStrategy {
 	return []KernelStrategy{KernelDIT, KernelStockham}
 }
 
+// selectCandidatesToTest expands the strategies for n into benchmark candidates.
+func selectCandidatesToTest(mode PlannerMode, n int) []measureCandidate {
+	strategies := selectStrategiesToTest(mode, n)
+	candidates := make([]measureCandidate, 0, len(strategies))
+
+	for _, strategy := range strategies {
+		if strategy == KernelDIT && !m.IsPowerOf2(n) {
+			for _, radices := range mixedRadixOrders(mode, n) {
+				candidates = append(candidates, measureCandidate{strategy: KernelDIT, radices: radices})
+			}
+
+			continue
+		}
+
+		candidates = append(candidates, measureCandidate{strategy: strategy})
+	}
+
+	return candidates
+}
+
+// mixedRadixOrders returns the mixed-radix factor orders to benchmark for n,
+// most promising first: the default greedy schedule, then the prime factors
+// packed into radices of at most 16, 8 and 4, each in descending and
+// ascending order. Measure tries 3 orders, Patient 6, Exhaustive all.
+func mixedRadixOrders(mode PlannerMode, n int) [][]int {
+	primes := mixedRadixPrimes(n)
+	if primes == nil {
+		return nil
+	}
+
+	var orders [][]int
+
+	add := func(radices []int) {
+		if !ValidMixedRadixSchedule(n, radices) {
+			return
+		}
+
+		for _, existing := range orders {
+			if slices.Equal(existing, radices) {
+				return
+			}
+		}
+
+		orders = append(orders, radices)
+	}
+
+	add(MixedRadixSchedule(n))
+
+	for _, limit := range []int{16, 8, 4} {
+		packed := packRadices(primes, limit)
+		add(packed)
+
+		reversed := slices.Clone(packed)
+		slices.Reverse(reversed)
+		add(reversed)
+	}
+
+	limit := len(orders)
+
+	switch mode {
+	case PlannerEstimate, PlannerMeasure:
+		limit = min(limit, 3)
+	case PlannerPatient:
+		limit = min(limit, 6)
+	case PlannerExhaustive:
+	}
+
+	return orders[:limit]
+}
+
+// mixedRadixPrimes returns the prime factors of n in descending order, or nil
+// if n < 2 or a factor exceeds the largest supported radix.
+func mixedRadixPrimes(n int) []int {
+	primes := m.Factorize(n)
+	if len(primes) == 0 || primes[len(primes)-1] > MixedRadixMaxRadix {
+		return nil
+	}
+
+	slices.Reverse(primes)
+
+	return primes
+}
+
+// packRadices groups descending prime factors into radices no larger than
+// limit (first-fit decreasing) and returns them largest first. Primes above
+// limit form their own radix.
+func packRadices(primes []int, limit int) []int {
+	radices := make([]int, 0, len(primes))
+
+	for _, p := range primes {
+		placed := false
+
+		for i, r := range radices {
+			if r*p <= limit {
+				radices[i] = r * p
+				placed = true
+
+				break
+			}
+		}
+
+		if !placed {
+			radices = append(radices, p)
+		}
+	}
+
+	slices.SortFunc(radices, func(a, b int) int { return b - a })
+
+	return radices
+}
+
 // MeasureAndSelect benchmarks multiple strategies and returns the best one.
 // It optionally records the result to the provided wisdom recorder.
 func MeasureAndSelect[T Complex](
@@ -98,25 +235,32 @@ func MeasureAndSelect[T Complex](
 		return estimateWithStrategy[T](n, features, forcedStrategy)
 	}
 
-	strategies := selectStrategiesToTest(mode, n)
-	if len(strategies) == 0 {
+	// Straight-line codelets beat the generic kernels at the small
+	// non-power-of-two sizes they cover, so there is nothing to measure.
+	if !m.IsPowerOf2(n) && HasCodelet[T](n, features) {
 		return estimateWithStrategy[T](n, features, KernelAuto)
 	}
 
-	// Single strategy? Just use it directly
-	if len(strategies) == 1 {
-		return estimateWithStrategy[T](n, features, strategies[0])
+	candidates := selectCandidatesToTest(mode, n)
+	if len(candidates) == 0 {
+		return estimateWithStrategy[T](n, features, KernelAuto)
+	}
+
+	// Single candidate? Just use it directly
+	if len(candidates) == 1 {
+		return estimateWithCandidate[T](n, features, candidates[0])
 	}
 
 	config := getMeasureConfig(mode)
-	results := make([]MeasureResult, 0, len(strategies))
+	results := make([]MeasureResult, 0, len(candidates))
 
-	for _, strategy := range strategies {
-		elapsed := benchmarkStrategy[T](n, features, strategy, config)
+	for _, candidate := range candidates {
+		elapsed := benchmarkCandidate[T](n, features, candidate, config)
 		if elapsed > 0 {
 			results = append(results, MeasureResult{
-				Strategy:  strategy,
-				Algorithm: planner.StrategyToAlgorithmName(strategy),
+				Strategy:  candidate.strategy,
+				Radices:   candidate.radices,
+				Algorithm: candidate.algorithm(),
 				NsPerOp:   float64(elapsed.Nanoseconds()) / float64(config.iters),
 			})
 		}
@@ -134,10 +278,11 @@ func MeasureAndSelect[T Complex](
 
 	best := results[0]
 
-	// Record to wisdom if recorder is provided
+	// Record to wisdom if recorder is provided. Mixed-radix winners are
+	// stored with their factor order so PlannerEstimate can reproduce them.
 	recordToWisdom[T](n, features, wisdom, best.Algorithm)
 
-	return estimateWithStrategy[T](n, features, best.Strategy)
+	return estimateWithCandidate[T](n, features, measureCandidate{strategy: best.Strategy, radices: best.Radices})
 }
 
 func recordToWisdom[T Complex](n int, features cpu.Features, wisdom WisdomRecorder, algorithm string) {
@@ -183,25 +328,32 @@ func benchmarkStrategy[T Complex](
 	features cpu.Features,
 	strategy KernelStrategy,
 	config measureConfig,
+) time.Duration {
+	return benchmarkCandidate[T](n, features, measureCandidate{strategy: strategy}, config)
+}
+
+// benchmarkCandidate runs a micro-benchmark for a single candidate.
+// Returns the total elapsed time for config.iters iterations, or 0 if the candidate failed.
+func benchmarkCandidate[T Complex](
+	n int,
+	features cpu.Features,
+	candidate measureCandidate,
+	config measureConfig,
 ) time.Duration {
 	// Prepare data buffers
 	src := make([]T, n)
 	dst := make([]T, n)
-	twiddle := ComputeTwiddleFactors[T](n)
-	scratch := make([]T, n)
-	bitrev := ComputeBitReversalIndices(n)
 
 	// Initialize source with simple pattern (avoids random number generation)
 	for i := range src {
 		src[i] = complexFromFloat64[T](float64(i%16)/16.0, float64((i+1)%16)/16.0)
 	}
 
-	// Get kernel for this strategy
-	kernels := SelectKernelsWithStrategy[T](features, strategy)
+	forward := measureForward[T](n, features, candidate)
 
 	// Warmup: verify the kernel works and warm up CPU caches
 	for range config.warmup {
-		ok := kernels.Forward(dst, src, twiddle, scratch, bitrev)
+		ok := forward(dst, src)
 		if !ok {
 			return 0 // Strategy not implemented
 		}
@@ -214,12 +366,82 @@ func benchmarkStrategy[T Complex](
 	start := time.Now()
 
 	for range config.iters {
-		kernels.Forward(dst, src, twiddle, scratch, bitrev)
+		forward(dst, src)
 	}
 
 	return time.Since(start)
 }
 
+// measureForward prepares the tables a candidate needs and returns its
+// forward transform. Setup happens here so it is excluded from timing.
+func measureForward[T Complex](n int, features cpu.Features, candidate measureCandidate) func(dst, src []T) bool {
+	switch {
+	case candidate.radices != nil:
+		twiddle := ComputeTwiddleFactors[T](n)
+		scratch := make([]T, n)
+		kernels := MixedRadixKernels[T](candidate.radices)
+
+		return func(dst, src []T) bool {
+			return kernels.Forward(dst, src, twiddle, scratch, nil)
+		}
+	case candidate.strategy == KernelRecursive:
+		strategy := PlanDecomposition(n, RecursiveCodeletSizes, RecursiveCacheSize)
+		twiddle := TwiddleFactorsRecursive[T](strategy)
+		scratch := make([]T, ScratchSizeRecursive(strategy))
+		registry := GetRegistry[T]()
+
+		return func(dst, src []T) bool {
+			RecursiveForward(dst, src, strategy, twiddle, scratch, registry, features)
+			return true
+		}
+	case candidate.strategy == KernelBluestein:
+		size := m.NextPowerOfTwo(2*n - 1)
+		chirp := ComputeChirpSequence[T](n)
+		twiddle := ComputeTwiddleFactors[T](size)
+		bitrev := ComputeBitReversalIndices(size)
+		scratch := make([]T, size)
+		work := make([]T, size)
+		filter := ComputeBluesteinFilter(n, size, chirp, twiddle, bitrev, scratch)
+
+		return func(dst, src []T) bool {
+			for i := range n {
+				work[i] = src[i] * chirp[i]
+			}
+
+			clear(work[n:])
+			BluesteinConvolution(work, work, filter, twiddle, scratch, bitrev)
+
+			for i := range n {
+				dst[i] = work[i] * chirp[i]
+			}
+
+			return true
+		}
+	default:
+		twiddle := ComputeTwiddleFactors[T](n)
+		scratch := make([]T, n)
+		bitrev := ComputeBitReversalIndices(n)
+		kernels := SelectKernelsWithStrategy[T](features, candidate.strategy)
+
+		return func(dst, src []T) bool {
+			return kernels.Forward(dst, src, twiddle, scratch, bitrev)
+		}
+	}
+}
+
+// estimateWithCandidate creates a PlanEstimate for a measured candidate.
+func estimateWithCandidate[T Complex](n int, features cpu.Features, candidate measureCandidate) PlanEstimate[T] {
+	if candidate.radices != nil {
+		return PlanEstimate[T]{
+			Strategy:  KernelDIT,
+			Algorithm: candidate.algorithm(),
+			Radices:   candidate.radices,
+		}
+	}
+
+	return estimateWithStrategy[T](n, features, candidate.strategy)
+}
+
 // estimateWithStrategy creates a PlanEstimate for a specific strategy.
 func estimateWithStrategy[T Complex](
 	n int,
@@ -1,10 +1,12 @@
 package fft
 
 import (
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+	"github.com/MeKo-Christian/algo-fft/internal/reference"
 )
 
 // mockWisdomRecorder records wisdom entries for testing.
@@ -53,6 +55,12 @@ func TestSelectStrategiesToTest(t *testing.T) {
 			n:        1024,
 			expected: []KernelStrategy{KernelDIT, KernelStockham, KernelSixStep, KernelEightStep},
 		},
+		{
+			name:     "Composite non-power-of-two compares mixed-radix, recursive and Bluestein",
+			mode:     PlannerMeasure,
+			n:        384,
+			expected: []KernelStrategy{KernelDIT, KernelRecursive, KernelBluestein},
+		},
 		{
 			name:     "Prime size uses Bluestein only",
 			mode:     PlannerExhaustive,
@@ -80,6 +88,48 @@ func TestSelectStrategiesToTest(t *testing.T) {
 	}
 }
 
+func TestMixedRadixOrders(t *testing.T) {
+	t.Parallel()
+
+	orders := mixedRadixOrders(PlannerExhaustive, 384)
+	if len(orders) < 3 {
+		t.Fatalf("mixedRadixOrders(384) = %v, want several orders", orders)
+	}
+
+	found := false
+
+	for _, radices := range orders {
+		if !ValidMixedRadixSchedule(384, radices) {
+			t.Errorf("invalid order %v for 384", radices)
+		}
+
+		if slices.Equal(radices, []int{8, 8, 6}) {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Errorf("mixedRadixOrders(384) = %v, want to include [8 8 6]", orders)
+	}
+
+	if got := mixedRadixOrders(PlannerMeasure, 384); len(got) > 3 {
+		t.Errorf("PlannerMeasure tried %d orders, want at most 3", len(got))
+	}
+
+	// 1001 = 7·11·13 has no default schedule but fits generic butterflies.
+	if got := mixedRadixOrders(PlannerMeasure, 1001); len(got) == 0 {
+		t.Error("mixedRadixOrders(1001) returned no orders")
+	}
+
+	if got := mixedRadixOrders(PlannerMeasure, 17*3); got != nil {
+		t.Errorf("mixedRadixOrders(51) = %v, want nil", got)
+	}
+
+	if got := packRadices([]int{3, 2, 2, 2, 2, 2, 2, 2}, 8); !slices.Equal(got, []int{8, 8, 6}) {
+		t.Errorf("packRadices(384, 8) = %v, want [8 8 6]", got)
+	}
+}
+
 func TestGetMeasureConfig(t *testing.T) {
 	t.Parallel()
 
@@ -186,6 +236,87 @@ func TestMeasureAndSelect_RecordsToWisdom(t *testing.T) {
 	}
 }
 
+func TestMeasureAndSelect_NonPowerOfTwoRecordsFactorOrder(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.DetectFeatures()
+	recorder := &mockWisdomRecorder{}
+
+	estimate := MeasureAndSelect[complex128](384, features, PlannerMeasure, recorder, KernelAuto)
+
+	if len(recorder.entries) != 1 {
+		t.Fatalf("expected 1 wisdom entry, got %d", len(recorder.entries))
+	}
+
+	if got := recorder.entries[0].Algorithm; got != estimate.Algorithm {
+		t.Fatalf("recorded %q, estimate uses %q", got, estimate.Algorithm)
+	}
+
+	switch estimate.Strategy {
+	case KernelDIT:
+		if !ValidMixedRadixSchedule(384, estimate.Radices) {
+			t.Fatalf("mixed-radix winner has invalid radices %v", estimate.Radices)
+		}
+
+		if estimate.Algorithm != MixedRadixAlgorithmName(estimate.Radices) {
+			t.Fatalf("Algorithm = %q, want factor order %v", estimate.Algorithm, estimate.Radices)
+		}
+	case KernelRecursive, KernelBluestein:
+		if estimate.Radices != nil {
+			t.Fatalf("%v winner has radices %v", estimate.Strategy, estimate.Radices)
+		}
+	default:
+		t.Fatalf("unexpected strategy %v for n=384", estimate.Strategy)
+	}
+
+	// Estimating with the recorded wisdom must reproduce the measured choice.
+	again := EstimatePlan[complex128](384, features, recorder, KernelAuto)
+	if again.Algorithm != estimate.Algorithm || !slices.Equal(again.Radices, estimate.Radices) {
+		t.Fatalf("EstimatePlan from wisdom = %q %v, want %q %v",
+			again.Algorithm, again.Radices, estimate.Algorithm, estimate.Radices)
+	}
+}
+
+func TestBenchmarkCandidateNonPowerOfTwo(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.DetectFeatures()
+	config := measureConfig{warmup: 1, iters: 2}
+
+	for _, candidate := range []measureCandidate{
+		{strategy: KernelDIT, radices: []int{8, 8, 6}},
+		{strategy: KernelRecursive},
+		{strategy: KernelBluestein},
+	} {
+		if elapsed := benchmarkCandidate[complex64](384, features, candidate, config); elapsed <= 0 {
+			t.Errorf("benchmarkCandidate(%q) returned %v", candidate.algorithm(), elapsed)
+		}
+	}
+}
+
+func TestMeasureForwardCandidatesAgree(t *testing.T) {
+	t.Parallel()
+
+	const n = 360
+
+	features := cpu.DetectFeatures()
+	src := randomComplex128(n, 0x360)
+	want := reference.NaiveDFT128(src)
+
+	for _, candidate := range []measureCandidate{
+		{strategy: KernelDIT, radices: []int{10, 6, 6}},
+		{strategy: KernelRecursive},
+		{strategy: KernelBluestein},
+	} {
+		dst := make([]complex128, n)
+		if !measureForward[complex128](n, features, candidate)(dst, src) {
+			t.Fatalf("%s: forward failed", candidate.algorithm())
+		}
+
+		assertComplex128SliceClose(t, dst, want, n)
+	}
+}
+
 func TestMeasureAndSelect_ForcedStrategy(t *testing.T) {
 	t.Parallel()
 
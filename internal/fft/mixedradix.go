@@ -1,9 +1,17 @@
 package fft
 
-import "github.com/MeKo-Christian/algo-fft/internal/kernels"
+import (
+	"github.com/MeKo-Christian/algo-fft/internal/kernels"
+	"github.com/MeKo-Christian/algo-fft/internal/planner"
+)
 
 const mixedRadixMaxStages = 64
 
+// MixedRadixMaxRadix is the largest radix accepted in an explicit schedule.
+// Radices 2, 3, 4 and 5 use specialized butterflies; other radices up to this
+// limit use a direct small DFT built from the plan's twiddle table.
+const MixedRadixMaxRadix = planner.MaxMixedRadix
+
 func forwardMixedRadixComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
 	return mixedRadixForward[complex64](dst, src, twiddle, scratch, bitrev)
 }
@@ -21,14 +29,45 @@ func inverseMixedRadixComplex128(dst, src, twiddle, scratch []complex128, bitrev
 }
 
 func mixedRadixForward[T Complex](dst, src, twiddle, scratch []T, bitrev []int) bool {
-	return mixedRadixTransform(dst, src, twiddle, scratch, bitrev, false)
+	return mixedRadixTransform(dst, src, twiddle, scratch, bitrev, nil, false)
 }
 
 func mixedRadixInverse[T Complex](dst, src, twiddle, scratch []T, bitrev []int) bool {
-	return mixedRadixTransform(dst, src, twiddle, scratch, bitrev, true)
+	return mixedRadixTransform(dst, src, twiddle, scratch, bitrev, nil, true)
+}
+
+// MixedRadixKernels returns mixed-radix kernels bound to a fixed factor order.
+// radices[0] is the outermost (first) split. The schedule must satisfy
+// ValidMixedRadixSchedule for the transform length; the kernels report
+// failure for any other length.
+func MixedRadixKernels[T Complex](radices []int) Kernels[T] {
+	schedule := append([]int(nil), radices...)
+
+	return Kernels[T]{
+		Forward: func(dst, src, twiddle, scratch []T, bitrev []int) bool {
+			return mixedRadixTransform(dst, src, twiddle, scratch, bitrev, schedule, false)
+		},
+		Inverse: func(dst, src, twiddle, scratch []T, bitrev []int) bool {
+			return mixedRadixTransform(dst, src, twiddle, scratch, bitrev, schedule, true)
+		},
+	}
+}
+
+// MixedRadixSchedule returns the default factor order used for size n:
+// greedy radix 5, then 4, 3 and 2. Returns nil if n has prime factors other
+// than 2, 3 and 5.
+func MixedRadixSchedule(n int) []int {
+	var radices [mixedRadixMaxStages]int
+
+	count := mixedRadixSchedule(n, &radices)
+	if count == 0 {
+		return nil
+	}
+
+	return append([]int(nil), radices[:count]...)
 }
 
-func mixedRadixTransform[T Complex](dst, src, twiddle, scratch []T, bitrev []int, inverse bool) bool {
+func mixedRadixTransform[T Complex](dst, src, twiddle, scratch []T, bitrev []int, schedule []int, inverse bool) bool {
 	_ = bitrev
 
 	n := len(src)
@@ -47,7 +86,18 @@ func mixedRadixTransform[T Complex](dst, src, twiddle, scratch []T, bitrev []int
 
 	var radices [mixedRadixMaxStages]int
 
-	stageCount := mixedRadixSchedule(n, &radices)
+	var stageCount int
+
+	if schedule != nil {
+		if !ValidMixedRadixSchedule(n, schedule) {
+			return false
+		}
+
+		stageCount = copy(radices[:], schedule)
+	} else {
+		stageCount = mixedRadixSchedule(n, &radices)
+	}
+
 	if stageCount == 0 {
 		return false
 	}
@@ -260,7 +310,7 @@ func mixedRadixRecursivePingPongComplex64(dst, src, work []complex64, n, stride,
 			dst[3*span+k] = y3
 			dst[4*span+k] = y4
 		default:
-			return
+			mixedRadixButterflyGeneric(dst, input, k, span, radix, step, twiddle, inverse)
 		}
 	}
 }
@@ -390,7 +440,7 @@ func mixedRadixRecursivePingPongComplex128(dst, src, work []complex128, n, strid
 			dst[3*span+k] = y3
 			dst[4*span+k] = y4
 		default:
-			return
+			mixedRadixButterflyGeneric(dst, input, k, span, radix, step, twiddle, inverse)
 		}
 	}
 }
@@ -535,7 +585,40 @@ func mixedRadixRecursivePingPong[T Complex](dst, src, work []T, n, stride, step
 			dst[3*span+k] = y3
 			dst[4*span+k] = y4
 		default:
-			return
+			mixedRadixButterflyGeneric(dst, input, k, span, radix, step, twiddle, inverse)
 		}
 	}
 }
+
+// mixedRadixButterflyGeneric applies a twiddled radix-r DFT to column k.
+// The radix-r roots W_r^q equal W_n^(q·n/r) = twiddle[q·step·span], so no
+// extra tables are needed.
+func mixedRadixButterflyGeneric[T Complex](dst, input []T, k, span, radix, step int, twiddle []T, inverse bool) {
+	var a [MixedRadixMaxRadix]T
+
+	for j := range radix {
+		w := twiddle[j*k*step]
+		if inverse {
+			w = conj(w)
+		}
+
+		a[j] = w * input[j*span+k]
+	}
+
+	rootStride := step * span
+
+	for q := range radix {
+		var sum T
+
+		for j := range radix {
+			w := twiddle[(j*q%radix)*rootStride]
+			if inverse {
+				w = conj(w)
+			}
+
+			sum += a[j] * w
+		}
+
+		dst[q*span+k] = sum
+	}
+}
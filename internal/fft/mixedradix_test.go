@@ -93,6 +93,81 @@ func TestMixedRadixInverseMatchesReferenceComplex128(t *testing.T) {
 	}
 }
 
+func TestMixedRadixKernelsExplicitSchedule(t *testing.T) {
+	t.Parallel()
+
+	schedules := [][]int{
+		{8, 8, 6},
+		{6, 8, 8},
+		{2, 3, 4, 5},
+		{16, 4},
+		{7, 8},
+		{11, 3},
+		{13},
+	}
+
+	for _, radices := range schedules {
+		n := 1
+		for _, r := range radices {
+			n *= r
+		}
+
+		if !ValidMixedRadixSchedule(n, radices) {
+			t.Fatalf("ValidMixedRadixSchedule(%d, %v) = false", n, radices)
+		}
+
+		kernels := MixedRadixKernels[complex128](radices)
+		src := randomComplex128(n, 0x5EED+uint64(n))
+		fwd := make([]complex128, n)
+		dst := make([]complex128, n)
+		scratch := make([]complex128, n)
+		twiddle := ComputeTwiddleFactors[complex128](n)
+
+		if !kernels.Forward(fwd, src, twiddle, scratch, nil) {
+			t.Fatalf("forward failed for radices %v", radices)
+		}
+
+		assertComplex128SliceClose(t, fwd, reference.NaiveDFT128(src), n)
+
+		if !kernels.Inverse(dst, fwd, twiddle, scratch, nil) {
+			t.Fatalf("inverse failed for radices %v", radices)
+		}
+
+		assertComplex128SliceClose(t, dst, src, n)
+	}
+}
+
+func TestValidMixedRadixSchedule(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		n       int
+		radices []int
+		want    bool
+	}{
+		{384, []int{8, 8, 6}, true},
+		{384, []int{8, 8, 8}, false},
+		{34, []int{17, 2}, false},
+		{8, []int{1, 8}, false},
+		{8, nil, false},
+		{1, []int{1}, false},
+	}
+
+	for _, tc := range cases {
+		if got := ValidMixedRadixSchedule(tc.n, tc.radices); got != tc.want {
+			t.Errorf("ValidMixedRadixSchedule(%d, %v) = %v, want %v", tc.n, tc.radices, got, tc.want)
+		}
+	}
+
+	if got := MixedRadixSchedule(60); len(got) != 3 || got[0] != 5 || got[1] != 4 || got[2] != 3 {
+		t.Errorf("MixedRadixSchedule(60) = %v, want [5 4 3]", got)
+	}
+
+	if got := MixedRadixSchedule(14); got != nil {
+		t.Errorf("MixedRadixSchedule(14) = %v, want nil", got)
+	}
+}
+
 func BenchmarkMixedRadixForward_60(b *testing.B) {
 	benchmarkMixedRadixKernel(b, 60, mixedRadixForward[complex64])
 }
@@ -9,3 +9,9 @@ type Complex interface {
 type Float interface {
 	float32 | float64
 }
+
+// Scalar is a type constraint for element types of transforms that only need
+// addition and subtraction, such as the Walsh-Hadamard transform.
+type Scalar interface {
+	float32 | float64 | complex64 | complex128
+}
@@ -34,6 +34,8 @@ func GetRegistry[T Complex]() *planner.CodeletRegistry[T] {
 // This file registers all built-in codelets with the global registries.
 // Registration happens at init time so codelets are available when plans are created.
 
+//go:generate go run ../../cmd/gencodelets -sizes 6,10,12,15,20,24,48,60 -dir .
+
 //nolint:gochecknoinits
 func init() {
 	// Register complex64 DIT codelets
@@ -42,6 +44,10 @@ func init() {
 	// Register complex128 DIT codelets
 	registerDITCodelets128()
 
+	// Register generated straight-line codelets (see codelet_init_gen.go)
+	registerGeneratedCodelets64()
+	registerGeneratedCodelets128()
+
 	// Register NEON codelets (conditional on build tags)
 	registerNEONDITCodelets64()
 	registerNEONDITCodelets128()
@@ -0,0 +1,171 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// registerGeneratedCodelets64 registers the gencodelets complex64 codelets.
+func registerGeneratedCodelets64() {
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       6,
+		Forward:    wrapCodelet64(forwardDIT6GenComplex64),
+		Inverse:    wrapCodelet64(inverseDIT6GenComplex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit6_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       10,
+		Forward:    wrapCodelet64(forwardDIT10GenComplex64),
+		Inverse:    wrapCodelet64(inverseDIT10GenComplex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit10_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       12,
+		Forward:    wrapCodelet64(forwardDIT12GenComplex64),
+		Inverse:    wrapCodelet64(inverseDIT12GenComplex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit12_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       15,
+		Forward:    wrapCodelet64(forwardDIT15GenComplex64),
+		Inverse:    wrapCodelet64(inverseDIT15GenComplex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit15_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       20,
+		Forward:    wrapCodelet64(forwardDIT20GenComplex64),
+		Inverse:    wrapCodelet64(inverseDIT20GenComplex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit20_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       24,
+		Forward:    wrapCodelet64(forwardDIT24GenComplex64),
+		Inverse:    wrapCodelet64(inverseDIT24GenComplex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit24_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       48,
+		Forward:    wrapCodelet64(forwardDIT48GenComplex64),
+		Inverse:    wrapCodelet64(inverseDIT48GenComplex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit48_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+	Registry64.Register(CodeletEntry[complex64]{
+		Size:       60,
+		Forward:    wrapCodelet64(forwardDIT60GenComplex64),
+		Inverse:    wrapCodelet64(inverseDIT60GenComplex64),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit60_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+}
+
+// registerGeneratedCodelets128 registers the gencodelets complex128 codelets.
+func registerGeneratedCodelets128() {
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       6,
+		Forward:    wrapCodelet128(forwardDIT6GenComplex128),
+		Inverse:    wrapCodelet128(inverseDIT6GenComplex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit6_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       10,
+		Forward:    wrapCodelet128(forwardDIT10GenComplex128),
+		Inverse:    wrapCodelet128(inverseDIT10GenComplex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit10_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       12,
+		Forward:    wrapCodelet128(forwardDIT12GenComplex128),
+		Inverse:    wrapCodelet128(inverseDIT12GenComplex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit12_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       15,
+		Forward:    wrapCodelet128(forwardDIT15GenComplex128),
+		Inverse:    wrapCodelet128(inverseDIT15GenComplex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit15_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       20,
+		Forward:    wrapCodelet128(forwardDIT20GenComplex128),
+		Inverse:    wrapCodelet128(inverseDIT20GenComplex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit20_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       24,
+		Forward:    wrapCodelet128(forwardDIT24GenComplex128),
+		Inverse:    wrapCodelet128(inverseDIT24GenComplex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit24_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       48,
+		Forward:    wrapCodelet128(forwardDIT48GenComplex128),
+		Inverse:    wrapCodelet128(inverseDIT48GenComplex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit48_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+	Registry128.Register(CodeletEntry[complex128]{
+		Size:       60,
+		Forward:    wrapCodelet128(forwardDIT60GenComplex128),
+		Inverse:    wrapCodelet128(inverseDIT60GenComplex128),
+		Algorithm:  KernelDIT,
+		SIMDLevel:  SIMDNone,
+		Signature:  "dit60_gen_generic",
+		Priority:   5,   // Below hand-tuned codelets of the same size
+		BitrevFunc: nil, // Natural-order input
+	})
+}
@@ -82,12 +82,16 @@ func TestCodeletRegistrySizes(t *testing.T) {
 	t.Parallel()
 
 	sizes := Registry64.Sizes()
-	if len(sizes) != 13 {
-		t.Errorf("expected 13 registered sizes, got %d", len(sizes))
+	if len(sizes) != 21 {
+		t.Errorf("expected 21 registered sizes, got %d", len(sizes))
 	}
 
-	// Check that all expected sizes are present
+	// Check that all expected sizes are present, including the generated
+	// non-power-of-two codelets.
 	expected := map[int]bool{4: true, 8: true, 16: true, 32: true, 64: true, 128: true, 256: true, 512: true, 1024: true, 2048: true, 4096: true, 8192: true, 16384: true}
+	for _, size := range []int{6, 10, 12, 15, 20, 24, 48, 60} {
+		expected[size] = true
+	}
 	for _, size := range sizes {
 		if !expected[size] {
 			t.Errorf("unexpected size %d in registry", size)
@@ -0,0 +1,323 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// forwardDIT10GenComplex64 computes a 10-point forward FFT for complex64 data as
+// straight-line code (radices 2x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT10GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 10
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+
+	t0 := x2 + x8
+	t1 := x2 - x8
+	t2 := x4 + x6
+	t3 := x4 - x6
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t8 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t12 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t13 := x3 + x9
+	t14 := x3 - x9
+	t15 := x5 + x7
+	t16 := x5 - x7
+	t17 := x1 + t13 + t15
+	t18 := complex(real(x1)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x1)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t21 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t22 := complex(real(x1)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x1)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t25 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t26 := t4 + t17
+	t27 := t4 - t17
+	t28 := t20 * complex(0.80901699437494745, -0.58778525229247314)
+	t29 := t7 + t28
+	t30 := t7 - t28
+	t31 := t24 * complex(0.30901699437494745, -0.95105651629515353)
+	t32 := t11 + t31
+	t33 := t11 - t31
+	t34 := t25 * complex(-0.30901699437494745, -0.95105651629515353)
+	t35 := t12 + t34
+	t36 := t12 - t34
+	t37 := t21 * complex(-0.80901699437494745, -0.58778525229247314)
+	t38 := t8 + t37
+	t39 := t8 - t37
+
+	d[0] = t26
+	d[1] = t29
+	d[2] = t32
+	d[3] = t35
+	d[4] = t38
+	d[5] = t27
+	d[6] = t30
+	d[7] = t33
+	d[8] = t36
+	d[9] = t39
+
+	return true
+}
+
+// inverseDIT10GenComplex64 computes a 10-point inverse (scaled by 1/n) FFT for complex64 data as
+// straight-line code (radices 2x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT10GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 10
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+
+	t0 := x2 + x8
+	t1 := x2 - x8
+	t2 := x4 + x6
+	t3 := x4 - x6
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t8 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t12 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t13 := x3 + x9
+	t14 := x3 - x9
+	t15 := x5 + x7
+	t16 := x5 - x7
+	t17 := x1 + t13 + t15
+	t18 := complex(real(x1)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x1)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t21 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t22 := complex(real(x1)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x1)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t25 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t26 := t4 + t17
+	t27 := t4 - t17
+	t28 := t20 * complex(0.80901699437494745, 0.58778525229247314)
+	t29 := t7 + t28
+	t30 := t7 - t28
+	t31 := t24 * complex(0.30901699437494745, 0.95105651629515353)
+	t32 := t11 + t31
+	t33 := t11 - t31
+	t34 := t25 * complex(-0.30901699437494745, 0.95105651629515353)
+	t35 := t12 + t34
+	t36 := t12 - t34
+	t37 := t21 * complex(-0.80901699437494745, 0.58778525229247314)
+	t38 := t8 + t37
+	t39 := t8 - t37
+
+	const scale = 1.0 / 10
+
+	d[0] = complex(real(t26)*scale, imag(t26)*scale)
+	d[1] = complex(real(t29)*scale, imag(t29)*scale)
+	d[2] = complex(real(t32)*scale, imag(t32)*scale)
+	d[3] = complex(real(t35)*scale, imag(t35)*scale)
+	d[4] = complex(real(t38)*scale, imag(t38)*scale)
+	d[5] = complex(real(t27)*scale, imag(t27)*scale)
+	d[6] = complex(real(t30)*scale, imag(t30)*scale)
+	d[7] = complex(real(t33)*scale, imag(t33)*scale)
+	d[8] = complex(real(t36)*scale, imag(t36)*scale)
+	d[9] = complex(real(t39)*scale, imag(t39)*scale)
+
+	return true
+}
+
+// forwardDIT10GenComplex128 computes a 10-point forward FFT for complex128 data as
+// straight-line code (radices 2x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT10GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 10
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+
+	t0 := x2 + x8
+	t1 := x2 - x8
+	t2 := x4 + x6
+	t3 := x4 - x6
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t8 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t12 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t13 := x3 + x9
+	t14 := x3 - x9
+	t15 := x5 + x7
+	t16 := x5 - x7
+	t17 := x1 + t13 + t15
+	t18 := complex(real(x1)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x1)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t21 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t22 := complex(real(x1)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x1)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t25 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t26 := t4 + t17
+	t27 := t4 - t17
+	t28 := t20 * complex(0.80901699437494745, -0.58778525229247314)
+	t29 := t7 + t28
+	t30 := t7 - t28
+	t31 := t24 * complex(0.30901699437494745, -0.95105651629515353)
+	t32 := t11 + t31
+	t33 := t11 - t31
+	t34 := t25 * complex(-0.30901699437494745, -0.95105651629515353)
+	t35 := t12 + t34
+	t36 := t12 - t34
+	t37 := t21 * complex(-0.80901699437494745, -0.58778525229247314)
+	t38 := t8 + t37
+	t39 := t8 - t37
+
+	d[0] = t26
+	d[1] = t29
+	d[2] = t32
+	d[3] = t35
+	d[4] = t38
+	d[5] = t27
+	d[6] = t30
+	d[7] = t33
+	d[8] = t36
+	d[9] = t39
+
+	return true
+}
+
+// inverseDIT10GenComplex128 computes a 10-point inverse (scaled by 1/n) FFT for complex128 data as
+// straight-line code (radices 2x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT10GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 10
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+
+	t0 := x2 + x8
+	t1 := x2 - x8
+	t2 := x4 + x6
+	t3 := x4 - x6
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t8 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t12 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t13 := x3 + x9
+	t14 := x3 - x9
+	t15 := x5 + x7
+	t16 := x5 - x7
+	t17 := x1 + t13 + t15
+	t18 := complex(real(x1)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x1)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t21 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t22 := complex(real(x1)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x1)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t25 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t26 := t4 + t17
+	t27 := t4 - t17
+	t28 := t20 * complex(0.80901699437494745, 0.58778525229247314)
+	t29 := t7 + t28
+	t30 := t7 - t28
+	t31 := t24 * complex(0.30901699437494745, 0.95105651629515353)
+	t32 := t11 + t31
+	t33 := t11 - t31
+	t34 := t25 * complex(-0.30901699437494745, 0.95105651629515353)
+	t35 := t12 + t34
+	t36 := t12 - t34
+	t37 := t21 * complex(-0.80901699437494745, 0.58778525229247314)
+	t38 := t8 + t37
+	t39 := t8 - t37
+
+	const scale = 1.0 / 10
+
+	d[0] = complex(real(t26)*scale, imag(t26)*scale)
+	d[1] = complex(real(t29)*scale, imag(t29)*scale)
+	d[2] = complex(real(t32)*scale, imag(t32)*scale)
+	d[3] = complex(real(t35)*scale, imag(t35)*scale)
+	d[4] = complex(real(t38)*scale, imag(t38)*scale)
+	d[5] = complex(real(t27)*scale, imag(t27)*scale)
+	d[6] = complex(real(t30)*scale, imag(t30)*scale)
+	d[7] = complex(real(t33)*scale, imag(t33)*scale)
+	d[8] = complex(real(t36)*scale, imag(t36)*scale)
+	d[9] = complex(real(t39)*scale, imag(t39)*scale)
+
+	return true
+}
@@ -0,0 +1,423 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// forwardDIT12GenComplex64 computes a 12-point forward FFT for complex64 data as
+// straight-line code (radices 4x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT12GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 12
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+
+	t0 := x4 + x8
+	t1 := x4 - x8
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t6 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t7 := x5 + x9
+	t8 := x5 - x9
+	t9 := x1 + t7
+	t10 := complex(real(x1)-0.5*real(t7), imag(x1)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t13 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t14 := x6 + x10
+	t15 := x6 - x10
+	t16 := x2 + t14
+	t17 := complex(real(x2)-0.5*real(t14), imag(x2)-0.5*imag(t14))
+	t18 := complex(0.8660254037844386*real(t15), 0.8660254037844386*imag(t15))
+	t19 := complex(real(t17)+imag(t18), imag(t17)-real(t18))
+	t20 := complex(real(t17)-imag(t18), imag(t17)+real(t18))
+	t21 := x7 + x11
+	t22 := x7 - x11
+	t23 := x3 + t21
+	t24 := complex(real(x3)-0.5*real(t21), imag(x3)-0.5*imag(t21))
+	t25 := complex(0.8660254037844386*real(t22), 0.8660254037844386*imag(t22))
+	t26 := complex(real(t24)+imag(t25), imag(t24)-real(t25))
+	t27 := complex(real(t24)-imag(t25), imag(t24)+real(t25))
+	t28 := t2 + t16
+	t29 := t2 - t16
+	t30 := t9 + t23
+	t31 := t9 - t23
+	t32 := complex(imag(t31), -real(t31))
+	t33 := t28 + t30
+	t34 := t29 + t32
+	t35 := t28 - t30
+	t36 := t29 - t32
+	t37 := t12 * complex(0.8660254037844386, -0.5)
+	t38 := t19 * complex(0.5, -0.8660254037844386)
+	t39 := complex(imag(t26), -real(t26))
+	t40 := t5 + t38
+	t41 := t5 - t38
+	t42 := t37 + t39
+	t43 := t37 - t39
+	t44 := complex(imag(t43), -real(t43))
+	t45 := t40 + t42
+	t46 := t41 + t44
+	t47 := t40 - t42
+	t48 := t41 - t44
+	t49 := t13 * complex(0.5, -0.8660254037844386)
+	t50 := t20 * complex(-0.5, -0.8660254037844386)
+	t51 := -t27
+	t52 := t6 + t50
+	t53 := t6 - t50
+	t54 := t49 + t51
+	t55 := t49 - t51
+	t56 := complex(imag(t55), -real(t55))
+	t57 := t52 + t54
+	t58 := t53 + t56
+	t59 := t52 - t54
+	t60 := t53 - t56
+
+	d[0] = t33
+	d[1] = t45
+	d[2] = t57
+	d[3] = t34
+	d[4] = t46
+	d[5] = t58
+	d[6] = t35
+	d[7] = t47
+	d[8] = t59
+	d[9] = t36
+	d[10] = t48
+	d[11] = t60
+
+	return true
+}
+
+// inverseDIT12GenComplex64 computes a 12-point inverse (scaled by 1/n) FFT for complex64 data as
+// straight-line code (radices 4x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT12GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 12
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+
+	t0 := x4 + x8
+	t1 := x4 - x8
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t6 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t7 := x5 + x9
+	t8 := x5 - x9
+	t9 := x1 + t7
+	t10 := complex(real(x1)-0.5*real(t7), imag(x1)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t13 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t14 := x6 + x10
+	t15 := x6 - x10
+	t16 := x2 + t14
+	t17 := complex(real(x2)-0.5*real(t14), imag(x2)-0.5*imag(t14))
+	t18 := complex(0.8660254037844386*real(t15), 0.8660254037844386*imag(t15))
+	t19 := complex(real(t17)-imag(t18), imag(t17)+real(t18))
+	t20 := complex(real(t17)+imag(t18), imag(t17)-real(t18))
+	t21 := x7 + x11
+	t22 := x7 - x11
+	t23 := x3 + t21
+	t24 := complex(real(x3)-0.5*real(t21), imag(x3)-0.5*imag(t21))
+	t25 := complex(0.8660254037844386*real(t22), 0.8660254037844386*imag(t22))
+	t26 := complex(real(t24)-imag(t25), imag(t24)+real(t25))
+	t27 := complex(real(t24)+imag(t25), imag(t24)-real(t25))
+	t28 := t2 + t16
+	t29 := t2 - t16
+	t30 := t9 + t23
+	t31 := t9 - t23
+	t32 := complex(-imag(t31), real(t31))
+	t33 := t28 + t30
+	t34 := t29 + t32
+	t35 := t28 - t30
+	t36 := t29 - t32
+	t37 := t12 * complex(0.8660254037844386, 0.5)
+	t38 := t19 * complex(0.5, 0.8660254037844386)
+	t39 := complex(-imag(t26), real(t26))
+	t40 := t5 + t38
+	t41 := t5 - t38
+	t42 := t37 + t39
+	t43 := t37 - t39
+	t44 := complex(-imag(t43), real(t43))
+	t45 := t40 + t42
+	t46 := t41 + t44
+	t47 := t40 - t42
+	t48 := t41 - t44
+	t49 := t13 * complex(0.5, 0.8660254037844386)
+	t50 := t20 * complex(-0.5, 0.8660254037844386)
+	t51 := -t27
+	t52 := t6 + t50
+	t53 := t6 - t50
+	t54 := t49 + t51
+	t55 := t49 - t51
+	t56 := complex(-imag(t55), real(t55))
+	t57 := t52 + t54
+	t58 := t53 + t56
+	t59 := t52 - t54
+	t60 := t53 - t56
+
+	const scale = 1.0 / 12
+
+	d[0] = complex(real(t33)*scale, imag(t33)*scale)
+	d[1] = complex(real(t45)*scale, imag(t45)*scale)
+	d[2] = complex(real(t57)*scale, imag(t57)*scale)
+	d[3] = complex(real(t34)*scale, imag(t34)*scale)
+	d[4] = complex(real(t46)*scale, imag(t46)*scale)
+	d[5] = complex(real(t58)*scale, imag(t58)*scale)
+	d[6] = complex(real(t35)*scale, imag(t35)*scale)
+	d[7] = complex(real(t47)*scale, imag(t47)*scale)
+	d[8] = complex(real(t59)*scale, imag(t59)*scale)
+	d[9] = complex(real(t36)*scale, imag(t36)*scale)
+	d[10] = complex(real(t48)*scale, imag(t48)*scale)
+	d[11] = complex(real(t60)*scale, imag(t60)*scale)
+
+	return true
+}
+
+// forwardDIT12GenComplex128 computes a 12-point forward FFT for complex128 data as
+// straight-line code (radices 4x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT12GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 12
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+
+	t0 := x4 + x8
+	t1 := x4 - x8
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t6 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t7 := x5 + x9
+	t8 := x5 - x9
+	t9 := x1 + t7
+	t10 := complex(real(x1)-0.5*real(t7), imag(x1)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t13 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t14 := x6 + x10
+	t15 := x6 - x10
+	t16 := x2 + t14
+	t17 := complex(real(x2)-0.5*real(t14), imag(x2)-0.5*imag(t14))
+	t18 := complex(0.8660254037844386*real(t15), 0.8660254037844386*imag(t15))
+	t19 := complex(real(t17)+imag(t18), imag(t17)-real(t18))
+	t20 := complex(real(t17)-imag(t18), imag(t17)+real(t18))
+	t21 := x7 + x11
+	t22 := x7 - x11
+	t23 := x3 + t21
+	t24 := complex(real(x3)-0.5*real(t21), imag(x3)-0.5*imag(t21))
+	t25 := complex(0.8660254037844386*real(t22), 0.8660254037844386*imag(t22))
+	t26 := complex(real(t24)+imag(t25), imag(t24)-real(t25))
+	t27 := complex(real(t24)-imag(t25), imag(t24)+real(t25))
+	t28 := t2 + t16
+	t29 := t2 - t16
+	t30 := t9 + t23
+	t31 := t9 - t23
+	t32 := complex(imag(t31), -real(t31))
+	t33 := t28 + t30
+	t34 := t29 + t32
+	t35 := t28 - t30
+	t36 := t29 - t32
+	t37 := t12 * complex(0.8660254037844386, -0.5)
+	t38 := t19 * complex(0.5, -0.8660254037844386)
+	t39 := complex(imag(t26), -real(t26))
+	t40 := t5 + t38
+	t41 := t5 - t38
+	t42 := t37 + t39
+	t43 := t37 - t39
+	t44 := complex(imag(t43), -real(t43))
+	t45 := t40 + t42
+	t46 := t41 + t44
+	t47 := t40 - t42
+	t48 := t41 - t44
+	t49 := t13 * complex(0.5, -0.8660254037844386)
+	t50 := t20 * complex(-0.5, -0.8660254037844386)
+	t51 := -t27
+	t52 := t6 + t50
+	t53 := t6 - t50
+	t54 := t49 + t51
+	t55 := t49 - t51
+	t56 := complex(imag(t55), -real(t55))
+	t57 := t52 + t54
+	t58 := t53 + t56
+	t59 := t52 - t54
+	t60 := t53 - t56
+
+	d[0] = t33
+	d[1] = t45
+	d[2] = t57
+	d[3] = t34
+	d[4] = t46
+	d[5] = t58
+	d[6] = t35
+	d[7] = t47
+	d[8] = t59
+	d[9] = t36
+	d[10] = t48
+	d[11] = t60
+
+	return true
+}
+
+// inverseDIT12GenComplex128 computes a 12-point inverse (scaled by 1/n) FFT for complex128 data as
+// straight-line code (radices 4x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT12GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 12
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+
+	t0 := x4 + x8
+	t1 := x4 - x8
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t6 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t7 := x5 + x9
+	t8 := x5 - x9
+	t9 := x1 + t7
+	t10 := complex(real(x1)-0.5*real(t7), imag(x1)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t13 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t14 := x6 + x10
+	t15 := x6 - x10
+	t16 := x2 + t14
+	t17 := complex(real(x2)-0.5*real(t14), imag(x2)-0.5*imag(t14))
+	t18 := complex(0.8660254037844386*real(t15), 0.8660254037844386*imag(t15))
+	t19 := complex(real(t17)-imag(t18), imag(t17)+real(t18))
+	t20 := complex(real(t17)+imag(t18), imag(t17)-real(t18))
+	t21 := x7 + x11
+	t22 := x7 - x11
+	t23 := x3 + t21
+	t24 := complex(real(x3)-0.5*real(t21), imag(x3)-0.5*imag(t21))
+	t25 := complex(0.8660254037844386*real(t22), 0.8660254037844386*imag(t22))
+	t26 := complex(real(t24)-imag(t25), imag(t24)+real(t25))
+	t27 := complex(real(t24)+imag(t25), imag(t24)-real(t25))
+	t28 := t2 + t16
+	t29 := t2 - t16
+	t30 := t9 + t23
+	t31 := t9 - t23
+	t32 := complex(-imag(t31), real(t31))
+	t33 := t28 + t30
+	t34 := t29 + t32
+	t35 := t28 - t30
+	t36 := t29 - t32
+	t37 := t12 * complex(0.8660254037844386, 0.5)
+	t38 := t19 * complex(0.5, 0.8660254037844386)
+	t39 := complex(-imag(t26), real(t26))
+	t40 := t5 + t38
+	t41 := t5 - t38
+	t42 := t37 + t39
+	t43 := t37 - t39
+	t44 := complex(-imag(t43), real(t43))
+	t45 := t40 + t42
+	t46 := t41 + t44
+	t47 := t40 - t42
+	t48 := t41 - t44
+	t49 := t13 * complex(0.5, 0.8660254037844386)
+	t50 := t20 * complex(-0.5, 0.8660254037844386)
+	t51 := -t27
+	t52 := t6 + t50
+	t53 := t6 - t50
+	t54 := t49 + t51
+	t55 := t49 - t51
+	t56 := complex(-imag(t55), real(t55))
+	t57 := t52 + t54
+	t58 := t53 + t56
+	t59 := t52 - t54
+	t60 := t53 - t56
+
+	const scale = 1.0 / 12
+
+	d[0] = complex(real(t33)*scale, imag(t33)*scale)
+	d[1] = complex(real(t45)*scale, imag(t45)*scale)
+	d[2] = complex(real(t57)*scale, imag(t57)*scale)
+	d[3] = complex(real(t34)*scale, imag(t34)*scale)
+	d[4] = complex(real(t46)*scale, imag(t46)*scale)
+	d[5] = complex(real(t58)*scale, imag(t58)*scale)
+	d[6] = complex(real(t35)*scale, imag(t35)*scale)
+	d[7] = complex(real(t47)*scale, imag(t47)*scale)
+	d[8] = complex(real(t59)*scale, imag(t59)*scale)
+	d[9] = complex(real(t36)*scale, imag(t36)*scale)
+	d[10] = complex(real(t48)*scale, imag(t48)*scale)
+	d[11] = complex(real(t60)*scale, imag(t60)*scale)
+
+	return true
+}
@@ -0,0 +1,531 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// forwardDIT15GenComplex64 computes a 15-point forward FFT for complex64 data as
+// straight-line code (radices 3x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT15GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 15
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+
+	t0 := x3 + x12
+	t1 := x3 - x12
+	t2 := x6 + x9
+	t3 := x6 - x9
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t8 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t12 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t13 := x4 + x13
+	t14 := x4 - x13
+	t15 := x7 + x10
+	t16 := x7 - x10
+	t17 := x1 + t13 + t15
+	t18 := complex(real(x1)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x1)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t21 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t22 := complex(real(x1)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x1)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t25 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t26 := x5 + x14
+	t27 := x5 - x14
+	t28 := x8 + x11
+	t29 := x8 - x11
+	t30 := x2 + t26 + t28
+	t31 := complex(real(x2)+0.30901699437494745*real(t26)-0.80901699437494745*real(t28), imag(x2)+0.30901699437494745*imag(t26)-0.80901699437494745*imag(t28))
+	t32 := complex(0.95105651629515353*real(t27)+0.58778525229247314*real(t29), 0.95105651629515353*imag(t27)+0.58778525229247314*imag(t29))
+	t33 := complex(real(t31)+imag(t32), imag(t31)-real(t32))
+	t34 := complex(real(t31)-imag(t32), imag(t31)+real(t32))
+	t35 := complex(real(x2)-0.80901699437494745*real(t26)+0.30901699437494745*real(t28), imag(x2)-0.80901699437494745*imag(t26)+0.30901699437494745*imag(t28))
+	t36 := complex(0.58778525229247314*real(t27)-0.95105651629515353*real(t29), 0.58778525229247314*imag(t27)-0.95105651629515353*imag(t29))
+	t37 := complex(real(t35)+imag(t36), imag(t35)-real(t36))
+	t38 := complex(real(t35)-imag(t36), imag(t35)+real(t36))
+	t39 := t17 + t30
+	t40 := t17 - t30
+	t41 := t4 + t39
+	t42 := complex(real(t4)-0.5*real(t39), imag(t4)-0.5*imag(t39))
+	t43 := complex(0.8660254037844386*real(t40), 0.8660254037844386*imag(t40))
+	t44 := complex(real(t42)+imag(t43), imag(t42)-real(t43))
+	t45 := complex(real(t42)-imag(t43), imag(t42)+real(t43))
+	t46 := t20 * complex(0.91354545764260087, -0.40673664307580021)
+	t47 := t33 * complex(0.66913060635885824, -0.74314482547739424)
+	t48 := t46 + t47
+	t49 := t46 - t47
+	t50 := t7 + t48
+	t51 := complex(real(t7)-0.5*real(t48), imag(t7)-0.5*imag(t48))
+	t52 := complex(0.8660254037844386*real(t49), 0.8660254037844386*imag(t49))
+	t53 := complex(real(t51)+imag(t52), imag(t51)-real(t52))
+	t54 := complex(real(t51)-imag(t52), imag(t51)+real(t52))
+	t55 := t24 * complex(0.66913060635885824, -0.74314482547739424)
+	t56 := t37 * complex(-0.10452846326765347, -0.99452189536827329)
+	t57 := t55 + t56
+	t58 := t55 - t56
+	t59 := t11 + t57
+	t60 := complex(real(t11)-0.5*real(t57), imag(t11)-0.5*imag(t57))
+	t61 := complex(0.8660254037844386*real(t58), 0.8660254037844386*imag(t58))
+	t62 := complex(real(t60)+imag(t61), imag(t60)-real(t61))
+	t63 := complex(real(t60)-imag(t61), imag(t60)+real(t61))
+	t64 := t25 * complex(0.30901699437494745, -0.95105651629515353)
+	t65 := t38 * complex(-0.80901699437494745, -0.58778525229247314)
+	t66 := t64 + t65
+	t67 := t64 - t65
+	t68 := t12 + t66
+	t69 := complex(real(t12)-0.5*real(t66), imag(t12)-0.5*imag(t66))
+	t70 := complex(0.8660254037844386*real(t67), 0.8660254037844386*imag(t67))
+	t71 := complex(real(t69)+imag(t70), imag(t69)-real(t70))
+	t72 := complex(real(t69)-imag(t70), imag(t69)+real(t70))
+	t73 := t21 * complex(-0.10452846326765347, -0.99452189536827329)
+	t74 := t34 * complex(-0.97814760073380569, 0.20791169081775934)
+	t75 := t73 + t74
+	t76 := t73 - t74
+	t77 := t8 + t75
+	t78 := complex(real(t8)-0.5*real(t75), imag(t8)-0.5*imag(t75))
+	t79 := complex(0.8660254037844386*real(t76), 0.8660254037844386*imag(t76))
+	t80 := complex(real(t78)+imag(t79), imag(t78)-real(t79))
+	t81 := complex(real(t78)-imag(t79), imag(t78)+real(t79))
+
+	d[0] = t41
+	d[1] = t50
+	d[2] = t59
+	d[3] = t68
+	d[4] = t77
+	d[5] = t44
+	d[6] = t53
+	d[7] = t62
+	d[8] = t71
+	d[9] = t80
+	d[10] = t45
+	d[11] = t54
+	d[12] = t63
+	d[13] = t72
+	d[14] = t81
+
+	return true
+}
+
+// inverseDIT15GenComplex64 computes a 15-point inverse (scaled by 1/n) FFT for complex64 data as
+// straight-line code (radices 3x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT15GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 15
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+
+	t0 := x3 + x12
+	t1 := x3 - x12
+	t2 := x6 + x9
+	t3 := x6 - x9
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t8 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t12 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t13 := x4 + x13
+	t14 := x4 - x13
+	t15 := x7 + x10
+	t16 := x7 - x10
+	t17 := x1 + t13 + t15
+	t18 := complex(real(x1)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x1)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t21 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t22 := complex(real(x1)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x1)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t25 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t26 := x5 + x14
+	t27 := x5 - x14
+	t28 := x8 + x11
+	t29 := x8 - x11
+	t30 := x2 + t26 + t28
+	t31 := complex(real(x2)+0.30901699437494745*real(t26)-0.80901699437494745*real(t28), imag(x2)+0.30901699437494745*imag(t26)-0.80901699437494745*imag(t28))
+	t32 := complex(0.95105651629515353*real(t27)+0.58778525229247314*real(t29), 0.95105651629515353*imag(t27)+0.58778525229247314*imag(t29))
+	t33 := complex(real(t31)-imag(t32), imag(t31)+real(t32))
+	t34 := complex(real(t31)+imag(t32), imag(t31)-real(t32))
+	t35 := complex(real(x2)-0.80901699437494745*real(t26)+0.30901699437494745*real(t28), imag(x2)-0.80901699437494745*imag(t26)+0.30901699437494745*imag(t28))
+	t36 := complex(0.58778525229247314*real(t27)-0.95105651629515353*real(t29), 0.58778525229247314*imag(t27)-0.95105651629515353*imag(t29))
+	t37 := complex(real(t35)-imag(t36), imag(t35)+real(t36))
+	t38 := complex(real(t35)+imag(t36), imag(t35)-real(t36))
+	t39 := t17 + t30
+	t40 := t17 - t30
+	t41 := t4 + t39
+	t42 := complex(real(t4)-0.5*real(t39), imag(t4)-0.5*imag(t39))
+	t43 := complex(0.8660254037844386*real(t40), 0.8660254037844386*imag(t40))
+	t44 := complex(real(t42)-imag(t43), imag(t42)+real(t43))
+	t45 := complex(real(t42)+imag(t43), imag(t42)-real(t43))
+	t46 := t20 * complex(0.91354545764260087, 0.40673664307580021)
+	t47 := t33 * complex(0.66913060635885824, 0.74314482547739424)
+	t48 := t46 + t47
+	t49 := t46 - t47
+	t50 := t7 + t48
+	t51 := complex(real(t7)-0.5*real(t48), imag(t7)-0.5*imag(t48))
+	t52 := complex(0.8660254037844386*real(t49), 0.8660254037844386*imag(t49))
+	t53 := complex(real(t51)-imag(t52), imag(t51)+real(t52))
+	t54 := complex(real(t51)+imag(t52), imag(t51)-real(t52))
+	t55 := t24 * complex(0.66913060635885824, 0.74314482547739424)
+	t56 := t37 * complex(-0.10452846326765347, 0.99452189536827329)
+	t57 := t55 + t56
+	t58 := t55 - t56
+	t59 := t11 + t57
+	t60 := complex(real(t11)-0.5*real(t57), imag(t11)-0.5*imag(t57))
+	t61 := complex(0.8660254037844386*real(t58), 0.8660254037844386*imag(t58))
+	t62 := complex(real(t60)-imag(t61), imag(t60)+real(t61))
+	t63 := complex(real(t60)+imag(t61), imag(t60)-real(t61))
+	t64 := t25 * complex(0.30901699437494745, 0.95105651629515353)
+	t65 := t38 * complex(-0.80901699437494745, 0.58778525229247314)
+	t66 := t64 + t65
+	t67 := t64 - t65
+	t68 := t12 + t66
+	t69 := complex(real(t12)-0.5*real(t66), imag(t12)-0.5*imag(t66))
+	t70 := complex(0.8660254037844386*real(t67), 0.8660254037844386*imag(t67))
+	t71 := complex(real(t69)-imag(t70), imag(t69)+real(t70))
+	t72 := complex(real(t69)+imag(t70), imag(t69)-real(t70))
+	t73 := t21 * complex(-0.10452846326765347, 0.99452189536827329)
+	t74 := t34 * complex(-0.97814760073380569, -0.20791169081775934)
+	t75 := t73 + t74
+	t76 := t73 - t74
+	t77 := t8 + t75
+	t78 := complex(real(t8)-0.5*real(t75), imag(t8)-0.5*imag(t75))
+	t79 := complex(0.8660254037844386*real(t76), 0.8660254037844386*imag(t76))
+	t80 := complex(real(t78)-imag(t79), imag(t78)+real(t79))
+	t81 := complex(real(t78)+imag(t79), imag(t78)-real(t79))
+
+	const scale = 1.0 / 15
+
+	d[0] = complex(real(t41)*scale, imag(t41)*scale)
+	d[1] = complex(real(t50)*scale, imag(t50)*scale)
+	d[2] = complex(real(t59)*scale, imag(t59)*scale)
+	d[3] = complex(real(t68)*scale, imag(t68)*scale)
+	d[4] = complex(real(t77)*scale, imag(t77)*scale)
+	d[5] = complex(real(t44)*scale, imag(t44)*scale)
+	d[6] = complex(real(t53)*scale, imag(t53)*scale)
+	d[7] = complex(real(t62)*scale, imag(t62)*scale)
+	d[8] = complex(real(t71)*scale, imag(t71)*scale)
+	d[9] = complex(real(t80)*scale, imag(t80)*scale)
+	d[10] = complex(real(t45)*scale, imag(t45)*scale)
+	d[11] = complex(real(t54)*scale, imag(t54)*scale)
+	d[12] = complex(real(t63)*scale, imag(t63)*scale)
+	d[13] = complex(real(t72)*scale, imag(t72)*scale)
+	d[14] = complex(real(t81)*scale, imag(t81)*scale)
+
+	return true
+}
+
+// forwardDIT15GenComplex128 computes a 15-point forward FFT for complex128 data as
+// straight-line code (radices 3x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT15GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 15
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+
+	t0 := x3 + x12
+	t1 := x3 - x12
+	t2 := x6 + x9
+	t3 := x6 - x9
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t8 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t12 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t13 := x4 + x13
+	t14 := x4 - x13
+	t15 := x7 + x10
+	t16 := x7 - x10
+	t17 := x1 + t13 + t15
+	t18 := complex(real(x1)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x1)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t21 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t22 := complex(real(x1)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x1)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t25 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t26 := x5 + x14
+	t27 := x5 - x14
+	t28 := x8 + x11
+	t29 := x8 - x11
+	t30 := x2 + t26 + t28
+	t31 := complex(real(x2)+0.30901699437494745*real(t26)-0.80901699437494745*real(t28), imag(x2)+0.30901699437494745*imag(t26)-0.80901699437494745*imag(t28))
+	t32 := complex(0.95105651629515353*real(t27)+0.58778525229247314*real(t29), 0.95105651629515353*imag(t27)+0.58778525229247314*imag(t29))
+	t33 := complex(real(t31)+imag(t32), imag(t31)-real(t32))
+	t34 := complex(real(t31)-imag(t32), imag(t31)+real(t32))
+	t35 := complex(real(x2)-0.80901699437494745*real(t26)+0.30901699437494745*real(t28), imag(x2)-0.80901699437494745*imag(t26)+0.30901699437494745*imag(t28))
+	t36 := complex(0.58778525229247314*real(t27)-0.95105651629515353*real(t29), 0.58778525229247314*imag(t27)-0.95105651629515353*imag(t29))
+	t37 := complex(real(t35)+imag(t36), imag(t35)-real(t36))
+	t38 := complex(real(t35)-imag(t36), imag(t35)+real(t36))
+	t39 := t17 + t30
+	t40 := t17 - t30
+	t41 := t4 + t39
+	t42 := complex(real(t4)-0.5*real(t39), imag(t4)-0.5*imag(t39))
+	t43 := complex(0.8660254037844386*real(t40), 0.8660254037844386*imag(t40))
+	t44 := complex(real(t42)+imag(t43), imag(t42)-real(t43))
+	t45 := complex(real(t42)-imag(t43), imag(t42)+real(t43))
+	t46 := t20 * complex(0.91354545764260087, -0.40673664307580021)
+	t47 := t33 * complex(0.66913060635885824, -0.74314482547739424)
+	t48 := t46 + t47
+	t49 := t46 - t47
+	t50 := t7 + t48
+	t51 := complex(real(t7)-0.5*real(t48), imag(t7)-0.5*imag(t48))
+	t52 := complex(0.8660254037844386*real(t49), 0.8660254037844386*imag(t49))
+	t53 := complex(real(t51)+imag(t52), imag(t51)-real(t52))
+	t54 := complex(real(t51)-imag(t52), imag(t51)+real(t52))
+	t55 := t24 * complex(0.66913060635885824, -0.74314482547739424)
+	t56 := t37 * complex(-0.10452846326765347, -0.99452189536827329)
+	t57 := t55 + t56
+	t58 := t55 - t56
+	t59 := t11 + t57
+	t60 := complex(real(t11)-0.5*real(t57), imag(t11)-0.5*imag(t57))
+	t61 := complex(0.8660254037844386*real(t58), 0.8660254037844386*imag(t58))
+	t62 := complex(real(t60)+imag(t61), imag(t60)-real(t61))
+	t63 := complex(real(t60)-imag(t61), imag(t60)+real(t61))
+	t64 := t25 * complex(0.30901699437494745, -0.95105651629515353)
+	t65 := t38 * complex(-0.80901699437494745, -0.58778525229247314)
+	t66 := t64 + t65
+	t67 := t64 - t65
+	t68 := t12 + t66
+	t69 := complex(real(t12)-0.5*real(t66), imag(t12)-0.5*imag(t66))
+	t70 := complex(0.8660254037844386*real(t67), 0.8660254037844386*imag(t67))
+	t71 := complex(real(t69)+imag(t70), imag(t69)-real(t70))
+	t72 := complex(real(t69)-imag(t70), imag(t69)+real(t70))
+	t73 := t21 * complex(-0.10452846326765347, -0.99452189536827329)
+	t74 := t34 * complex(-0.97814760073380569, 0.20791169081775934)
+	t75 := t73 + t74
+	t76 := t73 - t74
+	t77 := t8 + t75
+	t78 := complex(real(t8)-0.5*real(t75), imag(t8)-0.5*imag(t75))
+	t79 := complex(0.8660254037844386*real(t76), 0.8660254037844386*imag(t76))
+	t80 := complex(real(t78)+imag(t79), imag(t78)-real(t79))
+	t81 := complex(real(t78)-imag(t79), imag(t78)+real(t79))
+
+	d[0] = t41
+	d[1] = t50
+	d[2] = t59
+	d[3] = t68
+	d[4] = t77
+	d[5] = t44
+	d[6] = t53
+	d[7] = t62
+	d[8] = t71
+	d[9] = t80
+	d[10] = t45
+	d[11] = t54
+	d[12] = t63
+	d[13] = t72
+	d[14] = t81
+
+	return true
+}
+
+// inverseDIT15GenComplex128 computes a 15-point inverse (scaled by 1/n) FFT for complex128 data as
+// straight-line code (radices 3x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT15GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 15
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+
+	t0 := x3 + x12
+	t1 := x3 - x12
+	t2 := x6 + x9
+	t3 := x6 - x9
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t8 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t12 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t13 := x4 + x13
+	t14 := x4 - x13
+	t15 := x7 + x10
+	t16 := x7 - x10
+	t17 := x1 + t13 + t15
+	t18 := complex(real(x1)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x1)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t21 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t22 := complex(real(x1)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x1)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t25 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t26 := x5 + x14
+	t27 := x5 - x14
+	t28 := x8 + x11
+	t29 := x8 - x11
+	t30 := x2 + t26 + t28
+	t31 := complex(real(x2)+0.30901699437494745*real(t26)-0.80901699437494745*real(t28), imag(x2)+0.30901699437494745*imag(t26)-0.80901699437494745*imag(t28))
+	t32 := complex(0.95105651629515353*real(t27)+0.58778525229247314*real(t29), 0.95105651629515353*imag(t27)+0.58778525229247314*imag(t29))
+	t33 := complex(real(t31)-imag(t32), imag(t31)+real(t32))
+	t34 := complex(real(t31)+imag(t32), imag(t31)-real(t32))
+	t35 := complex(real(x2)-0.80901699437494745*real(t26)+0.30901699437494745*real(t28), imag(x2)-0.80901699437494745*imag(t26)+0.30901699437494745*imag(t28))
+	t36 := complex(0.58778525229247314*real(t27)-0.95105651629515353*real(t29), 0.58778525229247314*imag(t27)-0.95105651629515353*imag(t29))
+	t37 := complex(real(t35)-imag(t36), imag(t35)+real(t36))
+	t38 := complex(real(t35)+imag(t36), imag(t35)-real(t36))
+	t39 := t17 + t30
+	t40 := t17 - t30
+	t41 := t4 + t39
+	t42 := complex(real(t4)-0.5*real(t39), imag(t4)-0.5*imag(t39))
+	t43 := complex(0.8660254037844386*real(t40), 0.8660254037844386*imag(t40))
+	t44 := complex(real(t42)-imag(t43), imag(t42)+real(t43))
+	t45 := complex(real(t42)+imag(t43), imag(t42)-real(t43))
+	t46 := t20 * complex(0.91354545764260087, 0.40673664307580021)
+	t47 := t33 * complex(0.66913060635885824, 0.74314482547739424)
+	t48 := t46 + t47
+	t49 := t46 - t47
+	t50 := t7 + t48
+	t51 := complex(real(t7)-0.5*real(t48), imag(t7)-0.5*imag(t48))
+	t52 := complex(0.8660254037844386*real(t49), 0.8660254037844386*imag(t49))
+	t53 := complex(real(t51)-imag(t52), imag(t51)+real(t52))
+	t54 := complex(real(t51)+imag(t52), imag(t51)-real(t52))
+	t55 := t24 * complex(0.66913060635885824, 0.74314482547739424)
+	t56 := t37 * complex(-0.10452846326765347, 0.99452189536827329)
+	t57 := t55 + t56
+	t58 := t55 - t56
+	t59 := t11 + t57
+	t60 := complex(real(t11)-0.5*real(t57), imag(t11)-0.5*imag(t57))
+	t61 := complex(0.8660254037844386*real(t58), 0.8660254037844386*imag(t58))
+	t62 := complex(real(t60)-imag(t61), imag(t60)+real(t61))
+	t63 := complex(real(t60)+imag(t61), imag(t60)-real(t61))
+	t64 := t25 * complex(0.30901699437494745, 0.95105651629515353)
+	t65 := t38 * complex(-0.80901699437494745, 0.58778525229247314)
+	t66 := t64 + t65
+	t67 := t64 - t65
+	t68 := t12 + t66
+	t69 := complex(real(t12)-0.5*real(t66), imag(t12)-0.5*imag(t66))
+	t70 := complex(0.8660254037844386*real(t67), 0.8660254037844386*imag(t67))
+	t71 := complex(real(t69)-imag(t70), imag(t69)+real(t70))
+	t72 := complex(real(t69)+imag(t70), imag(t69)-real(t70))
+	t73 := t21 * complex(-0.10452846326765347, 0.99452189536827329)
+	t74 := t34 * complex(-0.97814760073380569, -0.20791169081775934)
+	t75 := t73 + t74
+	t76 := t73 - t74
+	t77 := t8 + t75
+	t78 := complex(real(t8)-0.5*real(t75), imag(t8)-0.5*imag(t75))
+	t79 := complex(0.8660254037844386*real(t76), 0.8660254037844386*imag(t76))
+	t80 := complex(real(t78)-imag(t79), imag(t78)+real(t79))
+	t81 := complex(real(t78)+imag(t79), imag(t78)-real(t79))
+
+	const scale = 1.0 / 15
+
+	d[0] = complex(real(t41)*scale, imag(t41)*scale)
+	d[1] = complex(real(t50)*scale, imag(t50)*scale)
+	d[2] = complex(real(t59)*scale, imag(t59)*scale)
+	d[3] = complex(real(t68)*scale, imag(t68)*scale)
+	d[4] = complex(real(t77)*scale, imag(t77)*scale)
+	d[5] = complex(real(t44)*scale, imag(t44)*scale)
+	d[6] = complex(real(t53)*scale, imag(t53)*scale)
+	d[7] = complex(real(t62)*scale, imag(t62)*scale)
+	d[8] = complex(real(t71)*scale, imag(t71)*scale)
+	d[9] = complex(real(t80)*scale, imag(t80)*scale)
+	d[10] = complex(real(t45)*scale, imag(t45)*scale)
+	d[11] = complex(real(t54)*scale, imag(t54)*scale)
+	d[12] = complex(real(t63)*scale, imag(t63)*scale)
+	d[13] = complex(real(t72)*scale, imag(t72)*scale)
+	d[14] = complex(real(t81)*scale, imag(t81)*scale)
+
+	return true
+}
@@ -0,0 +1,679 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// forwardDIT20GenComplex64 computes a 20-point forward FFT for complex64 data as
+// straight-line code (radices 4x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT20GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 20
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+
+	t0 := x4 + x16
+	t1 := x4 - x16
+	t2 := x8 + x12
+	t3 := x8 - x12
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t8 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t12 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t13 := x5 + x17
+	t14 := x5 - x17
+	t15 := x9 + x13
+	t16 := x9 - x13
+	t17 := x1 + t13 + t15
+	t18 := complex(real(x1)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x1)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t21 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t22 := complex(real(x1)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x1)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t25 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t26 := x6 + x18
+	t27 := x6 - x18
+	t28 := x10 + x14
+	t29 := x10 - x14
+	t30 := x2 + t26 + t28
+	t31 := complex(real(x2)+0.30901699437494745*real(t26)-0.80901699437494745*real(t28), imag(x2)+0.30901699437494745*imag(t26)-0.80901699437494745*imag(t28))
+	t32 := complex(0.95105651629515353*real(t27)+0.58778525229247314*real(t29), 0.95105651629515353*imag(t27)+0.58778525229247314*imag(t29))
+	t33 := complex(real(t31)+imag(t32), imag(t31)-real(t32))
+	t34 := complex(real(t31)-imag(t32), imag(t31)+real(t32))
+	t35 := complex(real(x2)-0.80901699437494745*real(t26)+0.30901699437494745*real(t28), imag(x2)-0.80901699437494745*imag(t26)+0.30901699437494745*imag(t28))
+	t36 := complex(0.58778525229247314*real(t27)-0.95105651629515353*real(t29), 0.58778525229247314*imag(t27)-0.95105651629515353*imag(t29))
+	t37 := complex(real(t35)+imag(t36), imag(t35)-real(t36))
+	t38 := complex(real(t35)-imag(t36), imag(t35)+real(t36))
+	t39 := x7 + x19
+	t40 := x7 - x19
+	t41 := x11 + x15
+	t42 := x11 - x15
+	t43 := x3 + t39 + t41
+	t44 := complex(real(x3)+0.30901699437494745*real(t39)-0.80901699437494745*real(t41), imag(x3)+0.30901699437494745*imag(t39)-0.80901699437494745*imag(t41))
+	t45 := complex(0.95105651629515353*real(t40)+0.58778525229247314*real(t42), 0.95105651629515353*imag(t40)+0.58778525229247314*imag(t42))
+	t46 := complex(real(t44)+imag(t45), imag(t44)-real(t45))
+	t47 := complex(real(t44)-imag(t45), imag(t44)+real(t45))
+	t48 := complex(real(x3)-0.80901699437494745*real(t39)+0.30901699437494745*real(t41), imag(x3)-0.80901699437494745*imag(t39)+0.30901699437494745*imag(t41))
+	t49 := complex(0.58778525229247314*real(t40)-0.95105651629515353*real(t42), 0.58778525229247314*imag(t40)-0.95105651629515353*imag(t42))
+	t50 := complex(real(t48)+imag(t49), imag(t48)-real(t49))
+	t51 := complex(real(t48)-imag(t49), imag(t48)+real(t49))
+	t52 := t4 + t30
+	t53 := t4 - t30
+	t54 := t17 + t43
+	t55 := t17 - t43
+	t56 := complex(imag(t55), -real(t55))
+	t57 := t52 + t54
+	t58 := t53 + t56
+	t59 := t52 - t54
+	t60 := t53 - t56
+	t61 := t20 * complex(0.95105651629515353, -0.30901699437494745)
+	t62 := t33 * complex(0.80901699437494745, -0.58778525229247314)
+	t63 := t46 * complex(0.58778525229247314, -0.80901699437494745)
+	t64 := t7 + t62
+	t65 := t7 - t62
+	t66 := t61 + t63
+	t67 := t61 - t63
+	t68 := complex(imag(t67), -real(t67))
+	t69 := t64 + t66
+	t70 := t65 + t68
+	t71 := t64 - t66
+	t72 := t65 - t68
+	t73 := t24 * complex(0.80901699437494745, -0.58778525229247314)
+	t74 := t37 * complex(0.30901699437494745, -0.95105651629515353)
+	t75 := t50 * complex(-0.30901699437494745, -0.95105651629515353)
+	t76 := t11 + t74
+	t77 := t11 - t74
+	t78 := t73 + t75
+	t79 := t73 - t75
+	t80 := complex(imag(t79), -real(t79))
+	t81 := t76 + t78
+	t82 := t77 + t80
+	t83 := t76 - t78
+	t84 := t77 - t80
+	t85 := t25 * complex(0.58778525229247314, -0.80901699437494745)
+	t86 := t38 * complex(-0.30901699437494745, -0.95105651629515353)
+	t87 := t51 * complex(-0.95105651629515353, -0.30901699437494745)
+	t88 := t12 + t86
+	t89 := t12 - t86
+	t90 := t85 + t87
+	t91 := t85 - t87
+	t92 := complex(imag(t91), -real(t91))
+	t93 := t88 + t90
+	t94 := t89 + t92
+	t95 := t88 - t90
+	t96 := t89 - t92
+	t97 := t21 * complex(0.30901699437494745, -0.95105651629515353)
+	t98 := t34 * complex(-0.80901699437494745, -0.58778525229247314)
+	t99 := t47 * complex(-0.80901699437494745, 0.58778525229247314)
+	t100 := t8 + t98
+	t101 := t8 - t98
+	t102 := t97 + t99
+	t103 := t97 - t99
+	t104 := complex(imag(t103), -real(t103))
+	t105 := t100 + t102
+	t106 := t101 + t104
+	t107 := t100 - t102
+	t108 := t101 - t104
+
+	d[0] = t57
+	d[1] = t69
+	d[2] = t81
+	d[3] = t93
+	d[4] = t105
+	d[5] = t58
+	d[6] = t70
+	d[7] = t82
+	d[8] = t94
+	d[9] = t106
+	d[10] = t59
+	d[11] = t71
+	d[12] = t83
+	d[13] = t95
+	d[14] = t107
+	d[15] = t60
+	d[16] = t72
+	d[17] = t84
+	d[18] = t96
+	d[19] = t108
+
+	return true
+}
+
+// inverseDIT20GenComplex64 computes a 20-point inverse (scaled by 1/n) FFT for complex64 data as
+// straight-line code (radices 4x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT20GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 20
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+
+	t0 := x4 + x16
+	t1 := x4 - x16
+	t2 := x8 + x12
+	t3 := x8 - x12
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t8 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t12 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t13 := x5 + x17
+	t14 := x5 - x17
+	t15 := x9 + x13
+	t16 := x9 - x13
+	t17 := x1 + t13 + t15
+	t18 := complex(real(x1)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x1)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t21 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t22 := complex(real(x1)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x1)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t25 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t26 := x6 + x18
+	t27 := x6 - x18
+	t28 := x10 + x14
+	t29 := x10 - x14
+	t30 := x2 + t26 + t28
+	t31 := complex(real(x2)+0.30901699437494745*real(t26)-0.80901699437494745*real(t28), imag(x2)+0.30901699437494745*imag(t26)-0.80901699437494745*imag(t28))
+	t32 := complex(0.95105651629515353*real(t27)+0.58778525229247314*real(t29), 0.95105651629515353*imag(t27)+0.58778525229247314*imag(t29))
+	t33 := complex(real(t31)-imag(t32), imag(t31)+real(t32))
+	t34 := complex(real(t31)+imag(t32), imag(t31)-real(t32))
+	t35 := complex(real(x2)-0.80901699437494745*real(t26)+0.30901699437494745*real(t28), imag(x2)-0.80901699437494745*imag(t26)+0.30901699437494745*imag(t28))
+	t36 := complex(0.58778525229247314*real(t27)-0.95105651629515353*real(t29), 0.58778525229247314*imag(t27)-0.95105651629515353*imag(t29))
+	t37 := complex(real(t35)-imag(t36), imag(t35)+real(t36))
+	t38 := complex(real(t35)+imag(t36), imag(t35)-real(t36))
+	t39 := x7 + x19
+	t40 := x7 - x19
+	t41 := x11 + x15
+	t42 := x11 - x15
+	t43 := x3 + t39 + t41
+	t44 := complex(real(x3)+0.30901699437494745*real(t39)-0.80901699437494745*real(t41), imag(x3)+0.30901699437494745*imag(t39)-0.80901699437494745*imag(t41))
+	t45 := complex(0.95105651629515353*real(t40)+0.58778525229247314*real(t42), 0.95105651629515353*imag(t40)+0.58778525229247314*imag(t42))
+	t46 := complex(real(t44)-imag(t45), imag(t44)+real(t45))
+	t47 := complex(real(t44)+imag(t45), imag(t44)-real(t45))
+	t48 := complex(real(x3)-0.80901699437494745*real(t39)+0.30901699437494745*real(t41), imag(x3)-0.80901699437494745*imag(t39)+0.30901699437494745*imag(t41))
+	t49 := complex(0.58778525229247314*real(t40)-0.95105651629515353*real(t42), 0.58778525229247314*imag(t40)-0.95105651629515353*imag(t42))
+	t50 := complex(real(t48)-imag(t49), imag(t48)+real(t49))
+	t51 := complex(real(t48)+imag(t49), imag(t48)-real(t49))
+	t52 := t4 + t30
+	t53 := t4 - t30
+	t54 := t17 + t43
+	t55 := t17 - t43
+	t56 := complex(-imag(t55), real(t55))
+	t57 := t52 + t54
+	t58 := t53 + t56
+	t59 := t52 - t54
+	t60 := t53 - t56
+	t61 := t20 * complex(0.95105651629515353, 0.30901699437494745)
+	t62 := t33 * complex(0.80901699437494745, 0.58778525229247314)
+	t63 := t46 * complex(0.58778525229247314, 0.80901699437494745)
+	t64 := t7 + t62
+	t65 := t7 - t62
+	t66 := t61 + t63
+	t67 := t61 - t63
+	t68 := complex(-imag(t67), real(t67))
+	t69 := t64 + t66
+	t70 := t65 + t68
+	t71 := t64 - t66
+	t72 := t65 - t68
+	t73 := t24 * complex(0.80901699437494745, 0.58778525229247314)
+	t74 := t37 * complex(0.30901699437494745, 0.95105651629515353)
+	t75 := t50 * complex(-0.30901699437494745, 0.95105651629515353)
+	t76 := t11 + t74
+	t77 := t11 - t74
+	t78 := t73 + t75
+	t79 := t73 - t75
+	t80 := complex(-imag(t79), real(t79))
+	t81 := t76 + t78
+	t82 := t77 + t80
+	t83 := t76 - t78
+	t84 := t77 - t80
+	t85 := t25 * complex(0.58778525229247314, 0.80901699437494745)
+	t86 := t38 * complex(-0.30901699437494745, 0.95105651629515353)
+	t87 := t51 * complex(-0.95105651629515353, 0.30901699437494745)
+	t88 := t12 + t86
+	t89 := t12 - t86
+	t90 := t85 + t87
+	t91 := t85 - t87
+	t92 := complex(-imag(t91), real(t91))
+	t93 := t88 + t90
+	t94 := t89 + t92
+	t95 := t88 - t90
+	t96 := t89 - t92
+	t97 := t21 * complex(0.30901699437494745, 0.95105651629515353)
+	t98 := t34 * complex(-0.80901699437494745, 0.58778525229247314)
+	t99 := t47 * complex(-0.80901699437494745, -0.58778525229247314)
+	t100 := t8 + t98
+	t101 := t8 - t98
+	t102 := t97 + t99
+	t103 := t97 - t99
+	t104 := complex(-imag(t103), real(t103))
+	t105 := t100 + t102
+	t106 := t101 + t104
+	t107 := t100 - t102
+	t108 := t101 - t104
+
+	const scale = 1.0 / 20
+
+	d[0] = complex(real(t57)*scale, imag(t57)*scale)
+	d[1] = complex(real(t69)*scale, imag(t69)*scale)
+	d[2] = complex(real(t81)*scale, imag(t81)*scale)
+	d[3] = complex(real(t93)*scale, imag(t93)*scale)
+	d[4] = complex(real(t105)*scale, imag(t105)*scale)
+	d[5] = complex(real(t58)*scale, imag(t58)*scale)
+	d[6] = complex(real(t70)*scale, imag(t70)*scale)
+	d[7] = complex(real(t82)*scale, imag(t82)*scale)
+	d[8] = complex(real(t94)*scale, imag(t94)*scale)
+	d[9] = complex(real(t106)*scale, imag(t106)*scale)
+	d[10] = complex(real(t59)*scale, imag(t59)*scale)
+	d[11] = complex(real(t71)*scale, imag(t71)*scale)
+	d[12] = complex(real(t83)*scale, imag(t83)*scale)
+	d[13] = complex(real(t95)*scale, imag(t95)*scale)
+	d[14] = complex(real(t107)*scale, imag(t107)*scale)
+	d[15] = complex(real(t60)*scale, imag(t60)*scale)
+	d[16] = complex(real(t72)*scale, imag(t72)*scale)
+	d[17] = complex(real(t84)*scale, imag(t84)*scale)
+	d[18] = complex(real(t96)*scale, imag(t96)*scale)
+	d[19] = complex(real(t108)*scale, imag(t108)*scale)
+
+	return true
+}
+
+// forwardDIT20GenComplex128 computes a 20-point forward FFT for complex128 data as
+// straight-line code (radices 4x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT20GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 20
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+
+	t0 := x4 + x16
+	t1 := x4 - x16
+	t2 := x8 + x12
+	t3 := x8 - x12
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t8 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t12 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t13 := x5 + x17
+	t14 := x5 - x17
+	t15 := x9 + x13
+	t16 := x9 - x13
+	t17 := x1 + t13 + t15
+	t18 := complex(real(x1)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x1)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t21 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t22 := complex(real(x1)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x1)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t25 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t26 := x6 + x18
+	t27 := x6 - x18
+	t28 := x10 + x14
+	t29 := x10 - x14
+	t30 := x2 + t26 + t28
+	t31 := complex(real(x2)+0.30901699437494745*real(t26)-0.80901699437494745*real(t28), imag(x2)+0.30901699437494745*imag(t26)-0.80901699437494745*imag(t28))
+	t32 := complex(0.95105651629515353*real(t27)+0.58778525229247314*real(t29), 0.95105651629515353*imag(t27)+0.58778525229247314*imag(t29))
+	t33 := complex(real(t31)+imag(t32), imag(t31)-real(t32))
+	t34 := complex(real(t31)-imag(t32), imag(t31)+real(t32))
+	t35 := complex(real(x2)-0.80901699437494745*real(t26)+0.30901699437494745*real(t28), imag(x2)-0.80901699437494745*imag(t26)+0.30901699437494745*imag(t28))
+	t36 := complex(0.58778525229247314*real(t27)-0.95105651629515353*real(t29), 0.58778525229247314*imag(t27)-0.95105651629515353*imag(t29))
+	t37 := complex(real(t35)+imag(t36), imag(t35)-real(t36))
+	t38 := complex(real(t35)-imag(t36), imag(t35)+real(t36))
+	t39 := x7 + x19
+	t40 := x7 - x19
+	t41 := x11 + x15
+	t42 := x11 - x15
+	t43 := x3 + t39 + t41
+	t44 := complex(real(x3)+0.30901699437494745*real(t39)-0.80901699437494745*real(t41), imag(x3)+0.30901699437494745*imag(t39)-0.80901699437494745*imag(t41))
+	t45 := complex(0.95105651629515353*real(t40)+0.58778525229247314*real(t42), 0.95105651629515353*imag(t40)+0.58778525229247314*imag(t42))
+	t46 := complex(real(t44)+imag(t45), imag(t44)-real(t45))
+	t47 := complex(real(t44)-imag(t45), imag(t44)+real(t45))
+	t48 := complex(real(x3)-0.80901699437494745*real(t39)+0.30901699437494745*real(t41), imag(x3)-0.80901699437494745*imag(t39)+0.30901699437494745*imag(t41))
+	t49 := complex(0.58778525229247314*real(t40)-0.95105651629515353*real(t42), 0.58778525229247314*imag(t40)-0.95105651629515353*imag(t42))
+	t50 := complex(real(t48)+imag(t49), imag(t48)-real(t49))
+	t51 := complex(real(t48)-imag(t49), imag(t48)+real(t49))
+	t52 := t4 + t30
+	t53 := t4 - t30
+	t54 := t17 + t43
+	t55 := t17 - t43
+	t56 := complex(imag(t55), -real(t55))
+	t57 := t52 + t54
+	t58 := t53 + t56
+	t59 := t52 - t54
+	t60 := t53 - t56
+	t61 := t20 * complex(0.95105651629515353, -0.30901699437494745)
+	t62 := t33 * complex(0.80901699437494745, -0.58778525229247314)
+	t63 := t46 * complex(0.58778525229247314, -0.80901699437494745)
+	t64 := t7 + t62
+	t65 := t7 - t62
+	t66 := t61 + t63
+	t67 := t61 - t63
+	t68 := complex(imag(t67), -real(t67))
+	t69 := t64 + t66
+	t70 := t65 + t68
+	t71 := t64 - t66
+	t72 := t65 - t68
+	t73 := t24 * complex(0.80901699437494745, -0.58778525229247314)
+	t74 := t37 * complex(0.30901699437494745, -0.95105651629515353)
+	t75 := t50 * complex(-0.30901699437494745, -0.95105651629515353)
+	t76 := t11 + t74
+	t77 := t11 - t74
+	t78 := t73 + t75
+	t79 := t73 - t75
+	t80 := complex(imag(t79), -real(t79))
+	t81 := t76 + t78
+	t82 := t77 + t80
+	t83 := t76 - t78
+	t84 := t77 - t80
+	t85 := t25 * complex(0.58778525229247314, -0.80901699437494745)
+	t86 := t38 * complex(-0.30901699437494745, -0.95105651629515353)
+	t87 := t51 * complex(-0.95105651629515353, -0.30901699437494745)
+	t88 := t12 + t86
+	t89 := t12 - t86
+	t90 := t85 + t87
+	t91 := t85 - t87
+	t92 := complex(imag(t91), -real(t91))
+	t93 := t88 + t90
+	t94 := t89 + t92
+	t95 := t88 - t90
+	t96 := t89 - t92
+	t97 := t21 * complex(0.30901699437494745, -0.95105651629515353)
+	t98 := t34 * complex(-0.80901699437494745, -0.58778525229247314)
+	t99 := t47 * complex(-0.80901699437494745, 0.58778525229247314)
+	t100 := t8 + t98
+	t101 := t8 - t98
+	t102 := t97 + t99
+	t103 := t97 - t99
+	t104 := complex(imag(t103), -real(t103))
+	t105 := t100 + t102
+	t106 := t101 + t104
+	t107 := t100 - t102
+	t108 := t101 - t104
+
+	d[0] = t57
+	d[1] = t69
+	d[2] = t81
+	d[3] = t93
+	d[4] = t105
+	d[5] = t58
+	d[6] = t70
+	d[7] = t82
+	d[8] = t94
+	d[9] = t106
+	d[10] = t59
+	d[11] = t71
+	d[12] = t83
+	d[13] = t95
+	d[14] = t107
+	d[15] = t60
+	d[16] = t72
+	d[17] = t84
+	d[18] = t96
+	d[19] = t108
+
+	return true
+}
+
+// inverseDIT20GenComplex128 computes a 20-point inverse (scaled by 1/n) FFT for complex128 data as
+// straight-line code (radices 4x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT20GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 20
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+
+	t0 := x4 + x16
+	t1 := x4 - x16
+	t2 := x8 + x12
+	t3 := x8 - x12
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t8 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t12 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t13 := x5 + x17
+	t14 := x5 - x17
+	t15 := x9 + x13
+	t16 := x9 - x13
+	t17 := x1 + t13 + t15
+	t18 := complex(real(x1)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x1)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t21 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t22 := complex(real(x1)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x1)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t25 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t26 := x6 + x18
+	t27 := x6 - x18
+	t28 := x10 + x14
+	t29 := x10 - x14
+	t30 := x2 + t26 + t28
+	t31 := complex(real(x2)+0.30901699437494745*real(t26)-0.80901699437494745*real(t28), imag(x2)+0.30901699437494745*imag(t26)-0.80901699437494745*imag(t28))
+	t32 := complex(0.95105651629515353*real(t27)+0.58778525229247314*real(t29), 0.95105651629515353*imag(t27)+0.58778525229247314*imag(t29))
+	t33 := complex(real(t31)-imag(t32), imag(t31)+real(t32))
+	t34 := complex(real(t31)+imag(t32), imag(t31)-real(t32))
+	t35 := complex(real(x2)-0.80901699437494745*real(t26)+0.30901699437494745*real(t28), imag(x2)-0.80901699437494745*imag(t26)+0.30901699437494745*imag(t28))
+	t36 := complex(0.58778525229247314*real(t27)-0.95105651629515353*real(t29), 0.58778525229247314*imag(t27)-0.95105651629515353*imag(t29))
+	t37 := complex(real(t35)-imag(t36), imag(t35)+real(t36))
+	t38 := complex(real(t35)+imag(t36), imag(t35)-real(t36))
+	t39 := x7 + x19
+	t40 := x7 - x19
+	t41 := x11 + x15
+	t42 := x11 - x15
+	t43 := x3 + t39 + t41
+	t44 := complex(real(x3)+0.30901699437494745*real(t39)-0.80901699437494745*real(t41), imag(x3)+0.30901699437494745*imag(t39)-0.80901699437494745*imag(t41))
+	t45 := complex(0.95105651629515353*real(t40)+0.58778525229247314*real(t42), 0.95105651629515353*imag(t40)+0.58778525229247314*imag(t42))
+	t46 := complex(real(t44)-imag(t45), imag(t44)+real(t45))
+	t47 := complex(real(t44)+imag(t45), imag(t44)-real(t45))
+	t48 := complex(real(x3)-0.80901699437494745*real(t39)+0.30901699437494745*real(t41), imag(x3)-0.80901699437494745*imag(t39)+0.30901699437494745*imag(t41))
+	t49 := complex(0.58778525229247314*real(t40)-0.95105651629515353*real(t42), 0.58778525229247314*imag(t40)-0.95105651629515353*imag(t42))
+	t50 := complex(real(t48)-imag(t49), imag(t48)+real(t49))
+	t51 := complex(real(t48)+imag(t49), imag(t48)-real(t49))
+	t52 := t4 + t30
+	t53 := t4 - t30
+	t54 := t17 + t43
+	t55 := t17 - t43
+	t56 := complex(-imag(t55), real(t55))
+	t57 := t52 + t54
+	t58 := t53 + t56
+	t59 := t52 - t54
+	t60 := t53 - t56
+	t61 := t20 * complex(0.95105651629515353, 0.30901699437494745)
+	t62 := t33 * complex(0.80901699437494745, 0.58778525229247314)
+	t63 := t46 * complex(0.58778525229247314, 0.80901699437494745)
+	t64 := t7 + t62
+	t65 := t7 - t62
+	t66 := t61 + t63
+	t67 := t61 - t63
+	t68 := complex(-imag(t67), real(t67))
+	t69 := t64 + t66
+	t70 := t65 + t68
+	t71 := t64 - t66
+	t72 := t65 - t68
+	t73 := t24 * complex(0.80901699437494745, 0.58778525229247314)
+	t74 := t37 * complex(0.30901699437494745, 0.95105651629515353)
+	t75 := t50 * complex(-0.30901699437494745, 0.95105651629515353)
+	t76 := t11 + t74
+	t77 := t11 - t74
+	t78 := t73 + t75
+	t79 := t73 - t75
+	t80 := complex(-imag(t79), real(t79))
+	t81 := t76 + t78
+	t82 := t77 + t80
+	t83 := t76 - t78
+	t84 := t77 - t80
+	t85 := t25 * complex(0.58778525229247314, 0.80901699437494745)
+	t86 := t38 * complex(-0.30901699437494745, 0.95105651629515353)
+	t87 := t51 * complex(-0.95105651629515353, 0.30901699437494745)
+	t88 := t12 + t86
+	t89 := t12 - t86
+	t90 := t85 + t87
+	t91 := t85 - t87
+	t92 := complex(-imag(t91), real(t91))
+	t93 := t88 + t90
+	t94 := t89 + t92
+	t95 := t88 - t90
+	t96 := t89 - t92
+	t97 := t21 * complex(0.30901699437494745, 0.95105651629515353)
+	t98 := t34 * complex(-0.80901699437494745, 0.58778525229247314)
+	t99 := t47 * complex(-0.80901699437494745, -0.58778525229247314)
+	t100 := t8 + t98
+	t101 := t8 - t98
+	t102 := t97 + t99
+	t103 := t97 - t99
+	t104 := complex(-imag(t103), real(t103))
+	t105 := t100 + t102
+	t106 := t101 + t104
+	t107 := t100 - t102
+	t108 := t101 - t104
+
+	const scale = 1.0 / 20
+
+	d[0] = complex(real(t57)*scale, imag(t57)*scale)
+	d[1] = complex(real(t69)*scale, imag(t69)*scale)
+	d[2] = complex(real(t81)*scale, imag(t81)*scale)
+	d[3] = complex(real(t93)*scale, imag(t93)*scale)
+	d[4] = complex(real(t105)*scale, imag(t105)*scale)
+	d[5] = complex(real(t58)*scale, imag(t58)*scale)
+	d[6] = complex(real(t70)*scale, imag(t70)*scale)
+	d[7] = complex(real(t82)*scale, imag(t82)*scale)
+	d[8] = complex(real(t94)*scale, imag(t94)*scale)
+	d[9] = complex(real(t106)*scale, imag(t106)*scale)
+	d[10] = complex(real(t59)*scale, imag(t59)*scale)
+	d[11] = complex(real(t71)*scale, imag(t71)*scale)
+	d[12] = complex(real(t83)*scale, imag(t83)*scale)
+	d[13] = complex(real(t95)*scale, imag(t95)*scale)
+	d[14] = complex(real(t107)*scale, imag(t107)*scale)
+	d[15] = complex(real(t60)*scale, imag(t60)*scale)
+	d[16] = complex(real(t72)*scale, imag(t72)*scale)
+	d[17] = complex(real(t84)*scale, imag(t84)*scale)
+	d[18] = complex(real(t96)*scale, imag(t96)*scale)
+	d[19] = complex(real(t108)*scale, imag(t108)*scale)
+
+	return true
+}
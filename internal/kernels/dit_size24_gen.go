@@ -0,0 +1,903 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// forwardDIT24GenComplex64 computes a 24-point forward FFT for complex64 data as
+// straight-line code (radices 4x2x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT24GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 24
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+
+	t0 := x8 + x16
+	t1 := x8 - x16
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t6 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t7 := x12 + x20
+	t8 := x12 - x20
+	t9 := x4 + t7
+	t10 := complex(real(x4)-0.5*real(t7), imag(x4)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t13 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t14 := t2 + t9
+	t15 := t2 - t9
+	t16 := t12 * complex(0.5, -0.8660254037844386)
+	t17 := t5 + t16
+	t18 := t5 - t16
+	t19 := t13 * complex(-0.5, -0.8660254037844386)
+	t20 := t6 + t19
+	t21 := t6 - t19
+	t22 := x9 + x17
+	t23 := x9 - x17
+	t24 := x1 + t22
+	t25 := complex(real(x1)-0.5*real(t22), imag(x1)-0.5*imag(t22))
+	t26 := complex(0.8660254037844386*real(t23), 0.8660254037844386*imag(t23))
+	t27 := complex(real(t25)+imag(t26), imag(t25)-real(t26))
+	t28 := complex(real(t25)-imag(t26), imag(t25)+real(t26))
+	t29 := x13 + x21
+	t30 := x13 - x21
+	t31 := x5 + t29
+	t32 := complex(real(x5)-0.5*real(t29), imag(x5)-0.5*imag(t29))
+	t33 := complex(0.8660254037844386*real(t30), 0.8660254037844386*imag(t30))
+	t34 := complex(real(t32)+imag(t33), imag(t32)-real(t33))
+	t35 := complex(real(t32)-imag(t33), imag(t32)+real(t33))
+	t36 := t24 + t31
+	t37 := t24 - t31
+	t38 := t34 * complex(0.5, -0.8660254037844386)
+	t39 := t27 + t38
+	t40 := t27 - t38
+	t41 := t35 * complex(-0.5, -0.8660254037844386)
+	t42 := t28 + t41
+	t43 := t28 - t41
+	t44 := x10 + x18
+	t45 := x10 - x18
+	t46 := x2 + t44
+	t47 := complex(real(x2)-0.5*real(t44), imag(x2)-0.5*imag(t44))
+	t48 := complex(0.8660254037844386*real(t45), 0.8660254037844386*imag(t45))
+	t49 := complex(real(t47)+imag(t48), imag(t47)-real(t48))
+	t50 := complex(real(t47)-imag(t48), imag(t47)+real(t48))
+	t51 := x14 + x22
+	t52 := x14 - x22
+	t53 := x6 + t51
+	t54 := complex(real(x6)-0.5*real(t51), imag(x6)-0.5*imag(t51))
+	t55 := complex(0.8660254037844386*real(t52), 0.8660254037844386*imag(t52))
+	t56 := complex(real(t54)+imag(t55), imag(t54)-real(t55))
+	t57 := complex(real(t54)-imag(t55), imag(t54)+real(t55))
+	t58 := t46 + t53
+	t59 := t46 - t53
+	t60 := t56 * complex(0.5, -0.8660254037844386)
+	t61 := t49 + t60
+	t62 := t49 - t60
+	t63 := t57 * complex(-0.5, -0.8660254037844386)
+	t64 := t50 + t63
+	t65 := t50 - t63
+	t66 := x11 + x19
+	t67 := x11 - x19
+	t68 := x3 + t66
+	t69 := complex(real(x3)-0.5*real(t66), imag(x3)-0.5*imag(t66))
+	t70 := complex(0.8660254037844386*real(t67), 0.8660254037844386*imag(t67))
+	t71 := complex(real(t69)+imag(t70), imag(t69)-real(t70))
+	t72 := complex(real(t69)-imag(t70), imag(t69)+real(t70))
+	t73 := x15 + x23
+	t74 := x15 - x23
+	t75 := x7 + t73
+	t76 := complex(real(x7)-0.5*real(t73), imag(x7)-0.5*imag(t73))
+	t77 := complex(0.8660254037844386*real(t74), 0.8660254037844386*imag(t74))
+	t78 := complex(real(t76)+imag(t77), imag(t76)-real(t77))
+	t79 := complex(real(t76)-imag(t77), imag(t76)+real(t77))
+	t80 := t68 + t75
+	t81 := t68 - t75
+	t82 := t78 * complex(0.5, -0.8660254037844386)
+	t83 := t71 + t82
+	t84 := t71 - t82
+	t85 := t79 * complex(-0.5, -0.8660254037844386)
+	t86 := t72 + t85
+	t87 := t72 - t85
+	t88 := t14 + t58
+	t89 := t14 - t58
+	t90 := t36 + t80
+	t91 := t36 - t80
+	t92 := complex(imag(t91), -real(t91))
+	t93 := t88 + t90
+	t94 := t89 + t92
+	t95 := t88 - t90
+	t96 := t89 - t92
+	t97 := t39 * complex(0.96592582628906831, -0.25881904510252074)
+	t98 := t61 * complex(0.8660254037844386, -0.5)
+	t99 := t83 * complex(0.70710678118654757, -0.70710678118654757)
+	t100 := t17 + t98
+	t101 := t17 - t98
+	t102 := t97 + t99
+	t103 := t97 - t99
+	t104 := complex(imag(t103), -real(t103))
+	t105 := t100 + t102
+	t106 := t101 + t104
+	t107 := t100 - t102
+	t108 := t101 - t104
+	t109 := t42 * complex(0.8660254037844386, -0.5)
+	t110 := t64 * complex(0.5, -0.8660254037844386)
+	t111 := complex(imag(t86), -real(t86))
+	t112 := t20 + t110
+	t113 := t20 - t110
+	t114 := t109 + t111
+	t115 := t109 - t111
+	t116 := complex(imag(t115), -real(t115))
+	t117 := t112 + t114
+	t118 := t113 + t116
+	t119 := t112 - t114
+	t120 := t113 - t116
+	t121 := t37 * complex(0.70710678118654757, -0.70710678118654757)
+	t122 := complex(imag(t59), -real(t59))
+	t123 := t81 * complex(-0.70710678118654757, -0.70710678118654757)
+	t124 := t15 + t122
+	t125 := t15 - t122
+	t126 := t121 + t123
+	t127 := t121 - t123
+	t128 := complex(imag(t127), -real(t127))
+	t129 := t124 + t126
+	t130 := t125 + t128
+	t131 := t124 - t126
+	t132 := t125 - t128
+	t133 := t40 * complex(0.5, -0.8660254037844386)
+	t134 := t62 * complex(-0.5, -0.8660254037844386)
+	t135 := -t84
+	t136 := t18 + t134
+	t137 := t18 - t134
+	t138 := t133 + t135
+	t139 := t133 - t135
+	t140 := complex(imag(t139), -real(t139))
+	t141 := t136 + t138
+	t142 := t137 + t140
+	t143 := t136 - t138
+	t144 := t137 - t140
+	t145 := t43 * complex(0.25881904510252074, -0.96592582628906831)
+	t146 := t65 * complex(-0.8660254037844386, -0.5)
+	t147 := t87 * complex(-0.70710678118654757, 0.70710678118654757)
+	t148 := t21 + t146
+	t149 := t21 - t146
+	t150 := t145 + t147
+	t151 := t145 - t147
+	t152 := complex(imag(t151), -real(t151))
+	t153 := t148 + t150
+	t154 := t149 + t152
+	t155 := t148 - t150
+	t156 := t149 - t152
+
+	d[0] = t93
+	d[1] = t105
+	d[2] = t117
+	d[3] = t129
+	d[4] = t141
+	d[5] = t153
+	d[6] = t94
+	d[7] = t106
+	d[8] = t118
+	d[9] = t130
+	d[10] = t142
+	d[11] = t154
+	d[12] = t95
+	d[13] = t107
+	d[14] = t119
+	d[15] = t131
+	d[16] = t143
+	d[17] = t155
+	d[18] = t96
+	d[19] = t108
+	d[20] = t120
+	d[21] = t132
+	d[22] = t144
+	d[23] = t156
+
+	return true
+}
+
+// inverseDIT24GenComplex64 computes a 24-point inverse (scaled by 1/n) FFT for complex64 data as
+// straight-line code (radices 4x2x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT24GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 24
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+
+	t0 := x8 + x16
+	t1 := x8 - x16
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t6 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t7 := x12 + x20
+	t8 := x12 - x20
+	t9 := x4 + t7
+	t10 := complex(real(x4)-0.5*real(t7), imag(x4)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t13 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t14 := t2 + t9
+	t15 := t2 - t9
+	t16 := t12 * complex(0.5, 0.8660254037844386)
+	t17 := t5 + t16
+	t18 := t5 - t16
+	t19 := t13 * complex(-0.5, 0.8660254037844386)
+	t20 := t6 + t19
+	t21 := t6 - t19
+	t22 := x9 + x17
+	t23 := x9 - x17
+	t24 := x1 + t22
+	t25 := complex(real(x1)-0.5*real(t22), imag(x1)-0.5*imag(t22))
+	t26 := complex(0.8660254037844386*real(t23), 0.8660254037844386*imag(t23))
+	t27 := complex(real(t25)-imag(t26), imag(t25)+real(t26))
+	t28 := complex(real(t25)+imag(t26), imag(t25)-real(t26))
+	t29 := x13 + x21
+	t30 := x13 - x21
+	t31 := x5 + t29
+	t32 := complex(real(x5)-0.5*real(t29), imag(x5)-0.5*imag(t29))
+	t33 := complex(0.8660254037844386*real(t30), 0.8660254037844386*imag(t30))
+	t34 := complex(real(t32)-imag(t33), imag(t32)+real(t33))
+	t35 := complex(real(t32)+imag(t33), imag(t32)-real(t33))
+	t36 := t24 + t31
+	t37 := t24 - t31
+	t38 := t34 * complex(0.5, 0.8660254037844386)
+	t39 := t27 + t38
+	t40 := t27 - t38
+	t41 := t35 * complex(-0.5, 0.8660254037844386)
+	t42 := t28 + t41
+	t43 := t28 - t41
+	t44 := x10 + x18
+	t45 := x10 - x18
+	t46 := x2 + t44
+	t47 := complex(real(x2)-0.5*real(t44), imag(x2)-0.5*imag(t44))
+	t48 := complex(0.8660254037844386*real(t45), 0.8660254037844386*imag(t45))
+	t49 := complex(real(t47)-imag(t48), imag(t47)+real(t48))
+	t50 := complex(real(t47)+imag(t48), imag(t47)-real(t48))
+	t51 := x14 + x22
+	t52 := x14 - x22
+	t53 := x6 + t51
+	t54 := complex(real(x6)-0.5*real(t51), imag(x6)-0.5*imag(t51))
+	t55 := complex(0.8660254037844386*real(t52), 0.8660254037844386*imag(t52))
+	t56 := complex(real(t54)-imag(t55), imag(t54)+real(t55))
+	t57 := complex(real(t54)+imag(t55), imag(t54)-real(t55))
+	t58 := t46 + t53
+	t59 := t46 - t53
+	t60 := t56 * complex(0.5, 0.8660254037844386)
+	t61 := t49 + t60
+	t62 := t49 - t60
+	t63 := t57 * complex(-0.5, 0.8660254037844386)
+	t64 := t50 + t63
+	t65 := t50 - t63
+	t66 := x11 + x19
+	t67 := x11 - x19
+	t68 := x3 + t66
+	t69 := complex(real(x3)-0.5*real(t66), imag(x3)-0.5*imag(t66))
+	t70 := complex(0.8660254037844386*real(t67), 0.8660254037844386*imag(t67))
+	t71 := complex(real(t69)-imag(t70), imag(t69)+real(t70))
+	t72 := complex(real(t69)+imag(t70), imag(t69)-real(t70))
+	t73 := x15 + x23
+	t74 := x15 - x23
+	t75 := x7 + t73
+	t76 := complex(real(x7)-0.5*real(t73), imag(x7)-0.5*imag(t73))
+	t77 := complex(0.8660254037844386*real(t74), 0.8660254037844386*imag(t74))
+	t78 := complex(real(t76)-imag(t77), imag(t76)+real(t77))
+	t79 := complex(real(t76)+imag(t77), imag(t76)-real(t77))
+	t80 := t68 + t75
+	t81 := t68 - t75
+	t82 := t78 * complex(0.5, 0.8660254037844386)
+	t83 := t71 + t82
+	t84 := t71 - t82
+	t85 := t79 * complex(-0.5, 0.8660254037844386)
+	t86 := t72 + t85
+	t87 := t72 - t85
+	t88 := t14 + t58
+	t89 := t14 - t58
+	t90 := t36 + t80
+	t91 := t36 - t80
+	t92 := complex(-imag(t91), real(t91))
+	t93 := t88 + t90
+	t94 := t89 + t92
+	t95 := t88 - t90
+	t96 := t89 - t92
+	t97 := t39 * complex(0.96592582628906831, 0.25881904510252074)
+	t98 := t61 * complex(0.8660254037844386, 0.5)
+	t99 := t83 * complex(0.70710678118654757, 0.70710678118654757)
+	t100 := t17 + t98
+	t101 := t17 - t98
+	t102 := t97 + t99
+	t103 := t97 - t99
+	t104 := complex(-imag(t103), real(t103))
+	t105 := t100 + t102
+	t106 := t101 + t104
+	t107 := t100 - t102
+	t108 := t101 - t104
+	t109 := t42 * complex(0.8660254037844386, 0.5)
+	t110 := t64 * complex(0.5, 0.8660254037844386)
+	t111 := complex(-imag(t86), real(t86))
+	t112 := t20 + t110
+	t113 := t20 - t110
+	t114 := t109 + t111
+	t115 := t109 - t111
+	t116 := complex(-imag(t115), real(t115))
+	t117 := t112 + t114
+	t118 := t113 + t116
+	t119 := t112 - t114
+	t120 := t113 - t116
+	t121 := t37 * complex(0.70710678118654757, 0.70710678118654757)
+	t122 := complex(-imag(t59), real(t59))
+	t123 := t81 * complex(-0.70710678118654757, 0.70710678118654757)
+	t124 := t15 + t122
+	t125 := t15 - t122
+	t126 := t121 + t123
+	t127 := t121 - t123
+	t128 := complex(-imag(t127), real(t127))
+	t129 := t124 + t126
+	t130 := t125 + t128
+	t131 := t124 - t126
+	t132 := t125 - t128
+	t133 := t40 * complex(0.5, 0.8660254037844386)
+	t134 := t62 * complex(-0.5, 0.8660254037844386)
+	t135 := -t84
+	t136 := t18 + t134
+	t137 := t18 - t134
+	t138 := t133 + t135
+	t139 := t133 - t135
+	t140 := complex(-imag(t139), real(t139))
+	t141 := t136 + t138
+	t142 := t137 + t140
+	t143 := t136 - t138
+	t144 := t137 - t140
+	t145 := t43 * complex(0.25881904510252074, 0.96592582628906831)
+	t146 := t65 * complex(-0.8660254037844386, 0.5)
+	t147 := t87 * complex(-0.70710678118654757, -0.70710678118654757)
+	t148 := t21 + t146
+	t149 := t21 - t146
+	t150 := t145 + t147
+	t151 := t145 - t147
+	t152 := complex(-imag(t151), real(t151))
+	t153 := t148 + t150
+	t154 := t149 + t152
+	t155 := t148 - t150
+	t156 := t149 - t152
+
+	const scale = 1.0 / 24
+
+	d[0] = complex(real(t93)*scale, imag(t93)*scale)
+	d[1] = complex(real(t105)*scale, imag(t105)*scale)
+	d[2] = complex(real(t117)*scale, imag(t117)*scale)
+	d[3] = complex(real(t129)*scale, imag(t129)*scale)
+	d[4] = complex(real(t141)*scale, imag(t141)*scale)
+	d[5] = complex(real(t153)*scale, imag(t153)*scale)
+	d[6] = complex(real(t94)*scale, imag(t94)*scale)
+	d[7] = complex(real(t106)*scale, imag(t106)*scale)
+	d[8] = complex(real(t118)*scale, imag(t118)*scale)
+	d[9] = complex(real(t130)*scale, imag(t130)*scale)
+	d[10] = complex(real(t142)*scale, imag(t142)*scale)
+	d[11] = complex(real(t154)*scale, imag(t154)*scale)
+	d[12] = complex(real(t95)*scale, imag(t95)*scale)
+	d[13] = complex(real(t107)*scale, imag(t107)*scale)
+	d[14] = complex(real(t119)*scale, imag(t119)*scale)
+	d[15] = complex(real(t131)*scale, imag(t131)*scale)
+	d[16] = complex(real(t143)*scale, imag(t143)*scale)
+	d[17] = complex(real(t155)*scale, imag(t155)*scale)
+	d[18] = complex(real(t96)*scale, imag(t96)*scale)
+	d[19] = complex(real(t108)*scale, imag(t108)*scale)
+	d[20] = complex(real(t120)*scale, imag(t120)*scale)
+	d[21] = complex(real(t132)*scale, imag(t132)*scale)
+	d[22] = complex(real(t144)*scale, imag(t144)*scale)
+	d[23] = complex(real(t156)*scale, imag(t156)*scale)
+
+	return true
+}
+
+// forwardDIT24GenComplex128 computes a 24-point forward FFT for complex128 data as
+// straight-line code (radices 4x2x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT24GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 24
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+
+	t0 := x8 + x16
+	t1 := x8 - x16
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t6 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t7 := x12 + x20
+	t8 := x12 - x20
+	t9 := x4 + t7
+	t10 := complex(real(x4)-0.5*real(t7), imag(x4)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t13 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t14 := t2 + t9
+	t15 := t2 - t9
+	t16 := t12 * complex(0.5, -0.8660254037844386)
+	t17 := t5 + t16
+	t18 := t5 - t16
+	t19 := t13 * complex(-0.5, -0.8660254037844386)
+	t20 := t6 + t19
+	t21 := t6 - t19
+	t22 := x9 + x17
+	t23 := x9 - x17
+	t24 := x1 + t22
+	t25 := complex(real(x1)-0.5*real(t22), imag(x1)-0.5*imag(t22))
+	t26 := complex(0.8660254037844386*real(t23), 0.8660254037844386*imag(t23))
+	t27 := complex(real(t25)+imag(t26), imag(t25)-real(t26))
+	t28 := complex(real(t25)-imag(t26), imag(t25)+real(t26))
+	t29 := x13 + x21
+	t30 := x13 - x21
+	t31 := x5 + t29
+	t32 := complex(real(x5)-0.5*real(t29), imag(x5)-0.5*imag(t29))
+	t33 := complex(0.8660254037844386*real(t30), 0.8660254037844386*imag(t30))
+	t34 := complex(real(t32)+imag(t33), imag(t32)-real(t33))
+	t35 := complex(real(t32)-imag(t33), imag(t32)+real(t33))
+	t36 := t24 + t31
+	t37 := t24 - t31
+	t38 := t34 * complex(0.5, -0.8660254037844386)
+	t39 := t27 + t38
+	t40 := t27 - t38
+	t41 := t35 * complex(-0.5, -0.8660254037844386)
+	t42 := t28 + t41
+	t43 := t28 - t41
+	t44 := x10 + x18
+	t45 := x10 - x18
+	t46 := x2 + t44
+	t47 := complex(real(x2)-0.5*real(t44), imag(x2)-0.5*imag(t44))
+	t48 := complex(0.8660254037844386*real(t45), 0.8660254037844386*imag(t45))
+	t49 := complex(real(t47)+imag(t48), imag(t47)-real(t48))
+	t50 := complex(real(t47)-imag(t48), imag(t47)+real(t48))
+	t51 := x14 + x22
+	t52 := x14 - x22
+	t53 := x6 + t51
+	t54 := complex(real(x6)-0.5*real(t51), imag(x6)-0.5*imag(t51))
+	t55 := complex(0.8660254037844386*real(t52), 0.8660254037844386*imag(t52))
+	t56 := complex(real(t54)+imag(t55), imag(t54)-real(t55))
+	t57 := complex(real(t54)-imag(t55), imag(t54)+real(t55))
+	t58 := t46 + t53
+	t59 := t46 - t53
+	t60 := t56 * complex(0.5, -0.8660254037844386)
+	t61 := t49 + t60
+	t62 := t49 - t60
+	t63 := t57 * complex(-0.5, -0.8660254037844386)
+	t64 := t50 + t63
+	t65 := t50 - t63
+	t66 := x11 + x19
+	t67 := x11 - x19
+	t68 := x3 + t66
+	t69 := complex(real(x3)-0.5*real(t66), imag(x3)-0.5*imag(t66))
+	t70 := complex(0.8660254037844386*real(t67), 0.8660254037844386*imag(t67))
+	t71 := complex(real(t69)+imag(t70), imag(t69)-real(t70))
+	t72 := complex(real(t69)-imag(t70), imag(t69)+real(t70))
+	t73 := x15 + x23
+	t74 := x15 - x23
+	t75 := x7 + t73
+	t76 := complex(real(x7)-0.5*real(t73), imag(x7)-0.5*imag(t73))
+	t77 := complex(0.8660254037844386*real(t74), 0.8660254037844386*imag(t74))
+	t78 := complex(real(t76)+imag(t77), imag(t76)-real(t77))
+	t79 := complex(real(t76)-imag(t77), imag(t76)+real(t77))
+	t80 := t68 + t75
+	t81 := t68 - t75
+	t82 := t78 * complex(0.5, -0.8660254037844386)
+	t83 := t71 + t82
+	t84 := t71 - t82
+	t85 := t79 * complex(-0.5, -0.8660254037844386)
+	t86 := t72 + t85
+	t87 := t72 - t85
+	t88 := t14 + t58
+	t89 := t14 - t58
+	t90 := t36 + t80
+	t91 := t36 - t80
+	t92 := complex(imag(t91), -real(t91))
+	t93 := t88 + t90
+	t94 := t89 + t92
+	t95 := t88 - t90
+	t96 := t89 - t92
+	t97 := t39 * complex(0.96592582628906831, -0.25881904510252074)
+	t98 := t61 * complex(0.8660254037844386, -0.5)
+	t99 := t83 * complex(0.70710678118654757, -0.70710678118654757)
+	t100 := t17 + t98
+	t101 := t17 - t98
+	t102 := t97 + t99
+	t103 := t97 - t99
+	t104 := complex(imag(t103), -real(t103))
+	t105 := t100 + t102
+	t106 := t101 + t104
+	t107 := t100 - t102
+	t108 := t101 - t104
+	t109 := t42 * complex(0.8660254037844386, -0.5)
+	t110 := t64 * complex(0.5, -0.8660254037844386)
+	t111 := complex(imag(t86), -real(t86))
+	t112 := t20 + t110
+	t113 := t20 - t110
+	t114 := t109 + t111
+	t115 := t109 - t111
+	t116 := complex(imag(t115), -real(t115))
+	t117 := t112 + t114
+	t118 := t113 + t116
+	t119 := t112 - t114
+	t120 := t113 - t116
+	t121 := t37 * complex(0.70710678118654757, -0.70710678118654757)
+	t122 := complex(imag(t59), -real(t59))
+	t123 := t81 * complex(-0.70710678118654757, -0.70710678118654757)
+	t124 := t15 + t122
+	t125 := t15 - t122
+	t126 := t121 + t123
+	t127 := t121 - t123
+	t128 := complex(imag(t127), -real(t127))
+	t129 := t124 + t126
+	t130 := t125 + t128
+	t131 := t124 - t126
+	t132 := t125 - t128
+	t133 := t40 * complex(0.5, -0.8660254037844386)
+	t134 := t62 * complex(-0.5, -0.8660254037844386)
+	t135 := -t84
+	t136 := t18 + t134
+	t137 := t18 - t134
+	t138 := t133 + t135
+	t139 := t133 - t135
+	t140 := complex(imag(t139), -real(t139))
+	t141 := t136 + t138
+	t142 := t137 + t140
+	t143 := t136 - t138
+	t144 := t137 - t140
+	t145 := t43 * complex(0.25881904510252074, -0.96592582628906831)
+	t146 := t65 * complex(-0.8660254037844386, -0.5)
+	t147 := t87 * complex(-0.70710678118654757, 0.70710678118654757)
+	t148 := t21 + t146
+	t149 := t21 - t146
+	t150 := t145 + t147
+	t151 := t145 - t147
+	t152 := complex(imag(t151), -real(t151))
+	t153 := t148 + t150
+	t154 := t149 + t152
+	t155 := t148 - t150
+	t156 := t149 - t152
+
+	d[0] = t93
+	d[1] = t105
+	d[2] = t117
+	d[3] = t129
+	d[4] = t141
+	d[5] = t153
+	d[6] = t94
+	d[7] = t106
+	d[8] = t118
+	d[9] = t130
+	d[10] = t142
+	d[11] = t154
+	d[12] = t95
+	d[13] = t107
+	d[14] = t119
+	d[15] = t131
+	d[16] = t143
+	d[17] = t155
+	d[18] = t96
+	d[19] = t108
+	d[20] = t120
+	d[21] = t132
+	d[22] = t144
+	d[23] = t156
+
+	return true
+}
+
+// inverseDIT24GenComplex128 computes a 24-point inverse (scaled by 1/n) FFT for complex128 data as
+// straight-line code (radices 4x2x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT24GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 24
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+
+	t0 := x8 + x16
+	t1 := x8 - x16
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t6 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t7 := x12 + x20
+	t8 := x12 - x20
+	t9 := x4 + t7
+	t10 := complex(real(x4)-0.5*real(t7), imag(x4)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t13 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t14 := t2 + t9
+	t15 := t2 - t9
+	t16 := t12 * complex(0.5, 0.8660254037844386)
+	t17 := t5 + t16
+	t18 := t5 - t16
+	t19 := t13 * complex(-0.5, 0.8660254037844386)
+	t20 := t6 + t19
+	t21 := t6 - t19
+	t22 := x9 + x17
+	t23 := x9 - x17
+	t24 := x1 + t22
+	t25 := complex(real(x1)-0.5*real(t22), imag(x1)-0.5*imag(t22))
+	t26 := complex(0.8660254037844386*real(t23), 0.8660254037844386*imag(t23))
+	t27 := complex(real(t25)-imag(t26), imag(t25)+real(t26))
+	t28 := complex(real(t25)+imag(t26), imag(t25)-real(t26))
+	t29 := x13 + x21
+	t30 := x13 - x21
+	t31 := x5 + t29
+	t32 := complex(real(x5)-0.5*real(t29), imag(x5)-0.5*imag(t29))
+	t33 := complex(0.8660254037844386*real(t30), 0.8660254037844386*imag(t30))
+	t34 := complex(real(t32)-imag(t33), imag(t32)+real(t33))
+	t35 := complex(real(t32)+imag(t33), imag(t32)-real(t33))
+	t36 := t24 + t31
+	t37 := t24 - t31
+	t38 := t34 * complex(0.5, 0.8660254037844386)
+	t39 := t27 + t38
+	t40 := t27 - t38
+	t41 := t35 * complex(-0.5, 0.8660254037844386)
+	t42 := t28 + t41
+	t43 := t28 - t41
+	t44 := x10 + x18
+	t45 := x10 - x18
+	t46 := x2 + t44
+	t47 := complex(real(x2)-0.5*real(t44), imag(x2)-0.5*imag(t44))
+	t48 := complex(0.8660254037844386*real(t45), 0.8660254037844386*imag(t45))
+	t49 := complex(real(t47)-imag(t48), imag(t47)+real(t48))
+	t50 := complex(real(t47)+imag(t48), imag(t47)-real(t48))
+	t51 := x14 + x22
+	t52 := x14 - x22
+	t53 := x6 + t51
+	t54 := complex(real(x6)-0.5*real(t51), imag(x6)-0.5*imag(t51))
+	t55 := complex(0.8660254037844386*real(t52), 0.8660254037844386*imag(t52))
+	t56 := complex(real(t54)-imag(t55), imag(t54)+real(t55))
+	t57 := complex(real(t54)+imag(t55), imag(t54)-real(t55))
+	t58 := t46 + t53
+	t59 := t46 - t53
+	t60 := t56 * complex(0.5, 0.8660254037844386)
+	t61 := t49 + t60
+	t62 := t49 - t60
+	t63 := t57 * complex(-0.5, 0.8660254037844386)
+	t64 := t50 + t63
+	t65 := t50 - t63
+	t66 := x11 + x19
+	t67 := x11 - x19
+	t68 := x3 + t66
+	t69 := complex(real(x3)-0.5*real(t66), imag(x3)-0.5*imag(t66))
+	t70 := complex(0.8660254037844386*real(t67), 0.8660254037844386*imag(t67))
+	t71 := complex(real(t69)-imag(t70), imag(t69)+real(t70))
+	t72 := complex(real(t69)+imag(t70), imag(t69)-real(t70))
+	t73 := x15 + x23
+	t74 := x15 - x23
+	t75 := x7 + t73
+	t76 := complex(real(x7)-0.5*real(t73), imag(x7)-0.5*imag(t73))
+	t77 := complex(0.8660254037844386*real(t74), 0.8660254037844386*imag(t74))
+	t78 := complex(real(t76)-imag(t77), imag(t76)+real(t77))
+	t79 := complex(real(t76)+imag(t77), imag(t76)-real(t77))
+	t80 := t68 + t75
+	t81 := t68 - t75
+	t82 := t78 * complex(0.5, 0.8660254037844386)
+	t83 := t71 + t82
+	t84 := t71 - t82
+	t85 := t79 * complex(-0.5, 0.8660254037844386)
+	t86 := t72 + t85
+	t87 := t72 - t85
+	t88 := t14 + t58
+	t89 := t14 - t58
+	t90 := t36 + t80
+	t91 := t36 - t80
+	t92 := complex(-imag(t91), real(t91))
+	t93 := t88 + t90
+	t94 := t89 + t92
+	t95 := t88 - t90
+	t96 := t89 - t92
+	t97 := t39 * complex(0.96592582628906831, 0.25881904510252074)
+	t98 := t61 * complex(0.8660254037844386, 0.5)
+	t99 := t83 * complex(0.70710678118654757, 0.70710678118654757)
+	t100 := t17 + t98
+	t101 := t17 - t98
+	t102 := t97 + t99
+	t103 := t97 - t99
+	t104 := complex(-imag(t103), real(t103))
+	t105 := t100 + t102
+	t106 := t101 + t104
+	t107 := t100 - t102
+	t108 := t101 - t104
+	t109 := t42 * complex(0.8660254037844386, 0.5)
+	t110 := t64 * complex(0.5, 0.8660254037844386)
+	t111 := complex(-imag(t86), real(t86))
+	t112 := t20 + t110
+	t113 := t20 - t110
+	t114 := t109 + t111
+	t115 := t109 - t111
+	t116 := complex(-imag(t115), real(t115))
+	t117 := t112 + t114
+	t118 := t113 + t116
+	t119 := t112 - t114
+	t120 := t113 - t116
+	t121 := t37 * complex(0.70710678118654757, 0.70710678118654757)
+	t122 := complex(-imag(t59), real(t59))
+	t123 := t81 * complex(-0.70710678118654757, 0.70710678118654757)
+	t124 := t15 + t122
+	t125 := t15 - t122
+	t126 := t121 + t123
+	t127 := t121 - t123
+	t128 := complex(-imag(t127), real(t127))
+	t129 := t124 + t126
+	t130 := t125 + t128
+	t131 := t124 - t126
+	t132 := t125 - t128
+	t133 := t40 * complex(0.5, 0.8660254037844386)
+	t134 := t62 * complex(-0.5, 0.8660254037844386)
+	t135 := -t84
+	t136 := t18 + t134
+	t137 := t18 - t134
+	t138 := t133 + t135
+	t139 := t133 - t135
+	t140 := complex(-imag(t139), real(t139))
+	t141 := t136 + t138
+	t142 := t137 + t140
+	t143 := t136 - t138
+	t144 := t137 - t140
+	t145 := t43 * complex(0.25881904510252074, 0.96592582628906831)
+	t146 := t65 * complex(-0.8660254037844386, 0.5)
+	t147 := t87 * complex(-0.70710678118654757, -0.70710678118654757)
+	t148 := t21 + t146
+	t149 := t21 - t146
+	t150 := t145 + t147
+	t151 := t145 - t147
+	t152 := complex(-imag(t151), real(t151))
+	t153 := t148 + t150
+	t154 := t149 + t152
+	t155 := t148 - t150
+	t156 := t149 - t152
+
+	const scale = 1.0 / 24
+
+	d[0] = complex(real(t93)*scale, imag(t93)*scale)
+	d[1] = complex(real(t105)*scale, imag(t105)*scale)
+	d[2] = complex(real(t117)*scale, imag(t117)*scale)
+	d[3] = complex(real(t129)*scale, imag(t129)*scale)
+	d[4] = complex(real(t141)*scale, imag(t141)*scale)
+	d[5] = complex(real(t153)*scale, imag(t153)*scale)
+	d[6] = complex(real(t94)*scale, imag(t94)*scale)
+	d[7] = complex(real(t106)*scale, imag(t106)*scale)
+	d[8] = complex(real(t118)*scale, imag(t118)*scale)
+	d[9] = complex(real(t130)*scale, imag(t130)*scale)
+	d[10] = complex(real(t142)*scale, imag(t142)*scale)
+	d[11] = complex(real(t154)*scale, imag(t154)*scale)
+	d[12] = complex(real(t95)*scale, imag(t95)*scale)
+	d[13] = complex(real(t107)*scale, imag(t107)*scale)
+	d[14] = complex(real(t119)*scale, imag(t119)*scale)
+	d[15] = complex(real(t131)*scale, imag(t131)*scale)
+	d[16] = complex(real(t143)*scale, imag(t143)*scale)
+	d[17] = complex(real(t155)*scale, imag(t155)*scale)
+	d[18] = complex(real(t96)*scale, imag(t96)*scale)
+	d[19] = complex(real(t108)*scale, imag(t108)*scale)
+	d[20] = complex(real(t120)*scale, imag(t120)*scale)
+	d[21] = complex(real(t132)*scale, imag(t132)*scale)
+	d[22] = complex(real(t144)*scale, imag(t144)*scale)
+	d[23] = complex(real(t156)*scale, imag(t156)*scale)
+
+	return true
+}
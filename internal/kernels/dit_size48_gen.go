@@ -0,0 +1,2007 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// forwardDIT48GenComplex64 computes a 48-point forward FFT for complex64 data as
+// straight-line code (radices 4x4x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT48GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 48
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+
+	t0 := x16 + x32
+	t1 := x16 - x32
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t6 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t7 := x20 + x36
+	t8 := x20 - x36
+	t9 := x4 + t7
+	t10 := complex(real(x4)-0.5*real(t7), imag(x4)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t13 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t14 := x24 + x40
+	t15 := x24 - x40
+	t16 := x8 + t14
+	t17 := complex(real(x8)-0.5*real(t14), imag(x8)-0.5*imag(t14))
+	t18 := complex(0.8660254037844386*real(t15), 0.8660254037844386*imag(t15))
+	t19 := complex(real(t17)+imag(t18), imag(t17)-real(t18))
+	t20 := complex(real(t17)-imag(t18), imag(t17)+real(t18))
+	t21 := x28 + x44
+	t22 := x28 - x44
+	t23 := x12 + t21
+	t24 := complex(real(x12)-0.5*real(t21), imag(x12)-0.5*imag(t21))
+	t25 := complex(0.8660254037844386*real(t22), 0.8660254037844386*imag(t22))
+	t26 := complex(real(t24)+imag(t25), imag(t24)-real(t25))
+	t27 := complex(real(t24)-imag(t25), imag(t24)+real(t25))
+	t28 := t2 + t16
+	t29 := t2 - t16
+	t30 := t9 + t23
+	t31 := t9 - t23
+	t32 := complex(imag(t31), -real(t31))
+	t33 := t28 + t30
+	t34 := t29 + t32
+	t35 := t28 - t30
+	t36 := t29 - t32
+	t37 := t12 * complex(0.8660254037844386, -0.5)
+	t38 := t19 * complex(0.5, -0.8660254037844386)
+	t39 := complex(imag(t26), -real(t26))
+	t40 := t5 + t38
+	t41 := t5 - t38
+	t42 := t37 + t39
+	t43 := t37 - t39
+	t44 := complex(imag(t43), -real(t43))
+	t45 := t40 + t42
+	t46 := t41 + t44
+	t47 := t40 - t42
+	t48 := t41 - t44
+	t49 := t13 * complex(0.5, -0.8660254037844386)
+	t50 := t20 * complex(-0.5, -0.8660254037844386)
+	t51 := -t27
+	t52 := t6 + t50
+	t53 := t6 - t50
+	t54 := t49 + t51
+	t55 := t49 - t51
+	t56 := complex(imag(t55), -real(t55))
+	t57 := t52 + t54
+	t58 := t53 + t56
+	t59 := t52 - t54
+	t60 := t53 - t56
+	t61 := x17 + x33
+	t62 := x17 - x33
+	t63 := x1 + t61
+	t64 := complex(real(x1)-0.5*real(t61), imag(x1)-0.5*imag(t61))
+	t65 := complex(0.8660254037844386*real(t62), 0.8660254037844386*imag(t62))
+	t66 := complex(real(t64)+imag(t65), imag(t64)-real(t65))
+	t67 := complex(real(t64)-imag(t65), imag(t64)+real(t65))
+	t68 := x21 + x37
+	t69 := x21 - x37
+	t70 := x5 + t68
+	t71 := complex(real(x5)-0.5*real(t68), imag(x5)-0.5*imag(t68))
+	t72 := complex(0.8660254037844386*real(t69), 0.8660254037844386*imag(t69))
+	t73 := complex(real(t71)+imag(t72), imag(t71)-real(t72))
+	t74 := complex(real(t71)-imag(t72), imag(t71)+real(t72))
+	t75 := x25 + x41
+	t76 := x25 - x41
+	t77 := x9 + t75
+	t78 := complex(real(x9)-0.5*real(t75), imag(x9)-0.5*imag(t75))
+	t79 := complex(0.8660254037844386*real(t76), 0.8660254037844386*imag(t76))
+	t80 := complex(real(t78)+imag(t79), imag(t78)-real(t79))
+	t81 := complex(real(t78)-imag(t79), imag(t78)+real(t79))
+	t82 := x29 + x45
+	t83 := x29 - x45
+	t84 := x13 + t82
+	t85 := complex(real(x13)-0.5*real(t82), imag(x13)-0.5*imag(t82))
+	t86 := complex(0.8660254037844386*real(t83), 0.8660254037844386*imag(t83))
+	t87 := complex(real(t85)+imag(t86), imag(t85)-real(t86))
+	t88 := complex(real(t85)-imag(t86), imag(t85)+real(t86))
+	t89 := t63 + t77
+	t90 := t63 - t77
+	t91 := t70 + t84
+	t92 := t70 - t84
+	t93 := complex(imag(t92), -real(t92))
+	t94 := t89 + t91
+	t95 := t90 + t93
+	t96 := t89 - t91
+	t97 := t90 - t93
+	t98 := t73 * complex(0.8660254037844386, -0.5)
+	t99 := t80 * complex(0.5, -0.8660254037844386)
+	t100 := complex(imag(t87), -real(t87))
+	t101 := t66 + t99
+	t102 := t66 - t99
+	t103 := t98 + t100
+	t104 := t98 - t100
+	t105 := complex(imag(t104), -real(t104))
+	t106 := t101 + t103
+	t107 := t102 + t105
+	t108 := t101 - t103
+	t109 := t102 - t105
+	t110 := t74 * complex(0.5, -0.8660254037844386)
+	t111 := t81 * complex(-0.5, -0.8660254037844386)
+	t112 := -t88
+	t113 := t67 + t111
+	t114 := t67 - t111
+	t115 := t110 + t112
+	t116 := t110 - t112
+	t117 := complex(imag(t116), -real(t116))
+	t118 := t113 + t115
+	t119 := t114 + t117
+	t120 := t113 - t115
+	t121 := t114 - t117
+	t122 := x18 + x34
+	t123 := x18 - x34
+	t124 := x2 + t122
+	t125 := complex(real(x2)-0.5*real(t122), imag(x2)-0.5*imag(t122))
+	t126 := complex(0.8660254037844386*real(t123), 0.8660254037844386*imag(t123))
+	t127 := complex(real(t125)+imag(t126), imag(t125)-real(t126))
+	t128 := complex(real(t125)-imag(t126), imag(t125)+real(t126))
+	t129 := x22 + x38
+	t130 := x22 - x38
+	t131 := x6 + t129
+	t132 := complex(real(x6)-0.5*real(t129), imag(x6)-0.5*imag(t129))
+	t133 := complex(0.8660254037844386*real(t130), 0.8660254037844386*imag(t130))
+	t134 := complex(real(t132)+imag(t133), imag(t132)-real(t133))
+	t135 := complex(real(t132)-imag(t133), imag(t132)+real(t133))
+	t136 := x26 + x42
+	t137 := x26 - x42
+	t138 := x10 + t136
+	t139 := complex(real(x10)-0.5*real(t136), imag(x10)-0.5*imag(t136))
+	t140 := complex(0.8660254037844386*real(t137), 0.8660254037844386*imag(t137))
+	t141 := complex(real(t139)+imag(t140), imag(t139)-real(t140))
+	t142 := complex(real(t139)-imag(t140), imag(t139)+real(t140))
+	t143 := x30 + x46
+	t144 := x30 - x46
+	t145 := x14 + t143
+	t146 := complex(real(x14)-0.5*real(t143), imag(x14)-0.5*imag(t143))
+	t147 := complex(0.8660254037844386*real(t144), 0.8660254037844386*imag(t144))
+	t148 := complex(real(t146)+imag(t147), imag(t146)-real(t147))
+	t149 := complex(real(t146)-imag(t147), imag(t146)+real(t147))
+	t150 := t124 + t138
+	t151 := t124 - t138
+	t152 := t131 + t145
+	t153 := t131 - t145
+	t154 := complex(imag(t153), -real(t153))
+	t155 := t150 + t152
+	t156 := t151 + t154
+	t157 := t150 - t152
+	t158 := t151 - t154
+	t159 := t134 * complex(0.8660254037844386, -0.5)
+	t160 := t141 * complex(0.5, -0.8660254037844386)
+	t161 := complex(imag(t148), -real(t148))
+	t162 := t127 + t160
+	t163 := t127 - t160
+	t164 := t159 + t161
+	t165 := t159 - t161
+	t166 := complex(imag(t165), -real(t165))
+	t167 := t162 + t164
+	t168 := t163 + t166
+	t169 := t162 - t164
+	t170 := t163 - t166
+	t171 := t135 * complex(0.5, -0.8660254037844386)
+	t172 := t142 * complex(-0.5, -0.8660254037844386)
+	t173 := -t149
+	t174 := t128 + t172
+	t175 := t128 - t172
+	t176 := t171 + t173
+	t177 := t171 - t173
+	t178 := complex(imag(t177), -real(t177))
+	t179 := t174 + t176
+	t180 := t175 + t178
+	t181 := t174 - t176
+	t182 := t175 - t178
+	t183 := x19 + x35
+	t184 := x19 - x35
+	t185 := x3 + t183
+	t186 := complex(real(x3)-0.5*real(t183), imag(x3)-0.5*imag(t183))
+	t187 := complex(0.8660254037844386*real(t184), 0.8660254037844386*imag(t184))
+	t188 := complex(real(t186)+imag(t187), imag(t186)-real(t187))
+	t189 := complex(real(t186)-imag(t187), imag(t186)+real(t187))
+	t190 := x23 + x39
+	t191 := x23 - x39
+	t192 := x7 + t190
+	t193 := complex(real(x7)-0.5*real(t190), imag(x7)-0.5*imag(t190))
+	t194 := complex(0.8660254037844386*real(t191), 0.8660254037844386*imag(t191))
+	t195 := complex(real(t193)+imag(t194), imag(t193)-real(t194))
+	t196 := complex(real(t193)-imag(t194), imag(t193)+real(t194))
+	t197 := x27 + x43
+	t198 := x27 - x43
+	t199 := x11 + t197
+	t200 := complex(real(x11)-0.5*real(t197), imag(x11)-0.5*imag(t197))
+	t201 := complex(0.8660254037844386*real(t198), 0.8660254037844386*imag(t198))
+	t202 := complex(real(t200)+imag(t201), imag(t200)-real(t201))
+	t203 := complex(real(t200)-imag(t201), imag(t200)+real(t201))
+	t204 := x31 + x47
+	t205 := x31 - x47
+	t206 := x15 + t204
+	t207 := complex(real(x15)-0.5*real(t204), imag(x15)-0.5*imag(t204))
+	t208 := complex(0.8660254037844386*real(t205), 0.8660254037844386*imag(t205))
+	t209 := complex(real(t207)+imag(t208), imag(t207)-real(t208))
+	t210 := complex(real(t207)-imag(t208), imag(t207)+real(t208))
+	t211 := t185 + t199
+	t212 := t185 - t199
+	t213 := t192 + t206
+	t214 := t192 - t206
+	t215 := complex(imag(t214), -real(t214))
+	t216 := t211 + t213
+	t217 := t212 + t215
+	t218 := t211 - t213
+	t219 := t212 - t215
+	t220 := t195 * complex(0.8660254037844386, -0.5)
+	t221 := t202 * complex(0.5, -0.8660254037844386)
+	t222 := complex(imag(t209), -real(t209))
+	t223 := t188 + t221
+	t224 := t188 - t221
+	t225 := t220 + t222
+	t226 := t220 - t222
+	t227 := complex(imag(t226), -real(t226))
+	t228 := t223 + t225
+	t229 := t224 + t227
+	t230 := t223 - t225
+	t231 := t224 - t227
+	t232 := t196 * complex(0.5, -0.8660254037844386)
+	t233 := t203 * complex(-0.5, -0.8660254037844386)
+	t234 := -t210
+	t235 := t189 + t233
+	t236 := t189 - t233
+	t237 := t232 + t234
+	t238 := t232 - t234
+	t239 := complex(imag(t238), -real(t238))
+	t240 := t235 + t237
+	t241 := t236 + t239
+	t242 := t235 - t237
+	t243 := t236 - t239
+	t244 := t33 + t155
+	t245 := t33 - t155
+	t246 := t94 + t216
+	t247 := t94 - t216
+	t248 := complex(imag(t247), -real(t247))
+	t249 := t244 + t246
+	t250 := t245 + t248
+	t251 := t244 - t246
+	t252 := t245 - t248
+	t253 := t106 * complex(0.99144486137381038, -0.1305261922200516)
+	t254 := t167 * complex(0.96592582628906831, -0.25881904510252074)
+	t255 := t228 * complex(0.92387953251128674, -0.38268343236508978)
+	t256 := t45 + t254
+	t257 := t45 - t254
+	t258 := t253 + t255
+	t259 := t253 - t255
+	t260 := complex(imag(t259), -real(t259))
+	t261 := t256 + t258
+	t262 := t257 + t260
+	t263 := t256 - t258
+	t264 := t257 - t260
+	t265 := t118 * complex(0.96592582628906831, -0.25881904510252074)
+	t266 := t179 * complex(0.8660254037844386, -0.5)
+	t267 := t240 * complex(0.70710678118654757, -0.70710678118654757)
+	t268 := t57 + t266
+	t269 := t57 - t266
+	t270 := t265 + t267
+	t271 := t265 - t267
+	t272 := complex(imag(t271), -real(t271))
+	t273 := t268 + t270
+	t274 := t269 + t272
+	t275 := t268 - t270
+	t276 := t269 - t272
+	t277 := t95 * complex(0.92387953251128674, -0.38268343236508978)
+	t278 := t156 * complex(0.70710678118654757, -0.70710678118654757)
+	t279 := t217 * complex(0.38268343236508978, -0.92387953251128674)
+	t280 := t34 + t278
+	t281 := t34 - t278
+	t282 := t277 + t279
+	t283 := t277 - t279
+	t284 := complex(imag(t283), -real(t283))
+	t285 := t280 + t282
+	t286 := t281 + t284
+	t287 := t280 - t282
+	t288 := t281 - t284
+	t289 := t107 * complex(0.8660254037844386, -0.5)
+	t290 := t168 * complex(0.5, -0.8660254037844386)
+	t291 := complex(imag(t229), -real(t229))
+	t292 := t46 + t290
+	t293 := t46 - t290
+	t294 := t289 + t291
+	t295 := t289 - t291
+	t296 := complex(imag(t295), -real(t295))
+	t297 := t292 + t294
+	t298 := t293 + t296
+	t299 := t292 - t294
+	t300 := t293 - t296
+	t301 := t119 * complex(0.79335334029123517, -0.60876142900872066)
+	t302 := t180 * complex(0.25881904510252074, -0.96592582628906831)
+	t303 := t241 * complex(-0.38268343236508978, -0.92387953251128674)
+	t304 := t58 + t302
+	t305 := t58 - t302
+	t306 := t301 + t303
+	t307 := t301 - t303
+	t308 := complex(imag(t307), -real(t307))
+	t309 := t304 + t306
+	t310 := t305 + t308
+	t311 := t304 - t306
+	t312 := t305 - t308
+	t313 := t96 * complex(0.70710678118654757, -0.70710678118654757)
+	t314 := complex(imag(t157), -real(t157))
+	t315 := t218 * complex(-0.70710678118654757, -0.70710678118654757)
+	t316 := t35 + t314
+	t317 := t35 - t314
+	t318 := t313 + t315
+	t319 := t313 - t315
+	t320 := complex(imag(t319), -real(t319))
+	t321 := t316 + t318
+	t322 := t317 + t320
+	t323 := t316 - t318
+	t324 := t317 - t320
+	t325 := t108 * complex(0.60876142900872066, -0.79335334029123517)
+	t326 := t169 * complex(-0.25881904510252074, -0.96592582628906831)
+	t327 := t230 * complex(-0.92387953251128674, -0.38268343236508978)
+	t328 := t47 + t326
+	t329 := t47 - t326
+	t330 := t325 + t327
+	t331 := t325 - t327
+	t332 := complex(imag(t331), -real(t331))
+	t333 := t328 + t330
+	t334 := t329 + t332
+	t335 := t328 - t330
+	t336 := t329 - t332
+	t337 := t120 * complex(0.5, -0.8660254037844386)
+	t338 := t181 * complex(-0.5, -0.8660254037844386)
+	t339 := -t242
+	t340 := t59 + t338
+	t341 := t59 - t338
+	t342 := t337 + t339
+	t343 := t337 - t339
+	t344 := complex(imag(t343), -real(t343))
+	t345 := t340 + t342
+	t346 := t341 + t344
+	t347 := t340 - t342
+	t348 := t341 - t344
+	t349 := t97 * complex(0.38268343236508978, -0.92387953251128674)
+	t350 := t158 * complex(-0.70710678118654757, -0.70710678118654757)
+	t351 := t219 * complex(-0.92387953251128674, 0.38268343236508978)
+	t352 := t36 + t350
+	t353 := t36 - t350
+	t354 := t349 + t351
+	t355 := t349 - t351
+	t356 := complex(imag(t355), -real(t355))
+	t357 := t352 + t354
+	t358 := t353 + t356
+	t359 := t352 - t354
+	t360 := t353 - t356
+	t361 := t109 * complex(0.25881904510252074, -0.96592582628906831)
+	t362 := t170 * complex(-0.8660254037844386, -0.5)
+	t363 := t231 * complex(-0.70710678118654757, 0.70710678118654757)
+	t364 := t48 + t362
+	t365 := t48 - t362
+	t366 := t361 + t363
+	t367 := t361 - t363
+	t368 := complex(imag(t367), -real(t367))
+	t369 := t364 + t366
+	t370 := t365 + t368
+	t371 := t364 - t366
+	t372 := t365 - t368
+	t373 := t121 * complex(0.1305261922200516, -0.99144486137381038)
+	t374 := t182 * complex(-0.96592582628906831, -0.25881904510252074)
+	t375 := t243 * complex(-0.38268343236508978, 0.92387953251128674)
+	t376 := t60 + t374
+	t377 := t60 - t374
+	t378 := t373 + t375
+	t379 := t373 - t375
+	t380 := complex(imag(t379), -real(t379))
+	t381 := t376 + t378
+	t382 := t377 + t380
+	t383 := t376 - t378
+	t384 := t377 - t380
+
+	d[0] = t249
+	d[1] = t261
+	d[2] = t273
+	d[3] = t285
+	d[4] = t297
+	d[5] = t309
+	d[6] = t321
+	d[7] = t333
+	d[8] = t345
+	d[9] = t357
+	d[10] = t369
+	d[11] = t381
+	d[12] = t250
+	d[13] = t262
+	d[14] = t274
+	d[15] = t286
+	d[16] = t298
+	d[17] = t310
+	d[18] = t322
+	d[19] = t334
+	d[20] = t346
+	d[21] = t358
+	d[22] = t370
+	d[23] = t382
+	d[24] = t251
+	d[25] = t263
+	d[26] = t275
+	d[27] = t287
+	d[28] = t299
+	d[29] = t311
+	d[30] = t323
+	d[31] = t335
+	d[32] = t347
+	d[33] = t359
+	d[34] = t371
+	d[35] = t383
+	d[36] = t252
+	d[37] = t264
+	d[38] = t276
+	d[39] = t288
+	d[40] = t300
+	d[41] = t312
+	d[42] = t324
+	d[43] = t336
+	d[44] = t348
+	d[45] = t360
+	d[46] = t372
+	d[47] = t384
+
+	return true
+}
+
+// inverseDIT48GenComplex64 computes a 48-point inverse (scaled by 1/n) FFT for complex64 data as
+// straight-line code (radices 4x4x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT48GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 48
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+
+	t0 := x16 + x32
+	t1 := x16 - x32
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t6 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t7 := x20 + x36
+	t8 := x20 - x36
+	t9 := x4 + t7
+	t10 := complex(real(x4)-0.5*real(t7), imag(x4)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t13 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t14 := x24 + x40
+	t15 := x24 - x40
+	t16 := x8 + t14
+	t17 := complex(real(x8)-0.5*real(t14), imag(x8)-0.5*imag(t14))
+	t18 := complex(0.8660254037844386*real(t15), 0.8660254037844386*imag(t15))
+	t19 := complex(real(t17)-imag(t18), imag(t17)+real(t18))
+	t20 := complex(real(t17)+imag(t18), imag(t17)-real(t18))
+	t21 := x28 + x44
+	t22 := x28 - x44
+	t23 := x12 + t21
+	t24 := complex(real(x12)-0.5*real(t21), imag(x12)-0.5*imag(t21))
+	t25 := complex(0.8660254037844386*real(t22), 0.8660254037844386*imag(t22))
+	t26 := complex(real(t24)-imag(t25), imag(t24)+real(t25))
+	t27 := complex(real(t24)+imag(t25), imag(t24)-real(t25))
+	t28 := t2 + t16
+	t29 := t2 - t16
+	t30 := t9 + t23
+	t31 := t9 - t23
+	t32 := complex(-imag(t31), real(t31))
+	t33 := t28 + t30
+	t34 := t29 + t32
+	t35 := t28 - t30
+	t36 := t29 - t32
+	t37 := t12 * complex(0.8660254037844386, 0.5)
+	t38 := t19 * complex(0.5, 0.8660254037844386)
+	t39 := complex(-imag(t26), real(t26))
+	t40 := t5 + t38
+	t41 := t5 - t38
+	t42 := t37 + t39
+	t43 := t37 - t39
+	t44 := complex(-imag(t43), real(t43))
+	t45 := t40 + t42
+	t46 := t41 + t44
+	t47 := t40 - t42
+	t48 := t41 - t44
+	t49 := t13 * complex(0.5, 0.8660254037844386)
+	t50 := t20 * complex(-0.5, 0.8660254037844386)
+	t51 := -t27
+	t52 := t6 + t50
+	t53 := t6 - t50
+	t54 := t49 + t51
+	t55 := t49 - t51
+	t56 := complex(-imag(t55), real(t55))
+	t57 := t52 + t54
+	t58 := t53 + t56
+	t59 := t52 - t54
+	t60 := t53 - t56
+	t61 := x17 + x33
+	t62 := x17 - x33
+	t63 := x1 + t61
+	t64 := complex(real(x1)-0.5*real(t61), imag(x1)-0.5*imag(t61))
+	t65 := complex(0.8660254037844386*real(t62), 0.8660254037844386*imag(t62))
+	t66 := complex(real(t64)-imag(t65), imag(t64)+real(t65))
+	t67 := complex(real(t64)+imag(t65), imag(t64)-real(t65))
+	t68 := x21 + x37
+	t69 := x21 - x37
+	t70 := x5 + t68
+	t71 := complex(real(x5)-0.5*real(t68), imag(x5)-0.5*imag(t68))
+	t72 := complex(0.8660254037844386*real(t69), 0.8660254037844386*imag(t69))
+	t73 := complex(real(t71)-imag(t72), imag(t71)+real(t72))
+	t74 := complex(real(t71)+imag(t72), imag(t71)-real(t72))
+	t75 := x25 + x41
+	t76 := x25 - x41
+	t77 := x9 + t75
+	t78 := complex(real(x9)-0.5*real(t75), imag(x9)-0.5*imag(t75))
+	t79 := complex(0.8660254037844386*real(t76), 0.8660254037844386*imag(t76))
+	t80 := complex(real(t78)-imag(t79), imag(t78)+real(t79))
+	t81 := complex(real(t78)+imag(t79), imag(t78)-real(t79))
+	t82 := x29 + x45
+	t83 := x29 - x45
+	t84 := x13 + t82
+	t85 := complex(real(x13)-0.5*real(t82), imag(x13)-0.5*imag(t82))
+	t86 := complex(0.8660254037844386*real(t83), 0.8660254037844386*imag(t83))
+	t87 := complex(real(t85)-imag(t86), imag(t85)+real(t86))
+	t88 := complex(real(t85)+imag(t86), imag(t85)-real(t86))
+	t89 := t63 + t77
+	t90 := t63 - t77
+	t91 := t70 + t84
+	t92 := t70 - t84
+	t93 := complex(-imag(t92), real(t92))
+	t94 := t89 + t91
+	t95 := t90 + t93
+	t96 := t89 - t91
+	t97 := t90 - t93
+	t98 := t73 * complex(0.8660254037844386, 0.5)
+	t99 := t80 * complex(0.5, 0.8660254037844386)
+	t100 := complex(-imag(t87), real(t87))
+	t101 := t66 + t99
+	t102 := t66 - t99
+	t103 := t98 + t100
+	t104 := t98 - t100
+	t105 := complex(-imag(t104), real(t104))
+	t106 := t101 + t103
+	t107 := t102 + t105
+	t108 := t101 - t103
+	t109 := t102 - t105
+	t110 := t74 * complex(0.5, 0.8660254037844386)
+	t111 := t81 * complex(-0.5, 0.8660254037844386)
+	t112 := -t88
+	t113 := t67 + t111
+	t114 := t67 - t111
+	t115 := t110 + t112
+	t116 := t110 - t112
+	t117 := complex(-imag(t116), real(t116))
+	t118 := t113 + t115
+	t119 := t114 + t117
+	t120 := t113 - t115
+	t121 := t114 - t117
+	t122 := x18 + x34
+	t123 := x18 - x34
+	t124 := x2 + t122
+	t125 := complex(real(x2)-0.5*real(t122), imag(x2)-0.5*imag(t122))
+	t126 := complex(0.8660254037844386*real(t123), 0.8660254037844386*imag(t123))
+	t127 := complex(real(t125)-imag(t126), imag(t125)+real(t126))
+	t128 := complex(real(t125)+imag(t126), imag(t125)-real(t126))
+	t129 := x22 + x38
+	t130 := x22 - x38
+	t131 := x6 + t129
+	t132 := complex(real(x6)-0.5*real(t129), imag(x6)-0.5*imag(t129))
+	t133 := complex(0.8660254037844386*real(t130), 0.8660254037844386*imag(t130))
+	t134 := complex(real(t132)-imag(t133), imag(t132)+real(t133))
+	t135 := complex(real(t132)+imag(t133), imag(t132)-real(t133))
+	t136 := x26 + x42
+	t137 := x26 - x42
+	t138 := x10 + t136
+	t139 := complex(real(x10)-0.5*real(t136), imag(x10)-0.5*imag(t136))
+	t140 := complex(0.8660254037844386*real(t137), 0.8660254037844386*imag(t137))
+	t141 := complex(real(t139)-imag(t140), imag(t139)+real(t140))
+	t142 := complex(real(t139)+imag(t140), imag(t139)-real(t140))
+	t143 := x30 + x46
+	t144 := x30 - x46
+	t145 := x14 + t143
+	t146 := complex(real(x14)-0.5*real(t143), imag(x14)-0.5*imag(t143))
+	t147 := complex(0.8660254037844386*real(t144), 0.8660254037844386*imag(t144))
+	t148 := complex(real(t146)-imag(t147), imag(t146)+real(t147))
+	t149 := complex(real(t146)+imag(t147), imag(t146)-real(t147))
+	t150 := t124 + t138
+	t151 := t124 - t138
+	t152 := t131 + t145
+	t153 := t131 - t145
+	t154 := complex(-imag(t153), real(t153))
+	t155 := t150 + t152
+	t156 := t151 + t154
+	t157 := t150 - t152
+	t158 := t151 - t154
+	t159 := t134 * complex(0.8660254037844386, 0.5)
+	t160 := t141 * complex(0.5, 0.8660254037844386)
+	t161 := complex(-imag(t148), real(t148))
+	t162 := t127 + t160
+	t163 := t127 - t160
+	t164 := t159 + t161
+	t165 := t159 - t161
+	t166 := complex(-imag(t165), real(t165))
+	t167 := t162 + t164
+	t168 := t163 + t166
+	t169 := t162 - t164
+	t170 := t163 - t166
+	t171 := t135 * complex(0.5, 0.8660254037844386)
+	t172 := t142 * complex(-0.5, 0.8660254037844386)
+	t173 := -t149
+	t174 := t128 + t172
+	t175 := t128 - t172
+	t176 := t171 + t173
+	t177 := t171 - t173
+	t178 := complex(-imag(t177), real(t177))
+	t179 := t174 + t176
+	t180 := t175 + t178
+	t181 := t174 - t176
+	t182 := t175 - t178
+	t183 := x19 + x35
+	t184 := x19 - x35
+	t185 := x3 + t183
+	t186 := complex(real(x3)-0.5*real(t183), imag(x3)-0.5*imag(t183))
+	t187 := complex(0.8660254037844386*real(t184), 0.8660254037844386*imag(t184))
+	t188 := complex(real(t186)-imag(t187), imag(t186)+real(t187))
+	t189 := complex(real(t186)+imag(t187), imag(t186)-real(t187))
+	t190 := x23 + x39
+	t191 := x23 - x39
+	t192 := x7 + t190
+	t193 := complex(real(x7)-0.5*real(t190), imag(x7)-0.5*imag(t190))
+	t194 := complex(0.8660254037844386*real(t191), 0.8660254037844386*imag(t191))
+	t195 := complex(real(t193)-imag(t194), imag(t193)+real(t194))
+	t196 := complex(real(t193)+imag(t194), imag(t193)-real(t194))
+	t197 := x27 + x43
+	t198 := x27 - x43
+	t199 := x11 + t197
+	t200 := complex(real(x11)-0.5*real(t197), imag(x11)-0.5*imag(t197))
+	t201 := complex(0.8660254037844386*real(t198), 0.8660254037844386*imag(t198))
+	t202 := complex(real(t200)-imag(t201), imag(t200)+real(t201))
+	t203 := complex(real(t200)+imag(t201), imag(t200)-real(t201))
+	t204 := x31 + x47
+	t205 := x31 - x47
+	t206 := x15 + t204
+	t207 := complex(real(x15)-0.5*real(t204), imag(x15)-0.5*imag(t204))
+	t208 := complex(0.8660254037844386*real(t205), 0.8660254037844386*imag(t205))
+	t209 := complex(real(t207)-imag(t208), imag(t207)+real(t208))
+	t210 := complex(real(t207)+imag(t208), imag(t207)-real(t208))
+	t211 := t185 + t199
+	t212 := t185 - t199
+	t213 := t192 + t206
+	t214 := t192 - t206
+	t215 := complex(-imag(t214), real(t214))
+	t216 := t211 + t213
+	t217 := t212 + t215
+	t218 := t211 - t213
+	t219 := t212 - t215
+	t220 := t195 * complex(0.8660254037844386, 0.5)
+	t221 := t202 * complex(0.5, 0.8660254037844386)
+	t222 := complex(-imag(t209), real(t209))
+	t223 := t188 + t221
+	t224 := t188 - t221
+	t225 := t220 + t222
+	t226 := t220 - t222
+	t227 := complex(-imag(t226), real(t226))
+	t228 := t223 + t225
+	t229 := t224 + t227
+	t230 := t223 - t225
+	t231 := t224 - t227
+	t232 := t196 * complex(0.5, 0.8660254037844386)
+	t233 := t203 * complex(-0.5, 0.8660254037844386)
+	t234 := -t210
+	t235 := t189 + t233
+	t236 := t189 - t233
+	t237 := t232 + t234
+	t238 := t232 - t234
+	t239 := complex(-imag(t238), real(t238))
+	t240 := t235 + t237
+	t241 := t236 + t239
+	t242 := t235 - t237
+	t243 := t236 - t239
+	t244 := t33 + t155
+	t245 := t33 - t155
+	t246 := t94 + t216
+	t247 := t94 - t216
+	t248 := complex(-imag(t247), real(t247))
+	t249 := t244 + t246
+	t250 := t245 + t248
+	t251 := t244 - t246
+	t252 := t245 - t248
+	t253 := t106 * complex(0.99144486137381038, 0.1305261922200516)
+	t254 := t167 * complex(0.96592582628906831, 0.25881904510252074)
+	t255 := t228 * complex(0.92387953251128674, 0.38268343236508978)
+	t256 := t45 + t254
+	t257 := t45 - t254
+	t258 := t253 + t255
+	t259 := t253 - t255
+	t260 := complex(-imag(t259), real(t259))
+	t261 := t256 + t258
+	t262 := t257 + t260
+	t263 := t256 - t258
+	t264 := t257 - t260
+	t265 := t118 * complex(0.96592582628906831, 0.25881904510252074)
+	t266 := t179 * complex(0.8660254037844386, 0.5)
+	t267 := t240 * complex(0.70710678118654757, 0.70710678118654757)
+	t268 := t57 + t266
+	t269 := t57 - t266
+	t270 := t265 + t267
+	t271 := t265 - t267
+	t272 := complex(-imag(t271), real(t271))
+	t273 := t268 + t270
+	t274 := t269 + t272
+	t275 := t268 - t270
+	t276 := t269 - t272
+	t277 := t95 * complex(0.92387953251128674, 0.38268343236508978)
+	t278 := t156 * complex(0.70710678118654757, 0.70710678118654757)
+	t279 := t217 * complex(0.38268343236508978, 0.92387953251128674)
+	t280 := t34 + t278
+	t281 := t34 - t278
+	t282 := t277 + t279
+	t283 := t277 - t279
+	t284 := complex(-imag(t283), real(t283))
+	t285 := t280 + t282
+	t286 := t281 + t284
+	t287 := t280 - t282
+	t288 := t281 - t284
+	t289 := t107 * complex(0.8660254037844386, 0.5)
+	t290 := t168 * complex(0.5, 0.8660254037844386)
+	t291 := complex(-imag(t229), real(t229))
+	t292 := t46 + t290
+	t293 := t46 - t290
+	t294 := t289 + t291
+	t295 := t289 - t291
+	t296 := complex(-imag(t295), real(t295))
+	t297 := t292 + t294
+	t298 := t293 + t296
+	t299 := t292 - t294
+	t300 := t293 - t296
+	t301 := t119 * complex(0.79335334029123517, 0.60876142900872066)
+	t302 := t180 * complex(0.25881904510252074, 0.96592582628906831)
+	t303 := t241 * complex(-0.38268343236508978, 0.92387953251128674)
+	t304 := t58 + t302
+	t305 := t58 - t302
+	t306 := t301 + t303
+	t307 := t301 - t303
+	t308 := complex(-imag(t307), real(t307))
+	t309 := t304 + t306
+	t310 := t305 + t308
+	t311 := t304 - t306
+	t312 := t305 - t308
+	t313 := t96 * complex(0.70710678118654757, 0.70710678118654757)
+	t314 := complex(-imag(t157), real(t157))
+	t315 := t218 * complex(-0.70710678118654757, 0.70710678118654757)
+	t316 := t35 + t314
+	t317 := t35 - t314
+	t318 := t313 + t315
+	t319 := t313 - t315
+	t320 := complex(-imag(t319), real(t319))
+	t321 := t316 + t318
+	t322 := t317 + t320
+	t323 := t316 - t318
+	t324 := t317 - t320
+	t325 := t108 * complex(0.60876142900872066, 0.79335334029123517)
+	t326 := t169 * complex(-0.25881904510252074, 0.96592582628906831)
+	t327 := t230 * complex(-0.92387953251128674, 0.38268343236508978)
+	t328 := t47 + t326
+	t329 := t47 - t326
+	t330 := t325 + t327
+	t331 := t325 - t327
+	t332 := complex(-imag(t331), real(t331))
+	t333 := t328 + t330
+	t334 := t329 + t332
+	t335 := t328 - t330
+	t336 := t329 - t332
+	t337 := t120 * complex(0.5, 0.8660254037844386)
+	t338 := t181 * complex(-0.5, 0.8660254037844386)
+	t339 := -t242
+	t340 := t59 + t338
+	t341 := t59 - t338
+	t342 := t337 + t339
+	t343 := t337 - t339
+	t344 := complex(-imag(t343), real(t343))
+	t345 := t340 + t342
+	t346 := t341 + t344
+	t347 := t340 - t342
+	t348 := t341 - t344
+	t349 := t97 * complex(0.38268343236508978, 0.92387953251128674)
+	t350 := t158 * complex(-0.70710678118654757, 0.70710678118654757)
+	t351 := t219 * complex(-0.92387953251128674, -0.38268343236508978)
+	t352 := t36 + t350
+	t353 := t36 - t350
+	t354 := t349 + t351
+	t355 := t349 - t351
+	t356 := complex(-imag(t355), real(t355))
+	t357 := t352 + t354
+	t358 := t353 + t356
+	t359 := t352 - t354
+	t360 := t353 - t356
+	t361 := t109 * complex(0.25881904510252074, 0.96592582628906831)
+	t362 := t170 * complex(-0.8660254037844386, 0.5)
+	t363 := t231 * complex(-0.70710678118654757, -0.70710678118654757)
+	t364 := t48 + t362
+	t365 := t48 - t362
+	t366 := t361 + t363
+	t367 := t361 - t363
+	t368 := complex(-imag(t367), real(t367))
+	t369 := t364 + t366
+	t370 := t365 + t368
+	t371 := t364 - t366
+	t372 := t365 - t368
+	t373 := t121 * complex(0.1305261922200516, 0.99144486137381038)
+	t374 := t182 * complex(-0.96592582628906831, 0.25881904510252074)
+	t375 := t243 * complex(-0.38268343236508978, -0.92387953251128674)
+	t376 := t60 + t374
+	t377 := t60 - t374
+	t378 := t373 + t375
+	t379 := t373 - t375
+	t380 := complex(-imag(t379), real(t379))
+	t381 := t376 + t378
+	t382 := t377 + t380
+	t383 := t376 - t378
+	t384 := t377 - t380
+
+	const scale = 1.0 / 48
+
+	d[0] = complex(real(t249)*scale, imag(t249)*scale)
+	d[1] = complex(real(t261)*scale, imag(t261)*scale)
+	d[2] = complex(real(t273)*scale, imag(t273)*scale)
+	d[3] = complex(real(t285)*scale, imag(t285)*scale)
+	d[4] = complex(real(t297)*scale, imag(t297)*scale)
+	d[5] = complex(real(t309)*scale, imag(t309)*scale)
+	d[6] = complex(real(t321)*scale, imag(t321)*scale)
+	d[7] = complex(real(t333)*scale, imag(t333)*scale)
+	d[8] = complex(real(t345)*scale, imag(t345)*scale)
+	d[9] = complex(real(t357)*scale, imag(t357)*scale)
+	d[10] = complex(real(t369)*scale, imag(t369)*scale)
+	d[11] = complex(real(t381)*scale, imag(t381)*scale)
+	d[12] = complex(real(t250)*scale, imag(t250)*scale)
+	d[13] = complex(real(t262)*scale, imag(t262)*scale)
+	d[14] = complex(real(t274)*scale, imag(t274)*scale)
+	d[15] = complex(real(t286)*scale, imag(t286)*scale)
+	d[16] = complex(real(t298)*scale, imag(t298)*scale)
+	d[17] = complex(real(t310)*scale, imag(t310)*scale)
+	d[18] = complex(real(t322)*scale, imag(t322)*scale)
+	d[19] = complex(real(t334)*scale, imag(t334)*scale)
+	d[20] = complex(real(t346)*scale, imag(t346)*scale)
+	d[21] = complex(real(t358)*scale, imag(t358)*scale)
+	d[22] = complex(real(t370)*scale, imag(t370)*scale)
+	d[23] = complex(real(t382)*scale, imag(t382)*scale)
+	d[24] = complex(real(t251)*scale, imag(t251)*scale)
+	d[25] = complex(real(t263)*scale, imag(t263)*scale)
+	d[26] = complex(real(t275)*scale, imag(t275)*scale)
+	d[27] = complex(real(t287)*scale, imag(t287)*scale)
+	d[28] = complex(real(t299)*scale, imag(t299)*scale)
+	d[29] = complex(real(t311)*scale, imag(t311)*scale)
+	d[30] = complex(real(t323)*scale, imag(t323)*scale)
+	d[31] = complex(real(t335)*scale, imag(t335)*scale)
+	d[32] = complex(real(t347)*scale, imag(t347)*scale)
+	d[33] = complex(real(t359)*scale, imag(t359)*scale)
+	d[34] = complex(real(t371)*scale, imag(t371)*scale)
+	d[35] = complex(real(t383)*scale, imag(t383)*scale)
+	d[36] = complex(real(t252)*scale, imag(t252)*scale)
+	d[37] = complex(real(t264)*scale, imag(t264)*scale)
+	d[38] = complex(real(t276)*scale, imag(t276)*scale)
+	d[39] = complex(real(t288)*scale, imag(t288)*scale)
+	d[40] = complex(real(t300)*scale, imag(t300)*scale)
+	d[41] = complex(real(t312)*scale, imag(t312)*scale)
+	d[42] = complex(real(t324)*scale, imag(t324)*scale)
+	d[43] = complex(real(t336)*scale, imag(t336)*scale)
+	d[44] = complex(real(t348)*scale, imag(t348)*scale)
+	d[45] = complex(real(t360)*scale, imag(t360)*scale)
+	d[46] = complex(real(t372)*scale, imag(t372)*scale)
+	d[47] = complex(real(t384)*scale, imag(t384)*scale)
+
+	return true
+}
+
+// forwardDIT48GenComplex128 computes a 48-point forward FFT for complex128 data as
+// straight-line code (radices 4x4x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT48GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 48
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+
+	t0 := x16 + x32
+	t1 := x16 - x32
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t6 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t7 := x20 + x36
+	t8 := x20 - x36
+	t9 := x4 + t7
+	t10 := complex(real(x4)-0.5*real(t7), imag(x4)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t13 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t14 := x24 + x40
+	t15 := x24 - x40
+	t16 := x8 + t14
+	t17 := complex(real(x8)-0.5*real(t14), imag(x8)-0.5*imag(t14))
+	t18 := complex(0.8660254037844386*real(t15), 0.8660254037844386*imag(t15))
+	t19 := complex(real(t17)+imag(t18), imag(t17)-real(t18))
+	t20 := complex(real(t17)-imag(t18), imag(t17)+real(t18))
+	t21 := x28 + x44
+	t22 := x28 - x44
+	t23 := x12 + t21
+	t24 := complex(real(x12)-0.5*real(t21), imag(x12)-0.5*imag(t21))
+	t25 := complex(0.8660254037844386*real(t22), 0.8660254037844386*imag(t22))
+	t26 := complex(real(t24)+imag(t25), imag(t24)-real(t25))
+	t27 := complex(real(t24)-imag(t25), imag(t24)+real(t25))
+	t28 := t2 + t16
+	t29 := t2 - t16
+	t30 := t9 + t23
+	t31 := t9 - t23
+	t32 := complex(imag(t31), -real(t31))
+	t33 := t28 + t30
+	t34 := t29 + t32
+	t35 := t28 - t30
+	t36 := t29 - t32
+	t37 := t12 * complex(0.8660254037844386, -0.5)
+	t38 := t19 * complex(0.5, -0.8660254037844386)
+	t39 := complex(imag(t26), -real(t26))
+	t40 := t5 + t38
+	t41 := t5 - t38
+	t42 := t37 + t39
+	t43 := t37 - t39
+	t44 := complex(imag(t43), -real(t43))
+	t45 := t40 + t42
+	t46 := t41 + t44
+	t47 := t40 - t42
+	t48 := t41 - t44
+	t49 := t13 * complex(0.5, -0.8660254037844386)
+	t50 := t20 * complex(-0.5, -0.8660254037844386)
+	t51 := -t27
+	t52 := t6 + t50
+	t53 := t6 - t50
+	t54 := t49 + t51
+	t55 := t49 - t51
+	t56 := complex(imag(t55), -real(t55))
+	t57 := t52 + t54
+	t58 := t53 + t56
+	t59 := t52 - t54
+	t60 := t53 - t56
+	t61 := x17 + x33
+	t62 := x17 - x33
+	t63 := x1 + t61
+	t64 := complex(real(x1)-0.5*real(t61), imag(x1)-0.5*imag(t61))
+	t65 := complex(0.8660254037844386*real(t62), 0.8660254037844386*imag(t62))
+	t66 := complex(real(t64)+imag(t65), imag(t64)-real(t65))
+	t67 := complex(real(t64)-imag(t65), imag(t64)+real(t65))
+	t68 := x21 + x37
+	t69 := x21 - x37
+	t70 := x5 + t68
+	t71 := complex(real(x5)-0.5*real(t68), imag(x5)-0.5*imag(t68))
+	t72 := complex(0.8660254037844386*real(t69), 0.8660254037844386*imag(t69))
+	t73 := complex(real(t71)+imag(t72), imag(t71)-real(t72))
+	t74 := complex(real(t71)-imag(t72), imag(t71)+real(t72))
+	t75 := x25 + x41
+	t76 := x25 - x41
+	t77 := x9 + t75
+	t78 := complex(real(x9)-0.5*real(t75), imag(x9)-0.5*imag(t75))
+	t79 := complex(0.8660254037844386*real(t76), 0.8660254037844386*imag(t76))
+	t80 := complex(real(t78)+imag(t79), imag(t78)-real(t79))
+	t81 := complex(real(t78)-imag(t79), imag(t78)+real(t79))
+	t82 := x29 + x45
+	t83 := x29 - x45
+	t84 := x13 + t82
+	t85 := complex(real(x13)-0.5*real(t82), imag(x13)-0.5*imag(t82))
+	t86 := complex(0.8660254037844386*real(t83), 0.8660254037844386*imag(t83))
+	t87 := complex(real(t85)+imag(t86), imag(t85)-real(t86))
+	t88 := complex(real(t85)-imag(t86), imag(t85)+real(t86))
+	t89 := t63 + t77
+	t90 := t63 - t77
+	t91 := t70 + t84
+	t92 := t70 - t84
+	t93 := complex(imag(t92), -real(t92))
+	t94 := t89 + t91
+	t95 := t90 + t93
+	t96 := t89 - t91
+	t97 := t90 - t93
+	t98 := t73 * complex(0.8660254037844386, -0.5)
+	t99 := t80 * complex(0.5, -0.8660254037844386)
+	t100 := complex(imag(t87), -real(t87))
+	t101 := t66 + t99
+	t102 := t66 - t99
+	t103 := t98 + t100
+	t104 := t98 - t100
+	t105 := complex(imag(t104), -real(t104))
+	t106 := t101 + t103
+	t107 := t102 + t105
+	t108 := t101 - t103
+	t109 := t102 - t105
+	t110 := t74 * complex(0.5, -0.8660254037844386)
+	t111 := t81 * complex(-0.5, -0.8660254037844386)
+	t112 := -t88
+	t113 := t67 + t111
+	t114 := t67 - t111
+	t115 := t110 + t112
+	t116 := t110 - t112
+	t117 := complex(imag(t116), -real(t116))
+	t118 := t113 + t115
+	t119 := t114 + t117
+	t120 := t113 - t115
+	t121 := t114 - t117
+	t122 := x18 + x34
+	t123 := x18 - x34
+	t124 := x2 + t122
+	t125 := complex(real(x2)-0.5*real(t122), imag(x2)-0.5*imag(t122))
+	t126 := complex(0.8660254037844386*real(t123), 0.8660254037844386*imag(t123))
+	t127 := complex(real(t125)+imag(t126), imag(t125)-real(t126))
+	t128 := complex(real(t125)-imag(t126), imag(t125)+real(t126))
+	t129 := x22 + x38
+	t130 := x22 - x38
+	t131 := x6 + t129
+	t132 := complex(real(x6)-0.5*real(t129), imag(x6)-0.5*imag(t129))
+	t133 := complex(0.8660254037844386*real(t130), 0.8660254037844386*imag(t130))
+	t134 := complex(real(t132)+imag(t133), imag(t132)-real(t133))
+	t135 := complex(real(t132)-imag(t133), imag(t132)+real(t133))
+	t136 := x26 + x42
+	t137 := x26 - x42
+	t138 := x10 + t136
+	t139 := complex(real(x10)-0.5*real(t136), imag(x10)-0.5*imag(t136))
+	t140 := complex(0.8660254037844386*real(t137), 0.8660254037844386*imag(t137))
+	t141 := complex(real(t139)+imag(t140), imag(t139)-real(t140))
+	t142 := complex(real(t139)-imag(t140), imag(t139)+real(t140))
+	t143 := x30 + x46
+	t144 := x30 - x46
+	t145 := x14 + t143
+	t146 := complex(real(x14)-0.5*real(t143), imag(x14)-0.5*imag(t143))
+	t147 := complex(0.8660254037844386*real(t144), 0.8660254037844386*imag(t144))
+	t148 := complex(real(t146)+imag(t147), imag(t146)-real(t147))
+	t149 := complex(real(t146)-imag(t147), imag(t146)+real(t147))
+	t150 := t124 + t138
+	t151 := t124 - t138
+	t152 := t131 + t145
+	t153 := t131 - t145
+	t154 := complex(imag(t153), -real(t153))
+	t155 := t150 + t152
+	t156 := t151 + t154
+	t157 := t150 - t152
+	t158 := t151 - t154
+	t159 := t134 * complex(0.8660254037844386, -0.5)
+	t160 := t141 * complex(0.5, -0.8660254037844386)
+	t161 := complex(imag(t148), -real(t148))
+	t162 := t127 + t160
+	t163 := t127 - t160
+	t164 := t159 + t161
+	t165 := t159 - t161
+	t166 := complex(imag(t165), -real(t165))
+	t167 := t162 + t164
+	t168 := t163 + t166
+	t169 := t162 - t164
+	t170 := t163 - t166
+	t171 := t135 * complex(0.5, -0.8660254037844386)
+	t172 := t142 * complex(-0.5, -0.8660254037844386)
+	t173 := -t149
+	t174 := t128 + t172
+	t175 := t128 - t172
+	t176 := t171 + t173
+	t177 := t171 - t173
+	t178 := complex(imag(t177), -real(t177))
+	t179 := t174 + t176
+	t180 := t175 + t178
+	t181 := t174 - t176
+	t182 := t175 - t178
+	t183 := x19 + x35
+	t184 := x19 - x35
+	t185 := x3 + t183
+	t186 := complex(real(x3)-0.5*real(t183), imag(x3)-0.5*imag(t183))
+	t187 := complex(0.8660254037844386*real(t184), 0.8660254037844386*imag(t184))
+	t188 := complex(real(t186)+imag(t187), imag(t186)-real(t187))
+	t189 := complex(real(t186)-imag(t187), imag(t186)+real(t187))
+	t190 := x23 + x39
+	t191 := x23 - x39
+	t192 := x7 + t190
+	t193 := complex(real(x7)-0.5*real(t190), imag(x7)-0.5*imag(t190))
+	t194 := complex(0.8660254037844386*real(t191), 0.8660254037844386*imag(t191))
+	t195 := complex(real(t193)+imag(t194), imag(t193)-real(t194))
+	t196 := complex(real(t193)-imag(t194), imag(t193)+real(t194))
+	t197 := x27 + x43
+	t198 := x27 - x43
+	t199 := x11 + t197
+	t200 := complex(real(x11)-0.5*real(t197), imag(x11)-0.5*imag(t197))
+	t201 := complex(0.8660254037844386*real(t198), 0.8660254037844386*imag(t198))
+	t202 := complex(real(t200)+imag(t201), imag(t200)-real(t201))
+	t203 := complex(real(t200)-imag(t201), imag(t200)+real(t201))
+	t204 := x31 + x47
+	t205 := x31 - x47
+	t206 := x15 + t204
+	t207 := complex(real(x15)-0.5*real(t204), imag(x15)-0.5*imag(t204))
+	t208 := complex(0.8660254037844386*real(t205), 0.8660254037844386*imag(t205))
+	t209 := complex(real(t207)+imag(t208), imag(t207)-real(t208))
+	t210 := complex(real(t207)-imag(t208), imag(t207)+real(t208))
+	t211 := t185 + t199
+	t212 := t185 - t199
+	t213 := t192 + t206
+	t214 := t192 - t206
+	t215 := complex(imag(t214), -real(t214))
+	t216 := t211 + t213
+	t217 := t212 + t215
+	t218 := t211 - t213
+	t219 := t212 - t215
+	t220 := t195 * complex(0.8660254037844386, -0.5)
+	t221 := t202 * complex(0.5, -0.8660254037844386)
+	t222 := complex(imag(t209), -real(t209))
+	t223 := t188 + t221
+	t224 := t188 - t221
+	t225 := t220 + t222
+	t226 := t220 - t222
+	t227 := complex(imag(t226), -real(t226))
+	t228 := t223 + t225
+	t229 := t224 + t227
+	t230 := t223 - t225
+	t231 := t224 - t227
+	t232 := t196 * complex(0.5, -0.8660254037844386)
+	t233 := t203 * complex(-0.5, -0.8660254037844386)
+	t234 := -t210
+	t235 := t189 + t233
+	t236 := t189 - t233
+	t237 := t232 + t234
+	t238 := t232 - t234
+	t239 := complex(imag(t238), -real(t238))
+	t240 := t235 + t237
+	t241 := t236 + t239
+	t242 := t235 - t237
+	t243 := t236 - t239
+	t244 := t33 + t155
+	t245 := t33 - t155
+	t246 := t94 + t216
+	t247 := t94 - t216
+	t248 := complex(imag(t247), -real(t247))
+	t249 := t244 + t246
+	t250 := t245 + t248
+	t251 := t244 - t246
+	t252 := t245 - t248
+	t253 := t106 * complex(0.99144486137381038, -0.1305261922200516)
+	t254 := t167 * complex(0.96592582628906831, -0.25881904510252074)
+	t255 := t228 * complex(0.92387953251128674, -0.38268343236508978)
+	t256 := t45 + t254
+	t257 := t45 - t254
+	t258 := t253 + t255
+	t259 := t253 - t255
+	t260 := complex(imag(t259), -real(t259))
+	t261 := t256 + t258
+	t262 := t257 + t260
+	t263 := t256 - t258
+	t264 := t257 - t260
+	t265 := t118 * complex(0.96592582628906831, -0.25881904510252074)
+	t266 := t179 * complex(0.8660254037844386, -0.5)
+	t267 := t240 * complex(0.70710678118654757, -0.70710678118654757)
+	t268 := t57 + t266
+	t269 := t57 - t266
+	t270 := t265 + t267
+	t271 := t265 - t267
+	t272 := complex(imag(t271), -real(t271))
+	t273 := t268 + t270
+	t274 := t269 + t272
+	t275 := t268 - t270
+	t276 := t269 - t272
+	t277 := t95 * complex(0.92387953251128674, -0.38268343236508978)
+	t278 := t156 * complex(0.70710678118654757, -0.70710678118654757)
+	t279 := t217 * complex(0.38268343236508978, -0.92387953251128674)
+	t280 := t34 + t278
+	t281 := t34 - t278
+	t282 := t277 + t279
+	t283 := t277 - t279
+	t284 := complex(imag(t283), -real(t283))
+	t285 := t280 + t282
+	t286 := t281 + t284
+	t287 := t280 - t282
+	t288 := t281 - t284
+	t289 := t107 * complex(0.8660254037844386, -0.5)
+	t290 := t168 * complex(0.5, -0.8660254037844386)
+	t291 := complex(imag(t229), -real(t229))
+	t292 := t46 + t290
+	t293 := t46 - t290
+	t294 := t289 + t291
+	t295 := t289 - t291
+	t296 := complex(imag(t295), -real(t295))
+	t297 := t292 + t294
+	t298 := t293 + t296
+	t299 := t292 - t294
+	t300 := t293 - t296
+	t301 := t119 * complex(0.79335334029123517, -0.60876142900872066)
+	t302 := t180 * complex(0.25881904510252074, -0.96592582628906831)
+	t303 := t241 * complex(-0.38268343236508978, -0.92387953251128674)
+	t304 := t58 + t302
+	t305 := t58 - t302
+	t306 := t301 + t303
+	t307 := t301 - t303
+	t308 := complex(imag(t307), -real(t307))
+	t309 := t304 + t306
+	t310 := t305 + t308
+	t311 := t304 - t306
+	t312 := t305 - t308
+	t313 := t96 * complex(0.70710678118654757, -0.70710678118654757)
+	t314 := complex(imag(t157), -real(t157))
+	t315 := t218 * complex(-0.70710678118654757, -0.70710678118654757)
+	t316 := t35 + t314
+	t317 := t35 - t314
+	t318 := t313 + t315
+	t319 := t313 - t315
+	t320 := complex(imag(t319), -real(t319))
+	t321 := t316 + t318
+	t322 := t317 + t320
+	t323 := t316 - t318
+	t324 := t317 - t320
+	t325 := t108 * complex(0.60876142900872066, -0.79335334029123517)
+	t326 := t169 * complex(-0.25881904510252074, -0.96592582628906831)
+	t327 := t230 * complex(-0.92387953251128674, -0.38268343236508978)
+	t328 := t47 + t326
+	t329 := t47 - t326
+	t330 := t325 + t327
+	t331 := t325 - t327
+	t332 := complex(imag(t331), -real(t331))
+	t333 := t328 + t330
+	t334 := t329 + t332
+	t335 := t328 - t330
+	t336 := t329 - t332
+	t337 := t120 * complex(0.5, -0.8660254037844386)
+	t338 := t181 * complex(-0.5, -0.8660254037844386)
+	t339 := -t242
+	t340 := t59 + t338
+	t341 := t59 - t338
+	t342 := t337 + t339
+	t343 := t337 - t339
+	t344 := complex(imag(t343), -real(t343))
+	t345 := t340 + t342
+	t346 := t341 + t344
+	t347 := t340 - t342
+	t348 := t341 - t344
+	t349 := t97 * complex(0.38268343236508978, -0.92387953251128674)
+	t350 := t158 * complex(-0.70710678118654757, -0.70710678118654757)
+	t351 := t219 * complex(-0.92387953251128674, 0.38268343236508978)
+	t352 := t36 + t350
+	t353 := t36 - t350
+	t354 := t349 + t351
+	t355 := t349 - t351
+	t356 := complex(imag(t355), -real(t355))
+	t357 := t352 + t354
+	t358 := t353 + t356
+	t359 := t352 - t354
+	t360 := t353 - t356
+	t361 := t109 * complex(0.25881904510252074, -0.96592582628906831)
+	t362 := t170 * complex(-0.8660254037844386, -0.5)
+	t363 := t231 * complex(-0.70710678118654757, 0.70710678118654757)
+	t364 := t48 + t362
+	t365 := t48 - t362
+	t366 := t361 + t363
+	t367 := t361 - t363
+	t368 := complex(imag(t367), -real(t367))
+	t369 := t364 + t366
+	t370 := t365 + t368
+	t371 := t364 - t366
+	t372 := t365 - t368
+	t373 := t121 * complex(0.1305261922200516, -0.99144486137381038)
+	t374 := t182 * complex(-0.96592582628906831, -0.25881904510252074)
+	t375 := t243 * complex(-0.38268343236508978, 0.92387953251128674)
+	t376 := t60 + t374
+	t377 := t60 - t374
+	t378 := t373 + t375
+	t379 := t373 - t375
+	t380 := complex(imag(t379), -real(t379))
+	t381 := t376 + t378
+	t382 := t377 + t380
+	t383 := t376 - t378
+	t384 := t377 - t380
+
+	d[0] = t249
+	d[1] = t261
+	d[2] = t273
+	d[3] = t285
+	d[4] = t297
+	d[5] = t309
+	d[6] = t321
+	d[7] = t333
+	d[8] = t345
+	d[9] = t357
+	d[10] = t369
+	d[11] = t381
+	d[12] = t250
+	d[13] = t262
+	d[14] = t274
+	d[15] = t286
+	d[16] = t298
+	d[17] = t310
+	d[18] = t322
+	d[19] = t334
+	d[20] = t346
+	d[21] = t358
+	d[22] = t370
+	d[23] = t382
+	d[24] = t251
+	d[25] = t263
+	d[26] = t275
+	d[27] = t287
+	d[28] = t299
+	d[29] = t311
+	d[30] = t323
+	d[31] = t335
+	d[32] = t347
+	d[33] = t359
+	d[34] = t371
+	d[35] = t383
+	d[36] = t252
+	d[37] = t264
+	d[38] = t276
+	d[39] = t288
+	d[40] = t300
+	d[41] = t312
+	d[42] = t324
+	d[43] = t336
+	d[44] = t348
+	d[45] = t360
+	d[46] = t372
+	d[47] = t384
+
+	return true
+}
+
+// inverseDIT48GenComplex128 computes a 48-point inverse (scaled by 1/n) FFT for complex128 data as
+// straight-line code (radices 4x4x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT48GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 48
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+
+	t0 := x16 + x32
+	t1 := x16 - x32
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t6 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t7 := x20 + x36
+	t8 := x20 - x36
+	t9 := x4 + t7
+	t10 := complex(real(x4)-0.5*real(t7), imag(x4)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t13 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t14 := x24 + x40
+	t15 := x24 - x40
+	t16 := x8 + t14
+	t17 := complex(real(x8)-0.5*real(t14), imag(x8)-0.5*imag(t14))
+	t18 := complex(0.8660254037844386*real(t15), 0.8660254037844386*imag(t15))
+	t19 := complex(real(t17)-imag(t18), imag(t17)+real(t18))
+	t20 := complex(real(t17)+imag(t18), imag(t17)-real(t18))
+	t21 := x28 + x44
+	t22 := x28 - x44
+	t23 := x12 + t21
+	t24 := complex(real(x12)-0.5*real(t21), imag(x12)-0.5*imag(t21))
+	t25 := complex(0.8660254037844386*real(t22), 0.8660254037844386*imag(t22))
+	t26 := complex(real(t24)-imag(t25), imag(t24)+real(t25))
+	t27 := complex(real(t24)+imag(t25), imag(t24)-real(t25))
+	t28 := t2 + t16
+	t29 := t2 - t16
+	t30 := t9 + t23
+	t31 := t9 - t23
+	t32 := complex(-imag(t31), real(t31))
+	t33 := t28 + t30
+	t34 := t29 + t32
+	t35 := t28 - t30
+	t36 := t29 - t32
+	t37 := t12 * complex(0.8660254037844386, 0.5)
+	t38 := t19 * complex(0.5, 0.8660254037844386)
+	t39 := complex(-imag(t26), real(t26))
+	t40 := t5 + t38
+	t41 := t5 - t38
+	t42 := t37 + t39
+	t43 := t37 - t39
+	t44 := complex(-imag(t43), real(t43))
+	t45 := t40 + t42
+	t46 := t41 + t44
+	t47 := t40 - t42
+	t48 := t41 - t44
+	t49 := t13 * complex(0.5, 0.8660254037844386)
+	t50 := t20 * complex(-0.5, 0.8660254037844386)
+	t51 := -t27
+	t52 := t6 + t50
+	t53 := t6 - t50
+	t54 := t49 + t51
+	t55 := t49 - t51
+	t56 := complex(-imag(t55), real(t55))
+	t57 := t52 + t54
+	t58 := t53 + t56
+	t59 := t52 - t54
+	t60 := t53 - t56
+	t61 := x17 + x33
+	t62 := x17 - x33
+	t63 := x1 + t61
+	t64 := complex(real(x1)-0.5*real(t61), imag(x1)-0.5*imag(t61))
+	t65 := complex(0.8660254037844386*real(t62), 0.8660254037844386*imag(t62))
+	t66 := complex(real(t64)-imag(t65), imag(t64)+real(t65))
+	t67 := complex(real(t64)+imag(t65), imag(t64)-real(t65))
+	t68 := x21 + x37
+	t69 := x21 - x37
+	t70 := x5 + t68
+	t71 := complex(real(x5)-0.5*real(t68), imag(x5)-0.5*imag(t68))
+	t72 := complex(0.8660254037844386*real(t69), 0.8660254037844386*imag(t69))
+	t73 := complex(real(t71)-imag(t72), imag(t71)+real(t72))
+	t74 := complex(real(t71)+imag(t72), imag(t71)-real(t72))
+	t75 := x25 + x41
+	t76 := x25 - x41
+	t77 := x9 + t75
+	t78 := complex(real(x9)-0.5*real(t75), imag(x9)-0.5*imag(t75))
+	t79 := complex(0.8660254037844386*real(t76), 0.8660254037844386*imag(t76))
+	t80 := complex(real(t78)-imag(t79), imag(t78)+real(t79))
+	t81 := complex(real(t78)+imag(t79), imag(t78)-real(t79))
+	t82 := x29 + x45
+	t83 := x29 - x45
+	t84 := x13 + t82
+	t85 := complex(real(x13)-0.5*real(t82), imag(x13)-0.5*imag(t82))
+	t86 := complex(0.8660254037844386*real(t83), 0.8660254037844386*imag(t83))
+	t87 := complex(real(t85)-imag(t86), imag(t85)+real(t86))
+	t88 := complex(real(t85)+imag(t86), imag(t85)-real(t86))
+	t89 := t63 + t77
+	t90 := t63 - t77
+	t91 := t70 + t84
+	t92 := t70 - t84
+	t93 := complex(-imag(t92), real(t92))
+	t94 := t89 + t91
+	t95 := t90 + t93
+	t96 := t89 - t91
+	t97 := t90 - t93
+	t98 := t73 * complex(0.8660254037844386, 0.5)
+	t99 := t80 * complex(0.5, 0.8660254037844386)
+	t100 := complex(-imag(t87), real(t87))
+	t101 := t66 + t99
+	t102 := t66 - t99
+	t103 := t98 + t100
+	t104 := t98 - t100
+	t105 := complex(-imag(t104), real(t104))
+	t106 := t101 + t103
+	t107 := t102 + t105
+	t108 := t101 - t103
+	t109 := t102 - t105
+	t110 := t74 * complex(0.5, 0.8660254037844386)
+	t111 := t81 * complex(-0.5, 0.8660254037844386)
+	t112 := -t88
+	t113 := t67 + t111
+	t114 := t67 - t111
+	t115 := t110 + t112
+	t116 := t110 - t112
+	t117 := complex(-imag(t116), real(t116))
+	t118 := t113 + t115
+	t119 := t114 + t117
+	t120 := t113 - t115
+	t121 := t114 - t117
+	t122 := x18 + x34
+	t123 := x18 - x34
+	t124 := x2 + t122
+	t125 := complex(real(x2)-0.5*real(t122), imag(x2)-0.5*imag(t122))
+	t126 := complex(0.8660254037844386*real(t123), 0.8660254037844386*imag(t123))
+	t127 := complex(real(t125)-imag(t126), imag(t125)+real(t126))
+	t128 := complex(real(t125)+imag(t126), imag(t125)-real(t126))
+	t129 := x22 + x38
+	t130 := x22 - x38
+	t131 := x6 + t129
+	t132 := complex(real(x6)-0.5*real(t129), imag(x6)-0.5*imag(t129))
+	t133 := complex(0.8660254037844386*real(t130), 0.8660254037844386*imag(t130))
+	t134 := complex(real(t132)-imag(t133), imag(t132)+real(t133))
+	t135 := complex(real(t132)+imag(t133), imag(t132)-real(t133))
+	t136 := x26 + x42
+	t137 := x26 - x42
+	t138 := x10 + t136
+	t139 := complex(real(x10)-0.5*real(t136), imag(x10)-0.5*imag(t136))
+	t140 := complex(0.8660254037844386*real(t137), 0.8660254037844386*imag(t137))
+	t141 := complex(real(t139)-imag(t140), imag(t139)+real(t140))
+	t142 := complex(real(t139)+imag(t140), imag(t139)-real(t140))
+	t143 := x30 + x46
+	t144 := x30 - x46
+	t145 := x14 + t143
+	t146 := complex(real(x14)-0.5*real(t143), imag(x14)-0.5*imag(t143))
+	t147 := complex(0.8660254037844386*real(t144), 0.8660254037844386*imag(t144))
+	t148 := complex(real(t146)-imag(t147), imag(t146)+real(t147))
+	t149 := complex(real(t146)+imag(t147), imag(t146)-real(t147))
+	t150 := t124 + t138
+	t151 := t124 - t138
+	t152 := t131 + t145
+	t153 := t131 - t145
+	t154 := complex(-imag(t153), real(t153))
+	t155 := t150 + t152
+	t156 := t151 + t154
+	t157 := t150 - t152
+	t158 := t151 - t154
+	t159 := t134 * complex(0.8660254037844386, 0.5)
+	t160 := t141 * complex(0.5, 0.8660254037844386)
+	t161 := complex(-imag(t148), real(t148))
+	t162 := t127 + t160
+	t163 := t127 - t160
+	t164 := t159 + t161
+	t165 := t159 - t161
+	t166 := complex(-imag(t165), real(t165))
+	t167 := t162 + t164
+	t168 := t163 + t166
+	t169 := t162 - t164
+	t170 := t163 - t166
+	t171 := t135 * complex(0.5, 0.8660254037844386)
+	t172 := t142 * complex(-0.5, 0.8660254037844386)
+	t173 := -t149
+	t174 := t128 + t172
+	t175 := t128 - t172
+	t176 := t171 + t173
+	t177 := t171 - t173
+	t178 := complex(-imag(t177), real(t177))
+	t179 := t174 + t176
+	t180 := t175 + t178
+	t181 := t174 - t176
+	t182 := t175 - t178
+	t183 := x19 + x35
+	t184 := x19 - x35
+	t185 := x3 + t183
+	t186 := complex(real(x3)-0.5*real(t183), imag(x3)-0.5*imag(t183))
+	t187 := complex(0.8660254037844386*real(t184), 0.8660254037844386*imag(t184))
+	t188 := complex(real(t186)-imag(t187), imag(t186)+real(t187))
+	t189 := complex(real(t186)+imag(t187), imag(t186)-real(t187))
+	t190 := x23 + x39
+	t191 := x23 - x39
+	t192 := x7 + t190
+	t193 := complex(real(x7)-0.5*real(t190), imag(x7)-0.5*imag(t190))
+	t194 := complex(0.8660254037844386*real(t191), 0.8660254037844386*imag(t191))
+	t195 := complex(real(t193)-imag(t194), imag(t193)+real(t194))
+	t196 := complex(real(t193)+imag(t194), imag(t193)-real(t194))
+	t197 := x27 + x43
+	t198 := x27 - x43
+	t199 := x11 + t197
+	t200 := complex(real(x11)-0.5*real(t197), imag(x11)-0.5*imag(t197))
+	t201 := complex(0.8660254037844386*real(t198), 0.8660254037844386*imag(t198))
+	t202 := complex(real(t200)-imag(t201), imag(t200)+real(t201))
+	t203 := complex(real(t200)+imag(t201), imag(t200)-real(t201))
+	t204 := x31 + x47
+	t205 := x31 - x47
+	t206 := x15 + t204
+	t207 := complex(real(x15)-0.5*real(t204), imag(x15)-0.5*imag(t204))
+	t208 := complex(0.8660254037844386*real(t205), 0.8660254037844386*imag(t205))
+	t209 := complex(real(t207)-imag(t208), imag(t207)+real(t208))
+	t210 := complex(real(t207)+imag(t208), imag(t207)-real(t208))
+	t211 := t185 + t199
+	t212 := t185 - t199
+	t213 := t192 + t206
+	t214 := t192 - t206
+	t215 := complex(-imag(t214), real(t214))
+	t216 := t211 + t213
+	t217 := t212 + t215
+	t218 := t211 - t213
+	t219 := t212 - t215
+	t220 := t195 * complex(0.8660254037844386, 0.5)
+	t221 := t202 * complex(0.5, 0.8660254037844386)
+	t222 := complex(-imag(t209), real(t209))
+	t223 := t188 + t221
+	t224 := t188 - t221
+	t225 := t220 + t222
+	t226 := t220 - t222
+	t227 := complex(-imag(t226), real(t226))
+	t228 := t223 + t225
+	t229 := t224 + t227
+	t230 := t223 - t225
+	t231 := t224 - t227
+	t232 := t196 * complex(0.5, 0.8660254037844386)
+	t233 := t203 * complex(-0.5, 0.8660254037844386)
+	t234 := -t210
+	t235 := t189 + t233
+	t236 := t189 - t233
+	t237 := t232 + t234
+	t238 := t232 - t234
+	t239 := complex(-imag(t238), real(t238))
+	t240 := t235 + t237
+	t241 := t236 + t239
+	t242 := t235 - t237
+	t243 := t236 - t239
+	t244 := t33 + t155
+	t245 := t33 - t155
+	t246 := t94 + t216
+	t247 := t94 - t216
+	t248 := complex(-imag(t247), real(t247))
+	t249 := t244 + t246
+	t250 := t245 + t248
+	t251 := t244 - t246
+	t252 := t245 - t248
+	t253 := t106 * complex(0.99144486137381038, 0.1305261922200516)
+	t254 := t167 * complex(0.96592582628906831, 0.25881904510252074)
+	t255 := t228 * complex(0.92387953251128674, 0.38268343236508978)
+	t256 := t45 + t254
+	t257 := t45 - t254
+	t258 := t253 + t255
+	t259 := t253 - t255
+	t260 := complex(-imag(t259), real(t259))
+	t261 := t256 + t258
+	t262 := t257 + t260
+	t263 := t256 - t258
+	t264 := t257 - t260
+	t265 := t118 * complex(0.96592582628906831, 0.25881904510252074)
+	t266 := t179 * complex(0.8660254037844386, 0.5)
+	t267 := t240 * complex(0.70710678118654757, 0.70710678118654757)
+	t268 := t57 + t266
+	t269 := t57 - t266
+	t270 := t265 + t267
+	t271 := t265 - t267
+	t272 := complex(-imag(t271), real(t271))
+	t273 := t268 + t270
+	t274 := t269 + t272
+	t275 := t268 - t270
+	t276 := t269 - t272
+	t277 := t95 * complex(0.92387953251128674, 0.38268343236508978)
+	t278 := t156 * complex(0.70710678118654757, 0.70710678118654757)
+	t279 := t217 * complex(0.38268343236508978, 0.92387953251128674)
+	t280 := t34 + t278
+	t281 := t34 - t278
+	t282 := t277 + t279
+	t283 := t277 - t279
+	t284 := complex(-imag(t283), real(t283))
+	t285 := t280 + t282
+	t286 := t281 + t284
+	t287 := t280 - t282
+	t288 := t281 - t284
+	t289 := t107 * complex(0.8660254037844386, 0.5)
+	t290 := t168 * complex(0.5, 0.8660254037844386)
+	t291 := complex(-imag(t229), real(t229))
+	t292 := t46 + t290
+	t293 := t46 - t290
+	t294 := t289 + t291
+	t295 := t289 - t291
+	t296 := complex(-imag(t295), real(t295))
+	t297 := t292 + t294
+	t298 := t293 + t296
+	t299 := t292 - t294
+	t300 := t293 - t296
+	t301 := t119 * complex(0.79335334029123517, 0.60876142900872066)
+	t302 := t180 * complex(0.25881904510252074, 0.96592582628906831)
+	t303 := t241 * complex(-0.38268343236508978, 0.92387953251128674)
+	t304 := t58 + t302
+	t305 := t58 - t302
+	t306 := t301 + t303
+	t307 := t301 - t303
+	t308 := complex(-imag(t307), real(t307))
+	t309 := t304 + t306
+	t310 := t305 + t308
+	t311 := t304 - t306
+	t312 := t305 - t308
+	t313 := t96 * complex(0.70710678118654757, 0.70710678118654757)
+	t314 := complex(-imag(t157), real(t157))
+	t315 := t218 * complex(-0.70710678118654757, 0.70710678118654757)
+	t316 := t35 + t314
+	t317 := t35 - t314
+	t318 := t313 + t315
+	t319 := t313 - t315
+	t320 := complex(-imag(t319), real(t319))
+	t321 := t316 + t318
+	t322 := t317 + t320
+	t323 := t316 - t318
+	t324 := t317 - t320
+	t325 := t108 * complex(0.60876142900872066, 0.79335334029123517)
+	t326 := t169 * complex(-0.25881904510252074, 0.96592582628906831)
+	t327 := t230 * complex(-0.92387953251128674, 0.38268343236508978)
+	t328 := t47 + t326
+	t329 := t47 - t326
+	t330 := t325 + t327
+	t331 := t325 - t327
+	t332 := complex(-imag(t331), real(t331))
+	t333 := t328 + t330
+	t334 := t329 + t332
+	t335 := t328 - t330
+	t336 := t329 - t332
+	t337 := t120 * complex(0.5, 0.8660254037844386)
+	t338 := t181 * complex(-0.5, 0.8660254037844386)
+	t339 := -t242
+	t340 := t59 + t338
+	t341 := t59 - t338
+	t342 := t337 + t339
+	t343 := t337 - t339
+	t344 := complex(-imag(t343), real(t343))
+	t345 := t340 + t342
+	t346 := t341 + t344
+	t347 := t340 - t342
+	t348 := t341 - t344
+	t349 := t97 * complex(0.38268343236508978, 0.92387953251128674)
+	t350 := t158 * complex(-0.70710678118654757, 0.70710678118654757)
+	t351 := t219 * complex(-0.92387953251128674, -0.38268343236508978)
+	t352 := t36 + t350
+	t353 := t36 - t350
+	t354 := t349 + t351
+	t355 := t349 - t351
+	t356 := complex(-imag(t355), real(t355))
+	t357 := t352 + t354
+	t358 := t353 + t356
+	t359 := t352 - t354
+	t360 := t353 - t356
+	t361 := t109 * complex(0.25881904510252074, 0.96592582628906831)
+	t362 := t170 * complex(-0.8660254037844386, 0.5)
+	t363 := t231 * complex(-0.70710678118654757, -0.70710678118654757)
+	t364 := t48 + t362
+	t365 := t48 - t362
+	t366 := t361 + t363
+	t367 := t361 - t363
+	t368 := complex(-imag(t367), real(t367))
+	t369 := t364 + t366
+	t370 := t365 + t368
+	t371 := t364 - t366
+	t372 := t365 - t368
+	t373 := t121 * complex(0.1305261922200516, 0.99144486137381038)
+	t374 := t182 * complex(-0.96592582628906831, 0.25881904510252074)
+	t375 := t243 * complex(-0.38268343236508978, -0.92387953251128674)
+	t376 := t60 + t374
+	t377 := t60 - t374
+	t378 := t373 + t375
+	t379 := t373 - t375
+	t380 := complex(-imag(t379), real(t379))
+	t381 := t376 + t378
+	t382 := t377 + t380
+	t383 := t376 - t378
+	t384 := t377 - t380
+
+	const scale = 1.0 / 48
+
+	d[0] = complex(real(t249)*scale, imag(t249)*scale)
+	d[1] = complex(real(t261)*scale, imag(t261)*scale)
+	d[2] = complex(real(t273)*scale, imag(t273)*scale)
+	d[3] = complex(real(t285)*scale, imag(t285)*scale)
+	d[4] = complex(real(t297)*scale, imag(t297)*scale)
+	d[5] = complex(real(t309)*scale, imag(t309)*scale)
+	d[6] = complex(real(t321)*scale, imag(t321)*scale)
+	d[7] = complex(real(t333)*scale, imag(t333)*scale)
+	d[8] = complex(real(t345)*scale, imag(t345)*scale)
+	d[9] = complex(real(t357)*scale, imag(t357)*scale)
+	d[10] = complex(real(t369)*scale, imag(t369)*scale)
+	d[11] = complex(real(t381)*scale, imag(t381)*scale)
+	d[12] = complex(real(t250)*scale, imag(t250)*scale)
+	d[13] = complex(real(t262)*scale, imag(t262)*scale)
+	d[14] = complex(real(t274)*scale, imag(t274)*scale)
+	d[15] = complex(real(t286)*scale, imag(t286)*scale)
+	d[16] = complex(real(t298)*scale, imag(t298)*scale)
+	d[17] = complex(real(t310)*scale, imag(t310)*scale)
+	d[18] = complex(real(t322)*scale, imag(t322)*scale)
+	d[19] = complex(real(t334)*scale, imag(t334)*scale)
+	d[20] = complex(real(t346)*scale, imag(t346)*scale)
+	d[21] = complex(real(t358)*scale, imag(t358)*scale)
+	d[22] = complex(real(t370)*scale, imag(t370)*scale)
+	d[23] = complex(real(t382)*scale, imag(t382)*scale)
+	d[24] = complex(real(t251)*scale, imag(t251)*scale)
+	d[25] = complex(real(t263)*scale, imag(t263)*scale)
+	d[26] = complex(real(t275)*scale, imag(t275)*scale)
+	d[27] = complex(real(t287)*scale, imag(t287)*scale)
+	d[28] = complex(real(t299)*scale, imag(t299)*scale)
+	d[29] = complex(real(t311)*scale, imag(t311)*scale)
+	d[30] = complex(real(t323)*scale, imag(t323)*scale)
+	d[31] = complex(real(t335)*scale, imag(t335)*scale)
+	d[32] = complex(real(t347)*scale, imag(t347)*scale)
+	d[33] = complex(real(t359)*scale, imag(t359)*scale)
+	d[34] = complex(real(t371)*scale, imag(t371)*scale)
+	d[35] = complex(real(t383)*scale, imag(t383)*scale)
+	d[36] = complex(real(t252)*scale, imag(t252)*scale)
+	d[37] = complex(real(t264)*scale, imag(t264)*scale)
+	d[38] = complex(real(t276)*scale, imag(t276)*scale)
+	d[39] = complex(real(t288)*scale, imag(t288)*scale)
+	d[40] = complex(real(t300)*scale, imag(t300)*scale)
+	d[41] = complex(real(t312)*scale, imag(t312)*scale)
+	d[42] = complex(real(t324)*scale, imag(t324)*scale)
+	d[43] = complex(real(t336)*scale, imag(t336)*scale)
+	d[44] = complex(real(t348)*scale, imag(t348)*scale)
+	d[45] = complex(real(t360)*scale, imag(t360)*scale)
+	d[46] = complex(real(t372)*scale, imag(t372)*scale)
+	d[47] = complex(real(t384)*scale, imag(t384)*scale)
+
+	return true
+}
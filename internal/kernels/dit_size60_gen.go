@@ -0,0 +1,2583 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// forwardDIT60GenComplex64 computes a 60-point forward FFT for complex64 data as
+// straight-line code (radices 4x3x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT60GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 60
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+
+	t0 := x12 + x48
+	t1 := x12 - x48
+	t2 := x24 + x36
+	t3 := x24 - x36
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t8 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t12 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t13 := x16 + x52
+	t14 := x16 - x52
+	t15 := x28 + x40
+	t16 := x28 - x40
+	t17 := x4 + t13 + t15
+	t18 := complex(real(x4)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x4)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t21 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t22 := complex(real(x4)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x4)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t25 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t26 := x20 + x56
+	t27 := x20 - x56
+	t28 := x32 + x44
+	t29 := x32 - x44
+	t30 := x8 + t26 + t28
+	t31 := complex(real(x8)+0.30901699437494745*real(t26)-0.80901699437494745*real(t28), imag(x8)+0.30901699437494745*imag(t26)-0.80901699437494745*imag(t28))
+	t32 := complex(0.95105651629515353*real(t27)+0.58778525229247314*real(t29), 0.95105651629515353*imag(t27)+0.58778525229247314*imag(t29))
+	t33 := complex(real(t31)+imag(t32), imag(t31)-real(t32))
+	t34 := complex(real(t31)-imag(t32), imag(t31)+real(t32))
+	t35 := complex(real(x8)-0.80901699437494745*real(t26)+0.30901699437494745*real(t28), imag(x8)-0.80901699437494745*imag(t26)+0.30901699437494745*imag(t28))
+	t36 := complex(0.58778525229247314*real(t27)-0.95105651629515353*real(t29), 0.58778525229247314*imag(t27)-0.95105651629515353*imag(t29))
+	t37 := complex(real(t35)+imag(t36), imag(t35)-real(t36))
+	t38 := complex(real(t35)-imag(t36), imag(t35)+real(t36))
+	t39 := t17 + t30
+	t40 := t17 - t30
+	t41 := t4 + t39
+	t42 := complex(real(t4)-0.5*real(t39), imag(t4)-0.5*imag(t39))
+	t43 := complex(0.8660254037844386*real(t40), 0.8660254037844386*imag(t40))
+	t44 := complex(real(t42)+imag(t43), imag(t42)-real(t43))
+	t45 := complex(real(t42)-imag(t43), imag(t42)+real(t43))
+	t46 := t20 * complex(0.91354545764260087, -0.40673664307580021)
+	t47 := t33 * complex(0.66913060635885824, -0.74314482547739424)
+	t48 := t46 + t47
+	t49 := t46 - t47
+	t50 := t7 + t48
+	t51 := complex(real(t7)-0.5*real(t48), imag(t7)-0.5*imag(t48))
+	t52 := complex(0.8660254037844386*real(t49), 0.8660254037844386*imag(t49))
+	t53 := complex(real(t51)+imag(t52), imag(t51)-real(t52))
+	t54 := complex(real(t51)-imag(t52), imag(t51)+real(t52))
+	t55 := t24 * complex(0.66913060635885824, -0.74314482547739424)
+	t56 := t37 * complex(-0.10452846326765347, -0.99452189536827329)
+	t57 := t55 + t56
+	t58 := t55 - t56
+	t59 := t11 + t57
+	t60 := complex(real(t11)-0.5*real(t57), imag(t11)-0.5*imag(t57))
+	t61 := complex(0.8660254037844386*real(t58), 0.8660254037844386*imag(t58))
+	t62 := complex(real(t60)+imag(t61), imag(t60)-real(t61))
+	t63 := complex(real(t60)-imag(t61), imag(t60)+real(t61))
+	t64 := t25 * complex(0.30901699437494745, -0.95105651629515353)
+	t65 := t38 * complex(-0.80901699437494745, -0.58778525229247314)
+	t66 := t64 + t65
+	t67 := t64 - t65
+	t68 := t12 + t66
+	t69 := complex(real(t12)-0.5*real(t66), imag(t12)-0.5*imag(t66))
+	t70 := complex(0.8660254037844386*real(t67), 0.8660254037844386*imag(t67))
+	t71 := complex(real(t69)+imag(t70), imag(t69)-real(t70))
+	t72 := complex(real(t69)-imag(t70), imag(t69)+real(t70))
+	t73 := t21 * complex(-0.10452846326765347, -0.99452189536827329)
+	t74 := t34 * complex(-0.97814760073380569, 0.20791169081775934)
+	t75 := t73 + t74
+	t76 := t73 - t74
+	t77 := t8 + t75
+	t78 := complex(real(t8)-0.5*real(t75), imag(t8)-0.5*imag(t75))
+	t79 := complex(0.8660254037844386*real(t76), 0.8660254037844386*imag(t76))
+	t80 := complex(real(t78)+imag(t79), imag(t78)-real(t79))
+	t81 := complex(real(t78)-imag(t79), imag(t78)+real(t79))
+	t82 := x13 + x49
+	t83 := x13 - x49
+	t84 := x25 + x37
+	t85 := x25 - x37
+	t86 := x1 + t82 + t84
+	t87 := complex(real(x1)+0.30901699437494745*real(t82)-0.80901699437494745*real(t84), imag(x1)+0.30901699437494745*imag(t82)-0.80901699437494745*imag(t84))
+	t88 := complex(0.95105651629515353*real(t83)+0.58778525229247314*real(t85), 0.95105651629515353*imag(t83)+0.58778525229247314*imag(t85))
+	t89 := complex(real(t87)+imag(t88), imag(t87)-real(t88))
+	t90 := complex(real(t87)-imag(t88), imag(t87)+real(t88))
+	t91 := complex(real(x1)-0.80901699437494745*real(t82)+0.30901699437494745*real(t84), imag(x1)-0.80901699437494745*imag(t82)+0.30901699437494745*imag(t84))
+	t92 := complex(0.58778525229247314*real(t83)-0.95105651629515353*real(t85), 0.58778525229247314*imag(t83)-0.95105651629515353*imag(t85))
+	t93 := complex(real(t91)+imag(t92), imag(t91)-real(t92))
+	t94 := complex(real(t91)-imag(t92), imag(t91)+real(t92))
+	t95 := x17 + x53
+	t96 := x17 - x53
+	t97 := x29 + x41
+	t98 := x29 - x41
+	t99 := x5 + t95 + t97
+	t100 := complex(real(x5)+0.30901699437494745*real(t95)-0.80901699437494745*real(t97), imag(x5)+0.30901699437494745*imag(t95)-0.80901699437494745*imag(t97))
+	t101 := complex(0.95105651629515353*real(t96)+0.58778525229247314*real(t98), 0.95105651629515353*imag(t96)+0.58778525229247314*imag(t98))
+	t102 := complex(real(t100)+imag(t101), imag(t100)-real(t101))
+	t103 := complex(real(t100)-imag(t101), imag(t100)+real(t101))
+	t104 := complex(real(x5)-0.80901699437494745*real(t95)+0.30901699437494745*real(t97), imag(x5)-0.80901699437494745*imag(t95)+0.30901699437494745*imag(t97))
+	t105 := complex(0.58778525229247314*real(t96)-0.95105651629515353*real(t98), 0.58778525229247314*imag(t96)-0.95105651629515353*imag(t98))
+	t106 := complex(real(t104)+imag(t105), imag(t104)-real(t105))
+	t107 := complex(real(t104)-imag(t105), imag(t104)+real(t105))
+	t108 := x21 + x57
+	t109 := x21 - x57
+	t110 := x33 + x45
+	t111 := x33 - x45
+	t112 := x9 + t108 + t110
+	t113 := complex(real(x9)+0.30901699437494745*real(t108)-0.80901699437494745*real(t110), imag(x9)+0.30901699437494745*imag(t108)-0.80901699437494745*imag(t110))
+	t114 := complex(0.95105651629515353*real(t109)+0.58778525229247314*real(t111), 0.95105651629515353*imag(t109)+0.58778525229247314*imag(t111))
+	t115 := complex(real(t113)+imag(t114), imag(t113)-real(t114))
+	t116 := complex(real(t113)-imag(t114), imag(t113)+real(t114))
+	t117 := complex(real(x9)-0.80901699437494745*real(t108)+0.30901699437494745*real(t110), imag(x9)-0.80901699437494745*imag(t108)+0.30901699437494745*imag(t110))
+	t118 := complex(0.58778525229247314*real(t109)-0.95105651629515353*real(t111), 0.58778525229247314*imag(t109)-0.95105651629515353*imag(t111))
+	t119 := complex(real(t117)+imag(t118), imag(t117)-real(t118))
+	t120 := complex(real(t117)-imag(t118), imag(t117)+real(t118))
+	t121 := t99 + t112
+	t122 := t99 - t112
+	t123 := t86 + t121
+	t124 := complex(real(t86)-0.5*real(t121), imag(t86)-0.5*imag(t121))
+	t125 := complex(0.8660254037844386*real(t122), 0.8660254037844386*imag(t122))
+	t126 := complex(real(t124)+imag(t125), imag(t124)-real(t125))
+	t127 := complex(real(t124)-imag(t125), imag(t124)+real(t125))
+	t128 := t102 * complex(0.91354545764260087, -0.40673664307580021)
+	t129 := t115 * complex(0.66913060635885824, -0.74314482547739424)
+	t130 := t128 + t129
+	t131 := t128 - t129
+	t132 := t89 + t130
+	t133 := complex(real(t89)-0.5*real(t130), imag(t89)-0.5*imag(t130))
+	t134 := complex(0.8660254037844386*real(t131), 0.8660254037844386*imag(t131))
+	t135 := complex(real(t133)+imag(t134), imag(t133)-real(t134))
+	t136 := complex(real(t133)-imag(t134), imag(t133)+real(t134))
+	t137 := t106 * complex(0.66913060635885824, -0.74314482547739424)
+	t138 := t119 * complex(-0.10452846326765347, -0.99452189536827329)
+	t139 := t137 + t138
+	t140 := t137 - t138
+	t141 := t93 + t139
+	t142 := complex(real(t93)-0.5*real(t139), imag(t93)-0.5*imag(t139))
+	t143 := complex(0.8660254037844386*real(t140), 0.8660254037844386*imag(t140))
+	t144 := complex(real(t142)+imag(t143), imag(t142)-real(t143))
+	t145 := complex(real(t142)-imag(t143), imag(t142)+real(t143))
+	t146 := t107 * complex(0.30901699437494745, -0.95105651629515353)
+	t147 := t120 * complex(-0.80901699437494745, -0.58778525229247314)
+	t148 := t146 + t147
+	t149 := t146 - t147
+	t150 := t94 + t148
+	t151 := complex(real(t94)-0.5*real(t148), imag(t94)-0.5*imag(t148))
+	t152 := complex(0.8660254037844386*real(t149), 0.8660254037844386*imag(t149))
+	t153 := complex(real(t151)+imag(t152), imag(t151)-real(t152))
+	t154 := complex(real(t151)-imag(t152), imag(t151)+real(t152))
+	t155 := t103 * complex(-0.10452846326765347, -0.99452189536827329)
+	t156 := t116 * complex(-0.97814760073380569, 0.20791169081775934)
+	t157 := t155 + t156
+	t158 := t155 - t156
+	t159 := t90 + t157
+	t160 := complex(real(t90)-0.5*real(t157), imag(t90)-0.5*imag(t157))
+	t161 := complex(0.8660254037844386*real(t158), 0.8660254037844386*imag(t158))
+	t162 := complex(real(t160)+imag(t161), imag(t160)-real(t161))
+	t163 := complex(real(t160)-imag(t161), imag(t160)+real(t161))
+	t164 := x14 + x50
+	t165 := x14 - x50
+	t166 := x26 + x38
+	t167 := x26 - x38
+	t168 := x2 + t164 + t166
+	t169 := complex(real(x2)+0.30901699437494745*real(t164)-0.80901699437494745*real(t166), imag(x2)+0.30901699437494745*imag(t164)-0.80901699437494745*imag(t166))
+	t170 := complex(0.95105651629515353*real(t165)+0.58778525229247314*real(t167), 0.95105651629515353*imag(t165)+0.58778525229247314*imag(t167))
+	t171 := complex(real(t169)+imag(t170), imag(t169)-real(t170))
+	t172 := complex(real(t169)-imag(t170), imag(t169)+real(t170))
+	t173 := complex(real(x2)-0.80901699437494745*real(t164)+0.30901699437494745*real(t166), imag(x2)-0.80901699437494745*imag(t164)+0.30901699437494745*imag(t166))
+	t174 := complex(0.58778525229247314*real(t165)-0.95105651629515353*real(t167), 0.58778525229247314*imag(t165)-0.95105651629515353*imag(t167))
+	t175 := complex(real(t173)+imag(t174), imag(t173)-real(t174))
+	t176 := complex(real(t173)-imag(t174), imag(t173)+real(t174))
+	t177 := x18 + x54
+	t178 := x18 - x54
+	t179 := x30 + x42
+	t180 := x30 - x42
+	t181 := x6 + t177 + t179
+	t182 := complex(real(x6)+0.30901699437494745*real(t177)-0.80901699437494745*real(t179), imag(x6)+0.30901699437494745*imag(t177)-0.80901699437494745*imag(t179))
+	t183 := complex(0.95105651629515353*real(t178)+0.58778525229247314*real(t180), 0.95105651629515353*imag(t178)+0.58778525229247314*imag(t180))
+	t184 := complex(real(t182)+imag(t183), imag(t182)-real(t183))
+	t185 := complex(real(t182)-imag(t183), imag(t182)+real(t183))
+	t186 := complex(real(x6)-0.80901699437494745*real(t177)+0.30901699437494745*real(t179), imag(x6)-0.80901699437494745*imag(t177)+0.30901699437494745*imag(t179))
+	t187 := complex(0.58778525229247314*real(t178)-0.95105651629515353*real(t180), 0.58778525229247314*imag(t178)-0.95105651629515353*imag(t180))
+	t188 := complex(real(t186)+imag(t187), imag(t186)-real(t187))
+	t189 := complex(real(t186)-imag(t187), imag(t186)+real(t187))
+	t190 := x22 + x58
+	t191 := x22 - x58
+	t192 := x34 + x46
+	t193 := x34 - x46
+	t194 := x10 + t190 + t192
+	t195 := complex(real(x10)+0.30901699437494745*real(t190)-0.80901699437494745*real(t192), imag(x10)+0.30901699437494745*imag(t190)-0.80901699437494745*imag(t192))
+	t196 := complex(0.95105651629515353*real(t191)+0.58778525229247314*real(t193), 0.95105651629515353*imag(t191)+0.58778525229247314*imag(t193))
+	t197 := complex(real(t195)+imag(t196), imag(t195)-real(t196))
+	t198 := complex(real(t195)-imag(t196), imag(t195)+real(t196))
+	t199 := complex(real(x10)-0.80901699437494745*real(t190)+0.30901699437494745*real(t192), imag(x10)-0.80901699437494745*imag(t190)+0.30901699437494745*imag(t192))
+	t200 := complex(0.58778525229247314*real(t191)-0.95105651629515353*real(t193), 0.58778525229247314*imag(t191)-0.95105651629515353*imag(t193))
+	t201 := complex(real(t199)+imag(t200), imag(t199)-real(t200))
+	t202 := complex(real(t199)-imag(t200), imag(t199)+real(t200))
+	t203 := t181 + t194
+	t204 := t181 - t194
+	t205 := t168 + t203
+	t206 := complex(real(t168)-0.5*real(t203), imag(t168)-0.5*imag(t203))
+	t207 := complex(0.8660254037844386*real(t204), 0.8660254037844386*imag(t204))
+	t208 := complex(real(t206)+imag(t207), imag(t206)-real(t207))
+	t209 := complex(real(t206)-imag(t207), imag(t206)+real(t207))
+	t210 := t184 * complex(0.91354545764260087, -0.40673664307580021)
+	t211 := t197 * complex(0.66913060635885824, -0.74314482547739424)
+	t212 := t210 + t211
+	t213 := t210 - t211
+	t214 := t171 + t212
+	t215 := complex(real(t171)-0.5*real(t212), imag(t171)-0.5*imag(t212))
+	t216 := complex(0.8660254037844386*real(t213), 0.8660254037844386*imag(t213))
+	t217 := complex(real(t215)+imag(t216), imag(t215)-real(t216))
+	t218 := complex(real(t215)-imag(t216), imag(t215)+real(t216))
+	t219 := t188 * complex(0.66913060635885824, -0.74314482547739424)
+	t220 := t201 * complex(-0.10452846326765347, -0.99452189536827329)
+	t221 := t219 + t220
+	t222 := t219 - t220
+	t223 := t175 + t221
+	t224 := complex(real(t175)-0.5*real(t221), imag(t175)-0.5*imag(t221))
+	t225 := complex(0.8660254037844386*real(t222), 0.8660254037844386*imag(t222))
+	t226 := complex(real(t224)+imag(t225), imag(t224)-real(t225))
+	t227 := complex(real(t224)-imag(t225), imag(t224)+real(t225))
+	t228 := t189 * complex(0.30901699437494745, -0.95105651629515353)
+	t229 := t202 * complex(-0.80901699437494745, -0.58778525229247314)
+	t230 := t228 + t229
+	t231 := t228 - t229
+	t232 := t176 + t230
+	t233 := complex(real(t176)-0.5*real(t230), imag(t176)-0.5*imag(t230))
+	t234 := complex(0.8660254037844386*real(t231), 0.8660254037844386*imag(t231))
+	t235 := complex(real(t233)+imag(t234), imag(t233)-real(t234))
+	t236 := complex(real(t233)-imag(t234), imag(t233)+real(t234))
+	t237 := t185 * complex(-0.10452846326765347, -0.99452189536827329)
+	t238 := t198 * complex(-0.97814760073380569, 0.20791169081775934)
+	t239 := t237 + t238
+	t240 := t237 - t238
+	t241 := t172 + t239
+	t242 := complex(real(t172)-0.5*real(t239), imag(t172)-0.5*imag(t239))
+	t243 := complex(0.8660254037844386*real(t240), 0.8660254037844386*imag(t240))
+	t244 := complex(real(t242)+imag(t243), imag(t242)-real(t243))
+	t245 := complex(real(t242)-imag(t243), imag(t242)+real(t243))
+	t246 := x15 + x51
+	t247 := x15 - x51
+	t248 := x27 + x39
+	t249 := x27 - x39
+	t250 := x3 + t246 + t248
+	t251 := complex(real(x3)+0.30901699437494745*real(t246)-0.80901699437494745*real(t248), imag(x3)+0.30901699437494745*imag(t246)-0.80901699437494745*imag(t248))
+	t252 := complex(0.95105651629515353*real(t247)+0.58778525229247314*real(t249), 0.95105651629515353*imag(t247)+0.58778525229247314*imag(t249))
+	t253 := complex(real(t251)+imag(t252), imag(t251)-real(t252))
+	t254 := complex(real(t251)-imag(t252), imag(t251)+real(t252))
+	t255 := complex(real(x3)-0.80901699437494745*real(t246)+0.30901699437494745*real(t248), imag(x3)-0.80901699437494745*imag(t246)+0.30901699437494745*imag(t248))
+	t256 := complex(0.58778525229247314*real(t247)-0.95105651629515353*real(t249), 0.58778525229247314*imag(t247)-0.95105651629515353*imag(t249))
+	t257 := complex(real(t255)+imag(t256), imag(t255)-real(t256))
+	t258 := complex(real(t255)-imag(t256), imag(t255)+real(t256))
+	t259 := x19 + x55
+	t260 := x19 - x55
+	t261 := x31 + x43
+	t262 := x31 - x43
+	t263 := x7 + t259 + t261
+	t264 := complex(real(x7)+0.30901699437494745*real(t259)-0.80901699437494745*real(t261), imag(x7)+0.30901699437494745*imag(t259)-0.80901699437494745*imag(t261))
+	t265 := complex(0.95105651629515353*real(t260)+0.58778525229247314*real(t262), 0.95105651629515353*imag(t260)+0.58778525229247314*imag(t262))
+	t266 := complex(real(t264)+imag(t265), imag(t264)-real(t265))
+	t267 := complex(real(t264)-imag(t265), imag(t264)+real(t265))
+	t268 := complex(real(x7)-0.80901699437494745*real(t259)+0.30901699437494745*real(t261), imag(x7)-0.80901699437494745*imag(t259)+0.30901699437494745*imag(t261))
+	t269 := complex(0.58778525229247314*real(t260)-0.95105651629515353*real(t262), 0.58778525229247314*imag(t260)-0.95105651629515353*imag(t262))
+	t270 := complex(real(t268)+imag(t269), imag(t268)-real(t269))
+	t271 := complex(real(t268)-imag(t269), imag(t268)+real(t269))
+	t272 := x23 + x59
+	t273 := x23 - x59
+	t274 := x35 + x47
+	t275 := x35 - x47
+	t276 := x11 + t272 + t274
+	t277 := complex(real(x11)+0.30901699437494745*real(t272)-0.80901699437494745*real(t274), imag(x11)+0.30901699437494745*imag(t272)-0.80901699437494745*imag(t274))
+	t278 := complex(0.95105651629515353*real(t273)+0.58778525229247314*real(t275), 0.95105651629515353*imag(t273)+0.58778525229247314*imag(t275))
+	t279 := complex(real(t277)+imag(t278), imag(t277)-real(t278))
+	t280 := complex(real(t277)-imag(t278), imag(t277)+real(t278))
+	t281 := complex(real(x11)-0.80901699437494745*real(t272)+0.30901699437494745*real(t274), imag(x11)-0.80901699437494745*imag(t272)+0.30901699437494745*imag(t274))
+	t282 := complex(0.58778525229247314*real(t273)-0.95105651629515353*real(t275), 0.58778525229247314*imag(t273)-0.95105651629515353*imag(t275))
+	t283 := complex(real(t281)+imag(t282), imag(t281)-real(t282))
+	t284 := complex(real(t281)-imag(t282), imag(t281)+real(t282))
+	t285 := t263 + t276
+	t286 := t263 - t276
+	t287 := t250 + t285
+	t288 := complex(real(t250)-0.5*real(t285), imag(t250)-0.5*imag(t285))
+	t289 := complex(0.8660254037844386*real(t286), 0.8660254037844386*imag(t286))
+	t290 := complex(real(t288)+imag(t289), imag(t288)-real(t289))
+	t291 := complex(real(t288)-imag(t289), imag(t288)+real(t289))
+	t292 := t266 * complex(0.91354545764260087, -0.40673664307580021)
+	t293 := t279 * complex(0.66913060635885824, -0.74314482547739424)
+	t294 := t292 + t293
+	t295 := t292 - t293
+	t296 := t253 + t294
+	t297 := complex(real(t253)-0.5*real(t294), imag(t253)-0.5*imag(t294))
+	t298 := complex(0.8660254037844386*real(t295), 0.8660254037844386*imag(t295))
+	t299 := complex(real(t297)+imag(t298), imag(t297)-real(t298))
+	t300 := complex(real(t297)-imag(t298), imag(t297)+real(t298))
+	t301 := t270 * complex(0.66913060635885824, -0.74314482547739424)
+	t302 := t283 * complex(-0.10452846326765347, -0.99452189536827329)
+	t303 := t301 + t302
+	t304 := t301 - t302
+	t305 := t257 + t303
+	t306 := complex(real(t257)-0.5*real(t303), imag(t257)-0.5*imag(t303))
+	t307 := complex(0.8660254037844386*real(t304), 0.8660254037844386*imag(t304))
+	t308 := complex(real(t306)+imag(t307), imag(t306)-real(t307))
+	t309 := complex(real(t306)-imag(t307), imag(t306)+real(t307))
+	t310 := t271 * complex(0.30901699437494745, -0.95105651629515353)
+	t311 := t284 * complex(-0.80901699437494745, -0.58778525229247314)
+	t312 := t310 + t311
+	t313 := t310 - t311
+	t314 := t258 + t312
+	t315 := complex(real(t258)-0.5*real(t312), imag(t258)-0.5*imag(t312))
+	t316 := complex(0.8660254037844386*real(t313), 0.8660254037844386*imag(t313))
+	t317 := complex(real(t315)+imag(t316), imag(t315)-real(t316))
+	t318 := complex(real(t315)-imag(t316), imag(t315)+real(t316))
+	t319 := t267 * complex(-0.10452846326765347, -0.99452189536827329)
+	t320 := t280 * complex(-0.97814760073380569, 0.20791169081775934)
+	t321 := t319 + t320
+	t322 := t319 - t320
+	t323 := t254 + t321
+	t324 := complex(real(t254)-0.5*real(t321), imag(t254)-0.5*imag(t321))
+	t325 := complex(0.8660254037844386*real(t322), 0.8660254037844386*imag(t322))
+	t326 := complex(real(t324)+imag(t325), imag(t324)-real(t325))
+	t327 := complex(real(t324)-imag(t325), imag(t324)+real(t325))
+	t328 := t41 + t205
+	t329 := t41 - t205
+	t330 := t123 + t287
+	t331 := t123 - t287
+	t332 := complex(imag(t331), -real(t331))
+	t333 := t328 + t330
+	t334 := t329 + t332
+	t335 := t328 - t330
+	t336 := t329 - t332
+	t337 := t132 * complex(0.99452189536827329, -0.10452846326765347)
+	t338 := t214 * complex(0.97814760073380569, -0.20791169081775934)
+	t339 := t296 * complex(0.95105651629515353, -0.30901699437494745)
+	t340 := t50 + t338
+	t341 := t50 - t338
+	t342 := t337 + t339
+	t343 := t337 - t339
+	t344 := complex(imag(t343), -real(t343))
+	t345 := t340 + t342
+	t346 := t341 + t344
+	t347 := t340 - t342
+	t348 := t341 - t344
+	t349 := t141 * complex(0.97814760073380569, -0.20791169081775934)
+	t350 := t223 * complex(0.91354545764260087, -0.40673664307580021)
+	t351 := t305 * complex(0.80901699437494745, -0.58778525229247314)
+	t352 := t59 + t350
+	t353 := t59 - t350
+	t354 := t349 + t351
+	t355 := t349 - t351
+	t356 := complex(imag(t355), -real(t355))
+	t357 := t352 + t354
+	t358 := t353 + t356
+	t359 := t352 - t354
+	t360 := t353 - t356
+	t361 := t150 * complex(0.95105651629515353, -0.30901699437494745)
+	t362 := t232 * complex(0.80901699437494745, -0.58778525229247314)
+	t363 := t314 * complex(0.58778525229247314, -0.80901699437494745)
+	t364 := t68 + t362
+	t365 := t68 - t362
+	t366 := t361 + t363
+	t367 := t361 - t363
+	t368 := complex(imag(t367), -real(t367))
+	t369 := t364 + t366
+	t370 := t365 + t368
+	t371 := t364 - t366
+	t372 := t365 - t368
+	t373 := t159 * complex(0.91354545764260087, -0.40673664307580021)
+	t374 := t241 * complex(0.66913060635885824, -0.74314482547739424)
+	t375 := t323 * complex(0.30901699437494745, -0.95105651629515353)
+	t376 := t77 + t374
+	t377 := t77 - t374
+	t378 := t373 + t375
+	t379 := t373 - t375
+	t380 := complex(imag(t379), -real(t379))
+	t381 := t376 + t378
+	t382 := t377 + t380
+	t383 := t376 - t378
+	t384 := t377 - t380
+	t385 := t126 * complex(0.8660254037844386, -0.5)
+	t386 := t208 * complex(0.5, -0.8660254037844386)
+	t387 := complex(imag(t290), -real(t290))
+	t388 := t44 + t386
+	t389 := t44 - t386
+	t390 := t385 + t387
+	t391 := t385 - t387
+	t392 := complex(imag(t391), -real(t391))
+	t393 := t388 + t390
+	t394 := t389 + t392
+	t395 := t388 - t390
+	t396 := t389 - t392
+	t397 := t135 * complex(0.80901699437494745, -0.58778525229247314)
+	t398 := t217 * complex(0.30901699437494745, -0.95105651629515353)
+	t399 := t299 * complex(-0.30901699437494745, -0.95105651629515353)
+	t400 := t53 + t398
+	t401 := t53 - t398
+	t402 := t397 + t399
+	t403 := t397 - t399
+	t404 := complex(imag(t403), -real(t403))
+	t405 := t400 + t402
+	t406 := t401 + t404
+	t407 := t400 - t402
+	t408 := t401 - t404
+	t409 := t144 * complex(0.74314482547739424, -0.66913060635885824)
+	t410 := t226 * complex(0.10452846326765347, -0.99452189536827329)
+	t411 := t308 * complex(-0.58778525229247314, -0.80901699437494745)
+	t412 := t62 + t410
+	t413 := t62 - t410
+	t414 := t409 + t411
+	t415 := t409 - t411
+	t416 := complex(imag(t415), -real(t415))
+	t417 := t412 + t414
+	t418 := t413 + t416
+	t419 := t412 - t414
+	t420 := t413 - t416
+	t421 := t153 * complex(0.66913060635885824, -0.74314482547739424)
+	t422 := t235 * complex(-0.10452846326765347, -0.99452189536827329)
+	t423 := t317 * complex(-0.80901699437494745, -0.58778525229247314)
+	t424 := t71 + t422
+	t425 := t71 - t422
+	t426 := t421 + t423
+	t427 := t421 - t423
+	t428 := complex(imag(t427), -real(t427))
+	t429 := t424 + t426
+	t430 := t425 + t428
+	t431 := t424 - t426
+	t432 := t425 - t428
+	t433 := t162 * complex(0.58778525229247314, -0.80901699437494745)
+	t434 := t244 * complex(-0.30901699437494745, -0.95105651629515353)
+	t435 := t326 * complex(-0.95105651629515353, -0.30901699437494745)
+	t436 := t80 + t434
+	t437 := t80 - t434
+	t438 := t433 + t435
+	t439 := t433 - t435
+	t440 := complex(imag(t439), -real(t439))
+	t441 := t436 + t438
+	t442 := t437 + t440
+	t443 := t436 - t438
+	t444 := t437 - t440
+	t445 := t127 * complex(0.5, -0.8660254037844386)
+	t446 := t209 * complex(-0.5, -0.8660254037844386)
+	t447 := -t291
+	t448 := t45 + t446
+	t449 := t45 - t446
+	t450 := t445 + t447
+	t451 := t445 - t447
+	t452 := complex(imag(t451), -real(t451))
+	t453 := t448 + t450
+	t454 := t449 + t452
+	t455 := t448 - t450
+	t456 := t449 - t452
+	t457 := t136 * complex(0.40673664307580021, -0.91354545764260087)
+	t458 := t218 * complex(-0.66913060635885824, -0.74314482547739424)
+	t459 := t300 * complex(-0.95105651629515353, 0.30901699437494745)
+	t460 := t54 + t458
+	t461 := t54 - t458
+	t462 := t457 + t459
+	t463 := t457 - t459
+	t464 := complex(imag(t463), -real(t463))
+	t465 := t460 + t462
+	t466 := t461 + t464
+	t467 := t460 - t462
+	t468 := t461 - t464
+	t469 := t145 * complex(0.30901699437494745, -0.95105651629515353)
+	t470 := t227 * complex(-0.80901699437494745, -0.58778525229247314)
+	t471 := t309 * complex(-0.80901699437494745, 0.58778525229247314)
+	t472 := t63 + t470
+	t473 := t63 - t470
+	t474 := t469 + t471
+	t475 := t469 - t471
+	t476 := complex(imag(t475), -real(t475))
+	t477 := t472 + t474
+	t478 := t473 + t476
+	t479 := t472 - t474
+	t480 := t473 - t476
+	t481 := t154 * complex(0.20791169081775934, -0.97814760073380569)
+	t482 := t236 * complex(-0.91354545764260087, -0.40673664307580021)
+	t483 := t318 * complex(-0.58778525229247314, 0.80901699437494745)
+	t484 := t72 + t482
+	t485 := t72 - t482
+	t486 := t481 + t483
+	t487 := t481 - t483
+	t488 := complex(imag(t487), -real(t487))
+	t489 := t484 + t486
+	t490 := t485 + t488
+	t491 := t484 - t486
+	t492 := t485 - t488
+	t493 := t163 * complex(0.10452846326765347, -0.99452189536827329)
+	t494 := t245 * complex(-0.97814760073380569, -0.20791169081775934)
+	t495 := t327 * complex(-0.30901699437494745, 0.95105651629515353)
+	t496 := t81 + t494
+	t497 := t81 - t494
+	t498 := t493 + t495
+	t499 := t493 - t495
+	t500 := complex(imag(t499), -real(t499))
+	t501 := t496 + t498
+	t502 := t497 + t500
+	t503 := t496 - t498
+	t504 := t497 - t500
+
+	d[0] = t333
+	d[1] = t345
+	d[2] = t357
+	d[3] = t369
+	d[4] = t381
+	d[5] = t393
+	d[6] = t405
+	d[7] = t417
+	d[8] = t429
+	d[9] = t441
+	d[10] = t453
+	d[11] = t465
+	d[12] = t477
+	d[13] = t489
+	d[14] = t501
+	d[15] = t334
+	d[16] = t346
+	d[17] = t358
+	d[18] = t370
+	d[19] = t382
+	d[20] = t394
+	d[21] = t406
+	d[22] = t418
+	d[23] = t430
+	d[24] = t442
+	d[25] = t454
+	d[26] = t466
+	d[27] = t478
+	d[28] = t490
+	d[29] = t502
+	d[30] = t335
+	d[31] = t347
+	d[32] = t359
+	d[33] = t371
+	d[34] = t383
+	d[35] = t395
+	d[36] = t407
+	d[37] = t419
+	d[38] = t431
+	d[39] = t443
+	d[40] = t455
+	d[41] = t467
+	d[42] = t479
+	d[43] = t491
+	d[44] = t503
+	d[45] = t336
+	d[46] = t348
+	d[47] = t360
+	d[48] = t372
+	d[49] = t384
+	d[50] = t396
+	d[51] = t408
+	d[52] = t420
+	d[53] = t432
+	d[54] = t444
+	d[55] = t456
+	d[56] = t468
+	d[57] = t480
+	d[58] = t492
+	d[59] = t504
+
+	return true
+}
+
+// inverseDIT60GenComplex64 computes a 60-point inverse (scaled by 1/n) FFT for complex64 data as
+// straight-line code (radices 4x3x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT60GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 60
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+
+	t0 := x12 + x48
+	t1 := x12 - x48
+	t2 := x24 + x36
+	t3 := x24 - x36
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t8 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t12 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t13 := x16 + x52
+	t14 := x16 - x52
+	t15 := x28 + x40
+	t16 := x28 - x40
+	t17 := x4 + t13 + t15
+	t18 := complex(real(x4)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x4)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t21 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t22 := complex(real(x4)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x4)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t25 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t26 := x20 + x56
+	t27 := x20 - x56
+	t28 := x32 + x44
+	t29 := x32 - x44
+	t30 := x8 + t26 + t28
+	t31 := complex(real(x8)+0.30901699437494745*real(t26)-0.80901699437494745*real(t28), imag(x8)+0.30901699437494745*imag(t26)-0.80901699437494745*imag(t28))
+	t32 := complex(0.95105651629515353*real(t27)+0.58778525229247314*real(t29), 0.95105651629515353*imag(t27)+0.58778525229247314*imag(t29))
+	t33 := complex(real(t31)-imag(t32), imag(t31)+real(t32))
+	t34 := complex(real(t31)+imag(t32), imag(t31)-real(t32))
+	t35 := complex(real(x8)-0.80901699437494745*real(t26)+0.30901699437494745*real(t28), imag(x8)-0.80901699437494745*imag(t26)+0.30901699437494745*imag(t28))
+	t36 := complex(0.58778525229247314*real(t27)-0.95105651629515353*real(t29), 0.58778525229247314*imag(t27)-0.95105651629515353*imag(t29))
+	t37 := complex(real(t35)-imag(t36), imag(t35)+real(t36))
+	t38 := complex(real(t35)+imag(t36), imag(t35)-real(t36))
+	t39 := t17 + t30
+	t40 := t17 - t30
+	t41 := t4 + t39
+	t42 := complex(real(t4)-0.5*real(t39), imag(t4)-0.5*imag(t39))
+	t43 := complex(0.8660254037844386*real(t40), 0.8660254037844386*imag(t40))
+	t44 := complex(real(t42)-imag(t43), imag(t42)+real(t43))
+	t45 := complex(real(t42)+imag(t43), imag(t42)-real(t43))
+	t46 := t20 * complex(0.91354545764260087, 0.40673664307580021)
+	t47 := t33 * complex(0.66913060635885824, 0.74314482547739424)
+	t48 := t46 + t47
+	t49 := t46 - t47
+	t50 := t7 + t48
+	t51 := complex(real(t7)-0.5*real(t48), imag(t7)-0.5*imag(t48))
+	t52 := complex(0.8660254037844386*real(t49), 0.8660254037844386*imag(t49))
+	t53 := complex(real(t51)-imag(t52), imag(t51)+real(t52))
+	t54 := complex(real(t51)+imag(t52), imag(t51)-real(t52))
+	t55 := t24 * complex(0.66913060635885824, 0.74314482547739424)
+	t56 := t37 * complex(-0.10452846326765347, 0.99452189536827329)
+	t57 := t55 + t56
+	t58 := t55 - t56
+	t59 := t11 + t57
+	t60 := complex(real(t11)-0.5*real(t57), imag(t11)-0.5*imag(t57))
+	t61 := complex(0.8660254037844386*real(t58), 0.8660254037844386*imag(t58))
+	t62 := complex(real(t60)-imag(t61), imag(t60)+real(t61))
+	t63 := complex(real(t60)+imag(t61), imag(t60)-real(t61))
+	t64 := t25 * complex(0.30901699437494745, 0.95105651629515353)
+	t65 := t38 * complex(-0.80901699437494745, 0.58778525229247314)
+	t66 := t64 + t65
+	t67 := t64 - t65
+	t68 := t12 + t66
+	t69 := complex(real(t12)-0.5*real(t66), imag(t12)-0.5*imag(t66))
+	t70 := complex(0.8660254037844386*real(t67), 0.8660254037844386*imag(t67))
+	t71 := complex(real(t69)-imag(t70), imag(t69)+real(t70))
+	t72 := complex(real(t69)+imag(t70), imag(t69)-real(t70))
+	t73 := t21 * complex(-0.10452846326765347, 0.99452189536827329)
+	t74 := t34 * complex(-0.97814760073380569, -0.20791169081775934)
+	t75 := t73 + t74
+	t76 := t73 - t74
+	t77 := t8 + t75
+	t78 := complex(real(t8)-0.5*real(t75), imag(t8)-0.5*imag(t75))
+	t79 := complex(0.8660254037844386*real(t76), 0.8660254037844386*imag(t76))
+	t80 := complex(real(t78)-imag(t79), imag(t78)+real(t79))
+	t81 := complex(real(t78)+imag(t79), imag(t78)-real(t79))
+	t82 := x13 + x49
+	t83 := x13 - x49
+	t84 := x25 + x37
+	t85 := x25 - x37
+	t86 := x1 + t82 + t84
+	t87 := complex(real(x1)+0.30901699437494745*real(t82)-0.80901699437494745*real(t84), imag(x1)+0.30901699437494745*imag(t82)-0.80901699437494745*imag(t84))
+	t88 := complex(0.95105651629515353*real(t83)+0.58778525229247314*real(t85), 0.95105651629515353*imag(t83)+0.58778525229247314*imag(t85))
+	t89 := complex(real(t87)-imag(t88), imag(t87)+real(t88))
+	t90 := complex(real(t87)+imag(t88), imag(t87)-real(t88))
+	t91 := complex(real(x1)-0.80901699437494745*real(t82)+0.30901699437494745*real(t84), imag(x1)-0.80901699437494745*imag(t82)+0.30901699437494745*imag(t84))
+	t92 := complex(0.58778525229247314*real(t83)-0.95105651629515353*real(t85), 0.58778525229247314*imag(t83)-0.95105651629515353*imag(t85))
+	t93 := complex(real(t91)-imag(t92), imag(t91)+real(t92))
+	t94 := complex(real(t91)+imag(t92), imag(t91)-real(t92))
+	t95 := x17 + x53
+	t96 := x17 - x53
+	t97 := x29 + x41
+	t98 := x29 - x41
+	t99 := x5 + t95 + t97
+	t100 := complex(real(x5)+0.30901699437494745*real(t95)-0.80901699437494745*real(t97), imag(x5)+0.30901699437494745*imag(t95)-0.80901699437494745*imag(t97))
+	t101 := complex(0.95105651629515353*real(t96)+0.58778525229247314*real(t98), 0.95105651629515353*imag(t96)+0.58778525229247314*imag(t98))
+	t102 := complex(real(t100)-imag(t101), imag(t100)+real(t101))
+	t103 := complex(real(t100)+imag(t101), imag(t100)-real(t101))
+	t104 := complex(real(x5)-0.80901699437494745*real(t95)+0.30901699437494745*real(t97), imag(x5)-0.80901699437494745*imag(t95)+0.30901699437494745*imag(t97))
+	t105 := complex(0.58778525229247314*real(t96)-0.95105651629515353*real(t98), 0.58778525229247314*imag(t96)-0.95105651629515353*imag(t98))
+	t106 := complex(real(t104)-imag(t105), imag(t104)+real(t105))
+	t107 := complex(real(t104)+imag(t105), imag(t104)-real(t105))
+	t108 := x21 + x57
+	t109 := x21 - x57
+	t110 := x33 + x45
+	t111 := x33 - x45
+	t112 := x9 + t108 + t110
+	t113 := complex(real(x9)+0.30901699437494745*real(t108)-0.80901699437494745*real(t110), imag(x9)+0.30901699437494745*imag(t108)-0.80901699437494745*imag(t110))
+	t114 := complex(0.95105651629515353*real(t109)+0.58778525229247314*real(t111), 0.95105651629515353*imag(t109)+0.58778525229247314*imag(t111))
+	t115 := complex(real(t113)-imag(t114), imag(t113)+real(t114))
+	t116 := complex(real(t113)+imag(t114), imag(t113)-real(t114))
+	t117 := complex(real(x9)-0.80901699437494745*real(t108)+0.30901699437494745*real(t110), imag(x9)-0.80901699437494745*imag(t108)+0.30901699437494745*imag(t110))
+	t118 := complex(0.58778525229247314*real(t109)-0.95105651629515353*real(t111), 0.58778525229247314*imag(t109)-0.95105651629515353*imag(t111))
+	t119 := complex(real(t117)-imag(t118), imag(t117)+real(t118))
+	t120 := complex(real(t117)+imag(t118), imag(t117)-real(t118))
+	t121 := t99 + t112
+	t122 := t99 - t112
+	t123 := t86 + t121
+	t124 := complex(real(t86)-0.5*real(t121), imag(t86)-0.5*imag(t121))
+	t125 := complex(0.8660254037844386*real(t122), 0.8660254037844386*imag(t122))
+	t126 := complex(real(t124)-imag(t125), imag(t124)+real(t125))
+	t127 := complex(real(t124)+imag(t125), imag(t124)-real(t125))
+	t128 := t102 * complex(0.91354545764260087, 0.40673664307580021)
+	t129 := t115 * complex(0.66913060635885824, 0.74314482547739424)
+	t130 := t128 + t129
+	t131 := t128 - t129
+	t132 := t89 + t130
+	t133 := complex(real(t89)-0.5*real(t130), imag(t89)-0.5*imag(t130))
+	t134 := complex(0.8660254037844386*real(t131), 0.8660254037844386*imag(t131))
+	t135 := complex(real(t133)-imag(t134), imag(t133)+real(t134))
+	t136 := complex(real(t133)+imag(t134), imag(t133)-real(t134))
+	t137 := t106 * complex(0.66913060635885824, 0.74314482547739424)
+	t138 := t119 * complex(-0.10452846326765347, 0.99452189536827329)
+	t139 := t137 + t138
+	t140 := t137 - t138
+	t141 := t93 + t139
+	t142 := complex(real(t93)-0.5*real(t139), imag(t93)-0.5*imag(t139))
+	t143 := complex(0.8660254037844386*real(t140), 0.8660254037844386*imag(t140))
+	t144 := complex(real(t142)-imag(t143), imag(t142)+real(t143))
+	t145 := complex(real(t142)+imag(t143), imag(t142)-real(t143))
+	t146 := t107 * complex(0.30901699437494745, 0.95105651629515353)
+	t147 := t120 * complex(-0.80901699437494745, 0.58778525229247314)
+	t148 := t146 + t147
+	t149 := t146 - t147
+	t150 := t94 + t148
+	t151 := complex(real(t94)-0.5*real(t148), imag(t94)-0.5*imag(t148))
+	t152 := complex(0.8660254037844386*real(t149), 0.8660254037844386*imag(t149))
+	t153 := complex(real(t151)-imag(t152), imag(t151)+real(t152))
+	t154 := complex(real(t151)+imag(t152), imag(t151)-real(t152))
+	t155 := t103 * complex(-0.10452846326765347, 0.99452189536827329)
+	t156 := t116 * complex(-0.97814760073380569, -0.20791169081775934)
+	t157 := t155 + t156
+	t158 := t155 - t156
+	t159 := t90 + t157
+	t160 := complex(real(t90)-0.5*real(t157), imag(t90)-0.5*imag(t157))
+	t161 := complex(0.8660254037844386*real(t158), 0.8660254037844386*imag(t158))
+	t162 := complex(real(t160)-imag(t161), imag(t160)+real(t161))
+	t163 := complex(real(t160)+imag(t161), imag(t160)-real(t161))
+	t164 := x14 + x50
+	t165 := x14 - x50
+	t166 := x26 + x38
+	t167 := x26 - x38
+	t168 := x2 + t164 + t166
+	t169 := complex(real(x2)+0.30901699437494745*real(t164)-0.80901699437494745*real(t166), imag(x2)+0.30901699437494745*imag(t164)-0.80901699437494745*imag(t166))
+	t170 := complex(0.95105651629515353*real(t165)+0.58778525229247314*real(t167), 0.95105651629515353*imag(t165)+0.58778525229247314*imag(t167))
+	t171 := complex(real(t169)-imag(t170), imag(t169)+real(t170))
+	t172 := complex(real(t169)+imag(t170), imag(t169)-real(t170))
+	t173 := complex(real(x2)-0.80901699437494745*real(t164)+0.30901699437494745*real(t166), imag(x2)-0.80901699437494745*imag(t164)+0.30901699437494745*imag(t166))
+	t174 := complex(0.58778525229247314*real(t165)-0.95105651629515353*real(t167), 0.58778525229247314*imag(t165)-0.95105651629515353*imag(t167))
+	t175 := complex(real(t173)-imag(t174), imag(t173)+real(t174))
+	t176 := complex(real(t173)+imag(t174), imag(t173)-real(t174))
+	t177 := x18 + x54
+	t178 := x18 - x54
+	t179 := x30 + x42
+	t180 := x30 - x42
+	t181 := x6 + t177 + t179
+	t182 := complex(real(x6)+0.30901699437494745*real(t177)-0.80901699437494745*real(t179), imag(x6)+0.30901699437494745*imag(t177)-0.80901699437494745*imag(t179))
+	t183 := complex(0.95105651629515353*real(t178)+0.58778525229247314*real(t180), 0.95105651629515353*imag(t178)+0.58778525229247314*imag(t180))
+	t184 := complex(real(t182)-imag(t183), imag(t182)+real(t183))
+	t185 := complex(real(t182)+imag(t183), imag(t182)-real(t183))
+	t186 := complex(real(x6)-0.80901699437494745*real(t177)+0.30901699437494745*real(t179), imag(x6)-0.80901699437494745*imag(t177)+0.30901699437494745*imag(t179))
+	t187 := complex(0.58778525229247314*real(t178)-0.95105651629515353*real(t180), 0.58778525229247314*imag(t178)-0.95105651629515353*imag(t180))
+	t188 := complex(real(t186)-imag(t187), imag(t186)+real(t187))
+	t189 := complex(real(t186)+imag(t187), imag(t186)-real(t187))
+	t190 := x22 + x58
+	t191 := x22 - x58
+	t192 := x34 + x46
+	t193 := x34 - x46
+	t194 := x10 + t190 + t192
+	t195 := complex(real(x10)+0.30901699437494745*real(t190)-0.80901699437494745*real(t192), imag(x10)+0.30901699437494745*imag(t190)-0.80901699437494745*imag(t192))
+	t196 := complex(0.95105651629515353*real(t191)+0.58778525229247314*real(t193), 0.95105651629515353*imag(t191)+0.58778525229247314*imag(t193))
+	t197 := complex(real(t195)-imag(t196), imag(t195)+real(t196))
+	t198 := complex(real(t195)+imag(t196), imag(t195)-real(t196))
+	t199 := complex(real(x10)-0.80901699437494745*real(t190)+0.30901699437494745*real(t192), imag(x10)-0.80901699437494745*imag(t190)+0.30901699437494745*imag(t192))
+	t200 := complex(0.58778525229247314*real(t191)-0.95105651629515353*real(t193), 0.58778525229247314*imag(t191)-0.95105651629515353*imag(t193))
+	t201 := complex(real(t199)-imag(t200), imag(t199)+real(t200))
+	t202 := complex(real(t199)+imag(t200), imag(t199)-real(t200))
+	t203 := t181 + t194
+	t204 := t181 - t194
+	t205 := t168 + t203
+	t206 := complex(real(t168)-0.5*real(t203), imag(t168)-0.5*imag(t203))
+	t207 := complex(0.8660254037844386*real(t204), 0.8660254037844386*imag(t204))
+	t208 := complex(real(t206)-imag(t207), imag(t206)+real(t207))
+	t209 := complex(real(t206)+imag(t207), imag(t206)-real(t207))
+	t210 := t184 * complex(0.91354545764260087, 0.40673664307580021)
+	t211 := t197 * complex(0.66913060635885824, 0.74314482547739424)
+	t212 := t210 + t211
+	t213 := t210 - t211
+	t214 := t171 + t212
+	t215 := complex(real(t171)-0.5*real(t212), imag(t171)-0.5*imag(t212))
+	t216 := complex(0.8660254037844386*real(t213), 0.8660254037844386*imag(t213))
+	t217 := complex(real(t215)-imag(t216), imag(t215)+real(t216))
+	t218 := complex(real(t215)+imag(t216), imag(t215)-real(t216))
+	t219 := t188 * complex(0.66913060635885824, 0.74314482547739424)
+	t220 := t201 * complex(-0.10452846326765347, 0.99452189536827329)
+	t221 := t219 + t220
+	t222 := t219 - t220
+	t223 := t175 + t221
+	t224 := complex(real(t175)-0.5*real(t221), imag(t175)-0.5*imag(t221))
+	t225 := complex(0.8660254037844386*real(t222), 0.8660254037844386*imag(t222))
+	t226 := complex(real(t224)-imag(t225), imag(t224)+real(t225))
+	t227 := complex(real(t224)+imag(t225), imag(t224)-real(t225))
+	t228 := t189 * complex(0.30901699437494745, 0.95105651629515353)
+	t229 := t202 * complex(-0.80901699437494745, 0.58778525229247314)
+	t230 := t228 + t229
+	t231 := t228 - t229
+	t232 := t176 + t230
+	t233 := complex(real(t176)-0.5*real(t230), imag(t176)-0.5*imag(t230))
+	t234 := complex(0.8660254037844386*real(t231), 0.8660254037844386*imag(t231))
+	t235 := complex(real(t233)-imag(t234), imag(t233)+real(t234))
+	t236 := complex(real(t233)+imag(t234), imag(t233)-real(t234))
+	t237 := t185 * complex(-0.10452846326765347, 0.99452189536827329)
+	t238 := t198 * complex(-0.97814760073380569, -0.20791169081775934)
+	t239 := t237 + t238
+	t240 := t237 - t238
+	t241 := t172 + t239
+	t242 := complex(real(t172)-0.5*real(t239), imag(t172)-0.5*imag(t239))
+	t243 := complex(0.8660254037844386*real(t240), 0.8660254037844386*imag(t240))
+	t244 := complex(real(t242)-imag(t243), imag(t242)+real(t243))
+	t245 := complex(real(t242)+imag(t243), imag(t242)-real(t243))
+	t246 := x15 + x51
+	t247 := x15 - x51
+	t248 := x27 + x39
+	t249 := x27 - x39
+	t250 := x3 + t246 + t248
+	t251 := complex(real(x3)+0.30901699437494745*real(t246)-0.80901699437494745*real(t248), imag(x3)+0.30901699437494745*imag(t246)-0.80901699437494745*imag(t248))
+	t252 := complex(0.95105651629515353*real(t247)+0.58778525229247314*real(t249), 0.95105651629515353*imag(t247)+0.58778525229247314*imag(t249))
+	t253 := complex(real(t251)-imag(t252), imag(t251)+real(t252))
+	t254 := complex(real(t251)+imag(t252), imag(t251)-real(t252))
+	t255 := complex(real(x3)-0.80901699437494745*real(t246)+0.30901699437494745*real(t248), imag(x3)-0.80901699437494745*imag(t246)+0.30901699437494745*imag(t248))
+	t256 := complex(0.58778525229247314*real(t247)-0.95105651629515353*real(t249), 0.58778525229247314*imag(t247)-0.95105651629515353*imag(t249))
+	t257 := complex(real(t255)-imag(t256), imag(t255)+real(t256))
+	t258 := complex(real(t255)+imag(t256), imag(t255)-real(t256))
+	t259 := x19 + x55
+	t260 := x19 - x55
+	t261 := x31 + x43
+	t262 := x31 - x43
+	t263 := x7 + t259 + t261
+	t264 := complex(real(x7)+0.30901699437494745*real(t259)-0.80901699437494745*real(t261), imag(x7)+0.30901699437494745*imag(t259)-0.80901699437494745*imag(t261))
+	t265 := complex(0.95105651629515353*real(t260)+0.58778525229247314*real(t262), 0.95105651629515353*imag(t260)+0.58778525229247314*imag(t262))
+	t266 := complex(real(t264)-imag(t265), imag(t264)+real(t265))
+	t267 := complex(real(t264)+imag(t265), imag(t264)-real(t265))
+	t268 := complex(real(x7)-0.80901699437494745*real(t259)+0.30901699437494745*real(t261), imag(x7)-0.80901699437494745*imag(t259)+0.30901699437494745*imag(t261))
+	t269 := complex(0.58778525229247314*real(t260)-0.95105651629515353*real(t262), 0.58778525229247314*imag(t260)-0.95105651629515353*imag(t262))
+	t270 := complex(real(t268)-imag(t269), imag(t268)+real(t269))
+	t271 := complex(real(t268)+imag(t269), imag(t268)-real(t269))
+	t272 := x23 + x59
+	t273 := x23 - x59
+	t274 := x35 + x47
+	t275 := x35 - x47
+	t276 := x11 + t272 + t274
+	t277 := complex(real(x11)+0.30901699437494745*real(t272)-0.80901699437494745*real(t274), imag(x11)+0.30901699437494745*imag(t272)-0.80901699437494745*imag(t274))
+	t278 := complex(0.95105651629515353*real(t273)+0.58778525229247314*real(t275), 0.95105651629515353*imag(t273)+0.58778525229247314*imag(t275))
+	t279 := complex(real(t277)-imag(t278), imag(t277)+real(t278))
+	t280 := complex(real(t277)+imag(t278), imag(t277)-real(t278))
+	t281 := complex(real(x11)-0.80901699437494745*real(t272)+0.30901699437494745*real(t274), imag(x11)-0.80901699437494745*imag(t272)+0.30901699437494745*imag(t274))
+	t282 := complex(0.58778525229247314*real(t273)-0.95105651629515353*real(t275), 0.58778525229247314*imag(t273)-0.95105651629515353*imag(t275))
+	t283 := complex(real(t281)-imag(t282), imag(t281)+real(t282))
+	t284 := complex(real(t281)+imag(t282), imag(t281)-real(t282))
+	t285 := t263 + t276
+	t286 := t263 - t276
+	t287 := t250 + t285
+	t288 := complex(real(t250)-0.5*real(t285), imag(t250)-0.5*imag(t285))
+	t289 := complex(0.8660254037844386*real(t286), 0.8660254037844386*imag(t286))
+	t290 := complex(real(t288)-imag(t289), imag(t288)+real(t289))
+	t291 := complex(real(t288)+imag(t289), imag(t288)-real(t289))
+	t292 := t266 * complex(0.91354545764260087, 0.40673664307580021)
+	t293 := t279 * complex(0.66913060635885824, 0.74314482547739424)
+	t294 := t292 + t293
+	t295 := t292 - t293
+	t296 := t253 + t294
+	t297 := complex(real(t253)-0.5*real(t294), imag(t253)-0.5*imag(t294))
+	t298 := complex(0.8660254037844386*real(t295), 0.8660254037844386*imag(t295))
+	t299 := complex(real(t297)-imag(t298), imag(t297)+real(t298))
+	t300 := complex(real(t297)+imag(t298), imag(t297)-real(t298))
+	t301 := t270 * complex(0.66913060635885824, 0.74314482547739424)
+	t302 := t283 * complex(-0.10452846326765347, 0.99452189536827329)
+	t303 := t301 + t302
+	t304 := t301 - t302
+	t305 := t257 + t303
+	t306 := complex(real(t257)-0.5*real(t303), imag(t257)-0.5*imag(t303))
+	t307 := complex(0.8660254037844386*real(t304), 0.8660254037844386*imag(t304))
+	t308 := complex(real(t306)-imag(t307), imag(t306)+real(t307))
+	t309 := complex(real(t306)+imag(t307), imag(t306)-real(t307))
+	t310 := t271 * complex(0.30901699437494745, 0.95105651629515353)
+	t311 := t284 * complex(-0.80901699437494745, 0.58778525229247314)
+	t312 := t310 + t311
+	t313 := t310 - t311
+	t314 := t258 + t312
+	t315 := complex(real(t258)-0.5*real(t312), imag(t258)-0.5*imag(t312))
+	t316 := complex(0.8660254037844386*real(t313), 0.8660254037844386*imag(t313))
+	t317 := complex(real(t315)-imag(t316), imag(t315)+real(t316))
+	t318 := complex(real(t315)+imag(t316), imag(t315)-real(t316))
+	t319 := t267 * complex(-0.10452846326765347, 0.99452189536827329)
+	t320 := t280 * complex(-0.97814760073380569, -0.20791169081775934)
+	t321 := t319 + t320
+	t322 := t319 - t320
+	t323 := t254 + t321
+	t324 := complex(real(t254)-0.5*real(t321), imag(t254)-0.5*imag(t321))
+	t325 := complex(0.8660254037844386*real(t322), 0.8660254037844386*imag(t322))
+	t326 := complex(real(t324)-imag(t325), imag(t324)+real(t325))
+	t327 := complex(real(t324)+imag(t325), imag(t324)-real(t325))
+	t328 := t41 + t205
+	t329 := t41 - t205
+	t330 := t123 + t287
+	t331 := t123 - t287
+	t332 := complex(-imag(t331), real(t331))
+	t333 := t328 + t330
+	t334 := t329 + t332
+	t335 := t328 - t330
+	t336 := t329 - t332
+	t337 := t132 * complex(0.99452189536827329, 0.10452846326765347)
+	t338 := t214 * complex(0.97814760073380569, 0.20791169081775934)
+	t339 := t296 * complex(0.95105651629515353, 0.30901699437494745)
+	t340 := t50 + t338
+	t341 := t50 - t338
+	t342 := t337 + t339
+	t343 := t337 - t339
+	t344 := complex(-imag(t343), real(t343))
+	t345 := t340 + t342
+	t346 := t341 + t344
+	t347 := t340 - t342
+	t348 := t341 - t344
+	t349 := t141 * complex(0.97814760073380569, 0.20791169081775934)
+	t350 := t223 * complex(0.91354545764260087, 0.40673664307580021)
+	t351 := t305 * complex(0.80901699437494745, 0.58778525229247314)
+	t352 := t59 + t350
+	t353 := t59 - t350
+	t354 := t349 + t351
+	t355 := t349 - t351
+	t356 := complex(-imag(t355), real(t355))
+	t357 := t352 + t354
+	t358 := t353 + t356
+	t359 := t352 - t354
+	t360 := t353 - t356
+	t361 := t150 * complex(0.95105651629515353, 0.30901699437494745)
+	t362 := t232 * complex(0.80901699437494745, 0.58778525229247314)
+	t363 := t314 * complex(0.58778525229247314, 0.80901699437494745)
+	t364 := t68 + t362
+	t365 := t68 - t362
+	t366 := t361 + t363
+	t367 := t361 - t363
+	t368 := complex(-imag(t367), real(t367))
+	t369 := t364 + t366
+	t370 := t365 + t368
+	t371 := t364 - t366
+	t372 := t365 - t368
+	t373 := t159 * complex(0.91354545764260087, 0.40673664307580021)
+	t374 := t241 * complex(0.66913060635885824, 0.74314482547739424)
+	t375 := t323 * complex(0.30901699437494745, 0.95105651629515353)
+	t376 := t77 + t374
+	t377 := t77 - t374
+	t378 := t373 + t375
+	t379 := t373 - t375
+	t380 := complex(-imag(t379), real(t379))
+	t381 := t376 + t378
+	t382 := t377 + t380
+	t383 := t376 - t378
+	t384 := t377 - t380
+	t385 := t126 * complex(0.8660254037844386, 0.5)
+	t386 := t208 * complex(0.5, 0.8660254037844386)
+	t387 := complex(-imag(t290), real(t290))
+	t388 := t44 + t386
+	t389 := t44 - t386
+	t390 := t385 + t387
+	t391 := t385 - t387
+	t392 := complex(-imag(t391), real(t391))
+	t393 := t388 + t390
+	t394 := t389 + t392
+	t395 := t388 - t390
+	t396 := t389 - t392
+	t397 := t135 * complex(0.80901699437494745, 0.58778525229247314)
+	t398 := t217 * complex(0.30901699437494745, 0.95105651629515353)
+	t399 := t299 * complex(-0.30901699437494745, 0.95105651629515353)
+	t400 := t53 + t398
+	t401 := t53 - t398
+	t402 := t397 + t399
+	t403 := t397 - t399
+	t404 := complex(-imag(t403), real(t403))
+	t405 := t400 + t402
+	t406 := t401 + t404
+	t407 := t400 - t402
+	t408 := t401 - t404
+	t409 := t144 * complex(0.74314482547739424, 0.66913060635885824)
+	t410 := t226 * complex(0.10452846326765347, 0.99452189536827329)
+	t411 := t308 * complex(-0.58778525229247314, 0.80901699437494745)
+	t412 := t62 + t410
+	t413 := t62 - t410
+	t414 := t409 + t411
+	t415 := t409 - t411
+	t416 := complex(-imag(t415), real(t415))
+	t417 := t412 + t414
+	t418 := t413 + t416
+	t419 := t412 - t414
+	t420 := t413 - t416
+	t421 := t153 * complex(0.66913060635885824, 0.74314482547739424)
+	t422 := t235 * complex(-0.10452846326765347, 0.99452189536827329)
+	t423 := t317 * complex(-0.80901699437494745, 0.58778525229247314)
+	t424 := t71 + t422
+	t425 := t71 - t422
+	t426 := t421 + t423
+	t427 := t421 - t423
+	t428 := complex(-imag(t427), real(t427))
+	t429 := t424 + t426
+	t430 := t425 + t428
+	t431 := t424 - t426
+	t432 := t425 - t428
+	t433 := t162 * complex(0.58778525229247314, 0.80901699437494745)
+	t434 := t244 * complex(-0.30901699437494745, 0.95105651629515353)
+	t435 := t326 * complex(-0.95105651629515353, 0.30901699437494745)
+	t436 := t80 + t434
+	t437 := t80 - t434
+	t438 := t433 + t435
+	t439 := t433 - t435
+	t440 := complex(-imag(t439), real(t439))
+	t441 := t436 + t438
+	t442 := t437 + t440
+	t443 := t436 - t438
+	t444 := t437 - t440
+	t445 := t127 * complex(0.5, 0.8660254037844386)
+	t446 := t209 * complex(-0.5, 0.8660254037844386)
+	t447 := -t291
+	t448 := t45 + t446
+	t449 := t45 - t446
+	t450 := t445 + t447
+	t451 := t445 - t447
+	t452 := complex(-imag(t451), real(t451))
+	t453 := t448 + t450
+	t454 := t449 + t452
+	t455 := t448 - t450
+	t456 := t449 - t452
+	t457 := t136 * complex(0.40673664307580021, 0.91354545764260087)
+	t458 := t218 * complex(-0.66913060635885824, 0.74314482547739424)
+	t459 := t300 * complex(-0.95105651629515353, -0.30901699437494745)
+	t460 := t54 + t458
+	t461 := t54 - t458
+	t462 := t457 + t459
+	t463 := t457 - t459
+	t464 := complex(-imag(t463), real(t463))
+	t465 := t460 + t462
+	t466 := t461 + t464
+	t467 := t460 - t462
+	t468 := t461 - t464
+	t469 := t145 * complex(0.30901699437494745, 0.95105651629515353)
+	t470 := t227 * complex(-0.80901699437494745, 0.58778525229247314)
+	t471 := t309 * complex(-0.80901699437494745, -0.58778525229247314)
+	t472 := t63 + t470
+	t473 := t63 - t470
+	t474 := t469 + t471
+	t475 := t469 - t471
+	t476 := complex(-imag(t475), real(t475))
+	t477 := t472 + t474
+	t478 := t473 + t476
+	t479 := t472 - t474
+	t480 := t473 - t476
+	t481 := t154 * complex(0.20791169081775934, 0.97814760073380569)
+	t482 := t236 * complex(-0.91354545764260087, 0.40673664307580021)
+	t483 := t318 * complex(-0.58778525229247314, -0.80901699437494745)
+	t484 := t72 + t482
+	t485 := t72 - t482
+	t486 := t481 + t483
+	t487 := t481 - t483
+	t488 := complex(-imag(t487), real(t487))
+	t489 := t484 + t486
+	t490 := t485 + t488
+	t491 := t484 - t486
+	t492 := t485 - t488
+	t493 := t163 * complex(0.10452846326765347, 0.99452189536827329)
+	t494 := t245 * complex(-0.97814760073380569, 0.20791169081775934)
+	t495 := t327 * complex(-0.30901699437494745, -0.95105651629515353)
+	t496 := t81 + t494
+	t497 := t81 - t494
+	t498 := t493 + t495
+	t499 := t493 - t495
+	t500 := complex(-imag(t499), real(t499))
+	t501 := t496 + t498
+	t502 := t497 + t500
+	t503 := t496 - t498
+	t504 := t497 - t500
+
+	const scale = 1.0 / 60
+
+	d[0] = complex(real(t333)*scale, imag(t333)*scale)
+	d[1] = complex(real(t345)*scale, imag(t345)*scale)
+	d[2] = complex(real(t357)*scale, imag(t357)*scale)
+	d[3] = complex(real(t369)*scale, imag(t369)*scale)
+	d[4] = complex(real(t381)*scale, imag(t381)*scale)
+	d[5] = complex(real(t393)*scale, imag(t393)*scale)
+	d[6] = complex(real(t405)*scale, imag(t405)*scale)
+	d[7] = complex(real(t417)*scale, imag(t417)*scale)
+	d[8] = complex(real(t429)*scale, imag(t429)*scale)
+	d[9] = complex(real(t441)*scale, imag(t441)*scale)
+	d[10] = complex(real(t453)*scale, imag(t453)*scale)
+	d[11] = complex(real(t465)*scale, imag(t465)*scale)
+	d[12] = complex(real(t477)*scale, imag(t477)*scale)
+	d[13] = complex(real(t489)*scale, imag(t489)*scale)
+	d[14] = complex(real(t501)*scale, imag(t501)*scale)
+	d[15] = complex(real(t334)*scale, imag(t334)*scale)
+	d[16] = complex(real(t346)*scale, imag(t346)*scale)
+	d[17] = complex(real(t358)*scale, imag(t358)*scale)
+	d[18] = complex(real(t370)*scale, imag(t370)*scale)
+	d[19] = complex(real(t382)*scale, imag(t382)*scale)
+	d[20] = complex(real(t394)*scale, imag(t394)*scale)
+	d[21] = complex(real(t406)*scale, imag(t406)*scale)
+	d[22] = complex(real(t418)*scale, imag(t418)*scale)
+	d[23] = complex(real(t430)*scale, imag(t430)*scale)
+	d[24] = complex(real(t442)*scale, imag(t442)*scale)
+	d[25] = complex(real(t454)*scale, imag(t454)*scale)
+	d[26] = complex(real(t466)*scale, imag(t466)*scale)
+	d[27] = complex(real(t478)*scale, imag(t478)*scale)
+	d[28] = complex(real(t490)*scale, imag(t490)*scale)
+	d[29] = complex(real(t502)*scale, imag(t502)*scale)
+	d[30] = complex(real(t335)*scale, imag(t335)*scale)
+	d[31] = complex(real(t347)*scale, imag(t347)*scale)
+	d[32] = complex(real(t359)*scale, imag(t359)*scale)
+	d[33] = complex(real(t371)*scale, imag(t371)*scale)
+	d[34] = complex(real(t383)*scale, imag(t383)*scale)
+	d[35] = complex(real(t395)*scale, imag(t395)*scale)
+	d[36] = complex(real(t407)*scale, imag(t407)*scale)
+	d[37] = complex(real(t419)*scale, imag(t419)*scale)
+	d[38] = complex(real(t431)*scale, imag(t431)*scale)
+	d[39] = complex(real(t443)*scale, imag(t443)*scale)
+	d[40] = complex(real(t455)*scale, imag(t455)*scale)
+	d[41] = complex(real(t467)*scale, imag(t467)*scale)
+	d[42] = complex(real(t479)*scale, imag(t479)*scale)
+	d[43] = complex(real(t491)*scale, imag(t491)*scale)
+	d[44] = complex(real(t503)*scale, imag(t503)*scale)
+	d[45] = complex(real(t336)*scale, imag(t336)*scale)
+	d[46] = complex(real(t348)*scale, imag(t348)*scale)
+	d[47] = complex(real(t360)*scale, imag(t360)*scale)
+	d[48] = complex(real(t372)*scale, imag(t372)*scale)
+	d[49] = complex(real(t384)*scale, imag(t384)*scale)
+	d[50] = complex(real(t396)*scale, imag(t396)*scale)
+	d[51] = complex(real(t408)*scale, imag(t408)*scale)
+	d[52] = complex(real(t420)*scale, imag(t420)*scale)
+	d[53] = complex(real(t432)*scale, imag(t432)*scale)
+	d[54] = complex(real(t444)*scale, imag(t444)*scale)
+	d[55] = complex(real(t456)*scale, imag(t456)*scale)
+	d[56] = complex(real(t468)*scale, imag(t468)*scale)
+	d[57] = complex(real(t480)*scale, imag(t480)*scale)
+	d[58] = complex(real(t492)*scale, imag(t492)*scale)
+	d[59] = complex(real(t504)*scale, imag(t504)*scale)
+
+	return true
+}
+
+// forwardDIT60GenComplex128 computes a 60-point forward FFT for complex128 data as
+// straight-line code (radices 4x3x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT60GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 60
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+
+	t0 := x12 + x48
+	t1 := x12 - x48
+	t2 := x24 + x36
+	t3 := x24 - x36
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t8 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t12 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t13 := x16 + x52
+	t14 := x16 - x52
+	t15 := x28 + x40
+	t16 := x28 - x40
+	t17 := x4 + t13 + t15
+	t18 := complex(real(x4)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x4)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t21 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t22 := complex(real(x4)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x4)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t25 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t26 := x20 + x56
+	t27 := x20 - x56
+	t28 := x32 + x44
+	t29 := x32 - x44
+	t30 := x8 + t26 + t28
+	t31 := complex(real(x8)+0.30901699437494745*real(t26)-0.80901699437494745*real(t28), imag(x8)+0.30901699437494745*imag(t26)-0.80901699437494745*imag(t28))
+	t32 := complex(0.95105651629515353*real(t27)+0.58778525229247314*real(t29), 0.95105651629515353*imag(t27)+0.58778525229247314*imag(t29))
+	t33 := complex(real(t31)+imag(t32), imag(t31)-real(t32))
+	t34 := complex(real(t31)-imag(t32), imag(t31)+real(t32))
+	t35 := complex(real(x8)-0.80901699437494745*real(t26)+0.30901699437494745*real(t28), imag(x8)-0.80901699437494745*imag(t26)+0.30901699437494745*imag(t28))
+	t36 := complex(0.58778525229247314*real(t27)-0.95105651629515353*real(t29), 0.58778525229247314*imag(t27)-0.95105651629515353*imag(t29))
+	t37 := complex(real(t35)+imag(t36), imag(t35)-real(t36))
+	t38 := complex(real(t35)-imag(t36), imag(t35)+real(t36))
+	t39 := t17 + t30
+	t40 := t17 - t30
+	t41 := t4 + t39
+	t42 := complex(real(t4)-0.5*real(t39), imag(t4)-0.5*imag(t39))
+	t43 := complex(0.8660254037844386*real(t40), 0.8660254037844386*imag(t40))
+	t44 := complex(real(t42)+imag(t43), imag(t42)-real(t43))
+	t45 := complex(real(t42)-imag(t43), imag(t42)+real(t43))
+	t46 := t20 * complex(0.91354545764260087, -0.40673664307580021)
+	t47 := t33 * complex(0.66913060635885824, -0.74314482547739424)
+	t48 := t46 + t47
+	t49 := t46 - t47
+	t50 := t7 + t48
+	t51 := complex(real(t7)-0.5*real(t48), imag(t7)-0.5*imag(t48))
+	t52 := complex(0.8660254037844386*real(t49), 0.8660254037844386*imag(t49))
+	t53 := complex(real(t51)+imag(t52), imag(t51)-real(t52))
+	t54 := complex(real(t51)-imag(t52), imag(t51)+real(t52))
+	t55 := t24 * complex(0.66913060635885824, -0.74314482547739424)
+	t56 := t37 * complex(-0.10452846326765347, -0.99452189536827329)
+	t57 := t55 + t56
+	t58 := t55 - t56
+	t59 := t11 + t57
+	t60 := complex(real(t11)-0.5*real(t57), imag(t11)-0.5*imag(t57))
+	t61 := complex(0.8660254037844386*real(t58), 0.8660254037844386*imag(t58))
+	t62 := complex(real(t60)+imag(t61), imag(t60)-real(t61))
+	t63 := complex(real(t60)-imag(t61), imag(t60)+real(t61))
+	t64 := t25 * complex(0.30901699437494745, -0.95105651629515353)
+	t65 := t38 * complex(-0.80901699437494745, -0.58778525229247314)
+	t66 := t64 + t65
+	t67 := t64 - t65
+	t68 := t12 + t66
+	t69 := complex(real(t12)-0.5*real(t66), imag(t12)-0.5*imag(t66))
+	t70 := complex(0.8660254037844386*real(t67), 0.8660254037844386*imag(t67))
+	t71 := complex(real(t69)+imag(t70), imag(t69)-real(t70))
+	t72 := complex(real(t69)-imag(t70), imag(t69)+real(t70))
+	t73 := t21 * complex(-0.10452846326765347, -0.99452189536827329)
+	t74 := t34 * complex(-0.97814760073380569, 0.20791169081775934)
+	t75 := t73 + t74
+	t76 := t73 - t74
+	t77 := t8 + t75
+	t78 := complex(real(t8)-0.5*real(t75), imag(t8)-0.5*imag(t75))
+	t79 := complex(0.8660254037844386*real(t76), 0.8660254037844386*imag(t76))
+	t80 := complex(real(t78)+imag(t79), imag(t78)-real(t79))
+	t81 := complex(real(t78)-imag(t79), imag(t78)+real(t79))
+	t82 := x13 + x49
+	t83 := x13 - x49
+	t84 := x25 + x37
+	t85 := x25 - x37
+	t86 := x1 + t82 + t84
+	t87 := complex(real(x1)+0.30901699437494745*real(t82)-0.80901699437494745*real(t84), imag(x1)+0.30901699437494745*imag(t82)-0.80901699437494745*imag(t84))
+	t88 := complex(0.95105651629515353*real(t83)+0.58778525229247314*real(t85), 0.95105651629515353*imag(t83)+0.58778525229247314*imag(t85))
+	t89 := complex(real(t87)+imag(t88), imag(t87)-real(t88))
+	t90 := complex(real(t87)-imag(t88), imag(t87)+real(t88))
+	t91 := complex(real(x1)-0.80901699437494745*real(t82)+0.30901699437494745*real(t84), imag(x1)-0.80901699437494745*imag(t82)+0.30901699437494745*imag(t84))
+	t92 := complex(0.58778525229247314*real(t83)-0.95105651629515353*real(t85), 0.58778525229247314*imag(t83)-0.95105651629515353*imag(t85))
+	t93 := complex(real(t91)+imag(t92), imag(t91)-real(t92))
+	t94 := complex(real(t91)-imag(t92), imag(t91)+real(t92))
+	t95 := x17 + x53
+	t96 := x17 - x53
+	t97 := x29 + x41
+	t98 := x29 - x41
+	t99 := x5 + t95 + t97
+	t100 := complex(real(x5)+0.30901699437494745*real(t95)-0.80901699437494745*real(t97), imag(x5)+0.30901699437494745*imag(t95)-0.80901699437494745*imag(t97))
+	t101 := complex(0.95105651629515353*real(t96)+0.58778525229247314*real(t98), 0.95105651629515353*imag(t96)+0.58778525229247314*imag(t98))
+	t102 := complex(real(t100)+imag(t101), imag(t100)-real(t101))
+	t103 := complex(real(t100)-imag(t101), imag(t100)+real(t101))
+	t104 := complex(real(x5)-0.80901699437494745*real(t95)+0.30901699437494745*real(t97), imag(x5)-0.80901699437494745*imag(t95)+0.30901699437494745*imag(t97))
+	t105 := complex(0.58778525229247314*real(t96)-0.95105651629515353*real(t98), 0.58778525229247314*imag(t96)-0.95105651629515353*imag(t98))
+	t106 := complex(real(t104)+imag(t105), imag(t104)-real(t105))
+	t107 := complex(real(t104)-imag(t105), imag(t104)+real(t105))
+	t108 := x21 + x57
+	t109 := x21 - x57
+	t110 := x33 + x45
+	t111 := x33 - x45
+	t112 := x9 + t108 + t110
+	t113 := complex(real(x9)+0.30901699437494745*real(t108)-0.80901699437494745*real(t110), imag(x9)+0.30901699437494745*imag(t108)-0.80901699437494745*imag(t110))
+	t114 := complex(0.95105651629515353*real(t109)+0.58778525229247314*real(t111), 0.95105651629515353*imag(t109)+0.58778525229247314*imag(t111))
+	t115 := complex(real(t113)+imag(t114), imag(t113)-real(t114))
+	t116 := complex(real(t113)-imag(t114), imag(t113)+real(t114))
+	t117 := complex(real(x9)-0.80901699437494745*real(t108)+0.30901699437494745*real(t110), imag(x9)-0.80901699437494745*imag(t108)+0.30901699437494745*imag(t110))
+	t118 := complex(0.58778525229247314*real(t109)-0.95105651629515353*real(t111), 0.58778525229247314*imag(t109)-0.95105651629515353*imag(t111))
+	t119 := complex(real(t117)+imag(t118), imag(t117)-real(t118))
+	t120 := complex(real(t117)-imag(t118), imag(t117)+real(t118))
+	t121 := t99 + t112
+	t122 := t99 - t112
+	t123 := t86 + t121
+	t124 := complex(real(t86)-0.5*real(t121), imag(t86)-0.5*imag(t121))
+	t125 := complex(0.8660254037844386*real(t122), 0.8660254037844386*imag(t122))
+	t126 := complex(real(t124)+imag(t125), imag(t124)-real(t125))
+	t127 := complex(real(t124)-imag(t125), imag(t124)+real(t125))
+	t128 := t102 * complex(0.91354545764260087, -0.40673664307580021)
+	t129 := t115 * complex(0.66913060635885824, -0.74314482547739424)
+	t130 := t128 + t129
+	t131 := t128 - t129
+	t132 := t89 + t130
+	t133 := complex(real(t89)-0.5*real(t130), imag(t89)-0.5*imag(t130))
+	t134 := complex(0.8660254037844386*real(t131), 0.8660254037844386*imag(t131))
+	t135 := complex(real(t133)+imag(t134), imag(t133)-real(t134))
+	t136 := complex(real(t133)-imag(t134), imag(t133)+real(t134))
+	t137 := t106 * complex(0.66913060635885824, -0.74314482547739424)
+	t138 := t119 * complex(-0.10452846326765347, -0.99452189536827329)
+	t139 := t137 + t138
+	t140 := t137 - t138
+	t141 := t93 + t139
+	t142 := complex(real(t93)-0.5*real(t139), imag(t93)-0.5*imag(t139))
+	t143 := complex(0.8660254037844386*real(t140), 0.8660254037844386*imag(t140))
+	t144 := complex(real(t142)+imag(t143), imag(t142)-real(t143))
+	t145 := complex(real(t142)-imag(t143), imag(t142)+real(t143))
+	t146 := t107 * complex(0.30901699437494745, -0.95105651629515353)
+	t147 := t120 * complex(-0.80901699437494745, -0.58778525229247314)
+	t148 := t146 + t147
+	t149 := t146 - t147
+	t150 := t94 + t148
+	t151 := complex(real(t94)-0.5*real(t148), imag(t94)-0.5*imag(t148))
+	t152 := complex(0.8660254037844386*real(t149), 0.8660254037844386*imag(t149))
+	t153 := complex(real(t151)+imag(t152), imag(t151)-real(t152))
+	t154 := complex(real(t151)-imag(t152), imag(t151)+real(t152))
+	t155 := t103 * complex(-0.10452846326765347, -0.99452189536827329)
+	t156 := t116 * complex(-0.97814760073380569, 0.20791169081775934)
+	t157 := t155 + t156
+	t158 := t155 - t156
+	t159 := t90 + t157
+	t160 := complex(real(t90)-0.5*real(t157), imag(t90)-0.5*imag(t157))
+	t161 := complex(0.8660254037844386*real(t158), 0.8660254037844386*imag(t158))
+	t162 := complex(real(t160)+imag(t161), imag(t160)-real(t161))
+	t163 := complex(real(t160)-imag(t161), imag(t160)+real(t161))
+	t164 := x14 + x50
+	t165 := x14 - x50
+	t166 := x26 + x38
+	t167 := x26 - x38
+	t168 := x2 + t164 + t166
+	t169 := complex(real(x2)+0.30901699437494745*real(t164)-0.80901699437494745*real(t166), imag(x2)+0.30901699437494745*imag(t164)-0.80901699437494745*imag(t166))
+	t170 := complex(0.95105651629515353*real(t165)+0.58778525229247314*real(t167), 0.95105651629515353*imag(t165)+0.58778525229247314*imag(t167))
+	t171 := complex(real(t169)+imag(t170), imag(t169)-real(t170))
+	t172 := complex(real(t169)-imag(t170), imag(t169)+real(t170))
+	t173 := complex(real(x2)-0.80901699437494745*real(t164)+0.30901699437494745*real(t166), imag(x2)-0.80901699437494745*imag(t164)+0.30901699437494745*imag(t166))
+	t174 := complex(0.58778525229247314*real(t165)-0.95105651629515353*real(t167), 0.58778525229247314*imag(t165)-0.95105651629515353*imag(t167))
+	t175 := complex(real(t173)+imag(t174), imag(t173)-real(t174))
+	t176 := complex(real(t173)-imag(t174), imag(t173)+real(t174))
+	t177 := x18 + x54
+	t178 := x18 - x54
+	t179 := x30 + x42
+	t180 := x30 - x42
+	t181 := x6 + t177 + t179
+	t182 := complex(real(x6)+0.30901699437494745*real(t177)-0.80901699437494745*real(t179), imag(x6)+0.30901699437494745*imag(t177)-0.80901699437494745*imag(t179))
+	t183 := complex(0.95105651629515353*real(t178)+0.58778525229247314*real(t180), 0.95105651629515353*imag(t178)+0.58778525229247314*imag(t180))
+	t184 := complex(real(t182)+imag(t183), imag(t182)-real(t183))
+	t185 := complex(real(t182)-imag(t183), imag(t182)+real(t183))
+	t186 := complex(real(x6)-0.80901699437494745*real(t177)+0.30901699437494745*real(t179), imag(x6)-0.80901699437494745*imag(t177)+0.30901699437494745*imag(t179))
+	t187 := complex(0.58778525229247314*real(t178)-0.95105651629515353*real(t180), 0.58778525229247314*imag(t178)-0.95105651629515353*imag(t180))
+	t188 := complex(real(t186)+imag(t187), imag(t186)-real(t187))
+	t189 := complex(real(t186)-imag(t187), imag(t186)+real(t187))
+	t190 := x22 + x58
+	t191 := x22 - x58
+	t192 := x34 + x46
+	t193 := x34 - x46
+	t194 := x10 + t190 + t192
+	t195 := complex(real(x10)+0.30901699437494745*real(t190)-0.80901699437494745*real(t192), imag(x10)+0.30901699437494745*imag(t190)-0.80901699437494745*imag(t192))
+	t196 := complex(0.95105651629515353*real(t191)+0.58778525229247314*real(t193), 0.95105651629515353*imag(t191)+0.58778525229247314*imag(t193))
+	t197 := complex(real(t195)+imag(t196), imag(t195)-real(t196))
+	t198 := complex(real(t195)-imag(t196), imag(t195)+real(t196))
+	t199 := complex(real(x10)-0.80901699437494745*real(t190)+0.30901699437494745*real(t192), imag(x10)-0.80901699437494745*imag(t190)+0.30901699437494745*imag(t192))
+	t200 := complex(0.58778525229247314*real(t191)-0.95105651629515353*real(t193), 0.58778525229247314*imag(t191)-0.95105651629515353*imag(t193))
+	t201 := complex(real(t199)+imag(t200), imag(t199)-real(t200))
+	t202 := complex(real(t199)-imag(t200), imag(t199)+real(t200))
+	t203 := t181 + t194
+	t204 := t181 - t194
+	t205 := t168 + t203
+	t206 := complex(real(t168)-0.5*real(t203), imag(t168)-0.5*imag(t203))
+	t207 := complex(0.8660254037844386*real(t204), 0.8660254037844386*imag(t204))
+	t208 := complex(real(t206)+imag(t207), imag(t206)-real(t207))
+	t209 := complex(real(t206)-imag(t207), imag(t206)+real(t207))
+	t210 := t184 * complex(0.91354545764260087, -0.40673664307580021)
+	t211 := t197 * complex(0.66913060635885824, -0.74314482547739424)
+	t212 := t210 + t211
+	t213 := t210 - t211
+	t214 := t171 + t212
+	t215 := complex(real(t171)-0.5*real(t212), imag(t171)-0.5*imag(t212))
+	t216 := complex(0.8660254037844386*real(t213), 0.8660254037844386*imag(t213))
+	t217 := complex(real(t215)+imag(t216), imag(t215)-real(t216))
+	t218 := complex(real(t215)-imag(t216), imag(t215)+real(t216))
+	t219 := t188 * complex(0.66913060635885824, -0.74314482547739424)
+	t220 := t201 * complex(-0.10452846326765347, -0.99452189536827329)
+	t221 := t219 + t220
+	t222 := t219 - t220
+	t223 := t175 + t221
+	t224 := complex(real(t175)-0.5*real(t221), imag(t175)-0.5*imag(t221))
+	t225 := complex(0.8660254037844386*real(t222), 0.8660254037844386*imag(t222))
+	t226 := complex(real(t224)+imag(t225), imag(t224)-real(t225))
+	t227 := complex(real(t224)-imag(t225), imag(t224)+real(t225))
+	t228 := t189 * complex(0.30901699437494745, -0.95105651629515353)
+	t229 := t202 * complex(-0.80901699437494745, -0.58778525229247314)
+	t230 := t228 + t229
+	t231 := t228 - t229
+	t232 := t176 + t230
+	t233 := complex(real(t176)-0.5*real(t230), imag(t176)-0.5*imag(t230))
+	t234 := complex(0.8660254037844386*real(t231), 0.8660254037844386*imag(t231))
+	t235 := complex(real(t233)+imag(t234), imag(t233)-real(t234))
+	t236 := complex(real(t233)-imag(t234), imag(t233)+real(t234))
+	t237 := t185 * complex(-0.10452846326765347, -0.99452189536827329)
+	t238 := t198 * complex(-0.97814760073380569, 0.20791169081775934)
+	t239 := t237 + t238
+	t240 := t237 - t238
+	t241 := t172 + t239
+	t242 := complex(real(t172)-0.5*real(t239), imag(t172)-0.5*imag(t239))
+	t243 := complex(0.8660254037844386*real(t240), 0.8660254037844386*imag(t240))
+	t244 := complex(real(t242)+imag(t243), imag(t242)-real(t243))
+	t245 := complex(real(t242)-imag(t243), imag(t242)+real(t243))
+	t246 := x15 + x51
+	t247 := x15 - x51
+	t248 := x27 + x39
+	t249 := x27 - x39
+	t250 := x3 + t246 + t248
+	t251 := complex(real(x3)+0.30901699437494745*real(t246)-0.80901699437494745*real(t248), imag(x3)+0.30901699437494745*imag(t246)-0.80901699437494745*imag(t248))
+	t252 := complex(0.95105651629515353*real(t247)+0.58778525229247314*real(t249), 0.95105651629515353*imag(t247)+0.58778525229247314*imag(t249))
+	t253 := complex(real(t251)+imag(t252), imag(t251)-real(t252))
+	t254 := complex(real(t251)-imag(t252), imag(t251)+real(t252))
+	t255 := complex(real(x3)-0.80901699437494745*real(t246)+0.30901699437494745*real(t248), imag(x3)-0.80901699437494745*imag(t246)+0.30901699437494745*imag(t248))
+	t256 := complex(0.58778525229247314*real(t247)-0.95105651629515353*real(t249), 0.58778525229247314*imag(t247)-0.95105651629515353*imag(t249))
+	t257 := complex(real(t255)+imag(t256), imag(t255)-real(t256))
+	t258 := complex(real(t255)-imag(t256), imag(t255)+real(t256))
+	t259 := x19 + x55
+	t260 := x19 - x55
+	t261 := x31 + x43
+	t262 := x31 - x43
+	t263 := x7 + t259 + t261
+	t264 := complex(real(x7)+0.30901699437494745*real(t259)-0.80901699437494745*real(t261), imag(x7)+0.30901699437494745*imag(t259)-0.80901699437494745*imag(t261))
+	t265 := complex(0.95105651629515353*real(t260)+0.58778525229247314*real(t262), 0.95105651629515353*imag(t260)+0.58778525229247314*imag(t262))
+	t266 := complex(real(t264)+imag(t265), imag(t264)-real(t265))
+	t267 := complex(real(t264)-imag(t265), imag(t264)+real(t265))
+	t268 := complex(real(x7)-0.80901699437494745*real(t259)+0.30901699437494745*real(t261), imag(x7)-0.80901699437494745*imag(t259)+0.30901699437494745*imag(t261))
+	t269 := complex(0.58778525229247314*real(t260)-0.95105651629515353*real(t262), 0.58778525229247314*imag(t260)-0.95105651629515353*imag(t262))
+	t270 := complex(real(t268)+imag(t269), imag(t268)-real(t269))
+	t271 := complex(real(t268)-imag(t269), imag(t268)+real(t269))
+	t272 := x23 + x59
+	t273 := x23 - x59
+	t274 := x35 + x47
+	t275 := x35 - x47
+	t276 := x11 + t272 + t274
+	t277 := complex(real(x11)+0.30901699437494745*real(t272)-0.80901699437494745*real(t274), imag(x11)+0.30901699437494745*imag(t272)-0.80901699437494745*imag(t274))
+	t278 := complex(0.95105651629515353*real(t273)+0.58778525229247314*real(t275), 0.95105651629515353*imag(t273)+0.58778525229247314*imag(t275))
+	t279 := complex(real(t277)+imag(t278), imag(t277)-real(t278))
+	t280 := complex(real(t277)-imag(t278), imag(t277)+real(t278))
+	t281 := complex(real(x11)-0.80901699437494745*real(t272)+0.30901699437494745*real(t274), imag(x11)-0.80901699437494745*imag(t272)+0.30901699437494745*imag(t274))
+	t282 := complex(0.58778525229247314*real(t273)-0.95105651629515353*real(t275), 0.58778525229247314*imag(t273)-0.95105651629515353*imag(t275))
+	t283 := complex(real(t281)+imag(t282), imag(t281)-real(t282))
+	t284 := complex(real(t281)-imag(t282), imag(t281)+real(t282))
+	t285 := t263 + t276
+	t286 := t263 - t276
+	t287 := t250 + t285
+	t288 := complex(real(t250)-0.5*real(t285), imag(t250)-0.5*imag(t285))
+	t289 := complex(0.8660254037844386*real(t286), 0.8660254037844386*imag(t286))
+	t290 := complex(real(t288)+imag(t289), imag(t288)-real(t289))
+	t291 := complex(real(t288)-imag(t289), imag(t288)+real(t289))
+	t292 := t266 * complex(0.91354545764260087, -0.40673664307580021)
+	t293 := t279 * complex(0.66913060635885824, -0.74314482547739424)
+	t294 := t292 + t293
+	t295 := t292 - t293
+	t296 := t253 + t294
+	t297 := complex(real(t253)-0.5*real(t294), imag(t253)-0.5*imag(t294))
+	t298 := complex(0.8660254037844386*real(t295), 0.8660254037844386*imag(t295))
+	t299 := complex(real(t297)+imag(t298), imag(t297)-real(t298))
+	t300 := complex(real(t297)-imag(t298), imag(t297)+real(t298))
+	t301 := t270 * complex(0.66913060635885824, -0.74314482547739424)
+	t302 := t283 * complex(-0.10452846326765347, -0.99452189536827329)
+	t303 := t301 + t302
+	t304 := t301 - t302
+	t305 := t257 + t303
+	t306 := complex(real(t257)-0.5*real(t303), imag(t257)-0.5*imag(t303))
+	t307 := complex(0.8660254037844386*real(t304), 0.8660254037844386*imag(t304))
+	t308 := complex(real(t306)+imag(t307), imag(t306)-real(t307))
+	t309 := complex(real(t306)-imag(t307), imag(t306)+real(t307))
+	t310 := t271 * complex(0.30901699437494745, -0.95105651629515353)
+	t311 := t284 * complex(-0.80901699437494745, -0.58778525229247314)
+	t312 := t310 + t311
+	t313 := t310 - t311
+	t314 := t258 + t312
+	t315 := complex(real(t258)-0.5*real(t312), imag(t258)-0.5*imag(t312))
+	t316 := complex(0.8660254037844386*real(t313), 0.8660254037844386*imag(t313))
+	t317 := complex(real(t315)+imag(t316), imag(t315)-real(t316))
+	t318 := complex(real(t315)-imag(t316), imag(t315)+real(t316))
+	t319 := t267 * complex(-0.10452846326765347, -0.99452189536827329)
+	t320 := t280 * complex(-0.97814760073380569, 0.20791169081775934)
+	t321 := t319 + t320
+	t322 := t319 - t320
+	t323 := t254 + t321
+	t324 := complex(real(t254)-0.5*real(t321), imag(t254)-0.5*imag(t321))
+	t325 := complex(0.8660254037844386*real(t322), 0.8660254037844386*imag(t322))
+	t326 := complex(real(t324)+imag(t325), imag(t324)-real(t325))
+	t327 := complex(real(t324)-imag(t325), imag(t324)+real(t325))
+	t328 := t41 + t205
+	t329 := t41 - t205
+	t330 := t123 + t287
+	t331 := t123 - t287
+	t332 := complex(imag(t331), -real(t331))
+	t333 := t328 + t330
+	t334 := t329 + t332
+	t335 := t328 - t330
+	t336 := t329 - t332
+	t337 := t132 * complex(0.99452189536827329, -0.10452846326765347)
+	t338 := t214 * complex(0.97814760073380569, -0.20791169081775934)
+	t339 := t296 * complex(0.95105651629515353, -0.30901699437494745)
+	t340 := t50 + t338
+	t341 := t50 - t338
+	t342 := t337 + t339
+	t343 := t337 - t339
+	t344 := complex(imag(t343), -real(t343))
+	t345 := t340 + t342
+	t346 := t341 + t344
+	t347 := t340 - t342
+	t348 := t341 - t344
+	t349 := t141 * complex(0.97814760073380569, -0.20791169081775934)
+	t350 := t223 * complex(0.91354545764260087, -0.40673664307580021)
+	t351 := t305 * complex(0.80901699437494745, -0.58778525229247314)
+	t352 := t59 + t350
+	t353 := t59 - t350
+	t354 := t349 + t351
+	t355 := t349 - t351
+	t356 := complex(imag(t355), -real(t355))
+	t357 := t352 + t354
+	t358 := t353 + t356
+	t359 := t352 - t354
+	t360 := t353 - t356
+	t361 := t150 * complex(0.95105651629515353, -0.30901699437494745)
+	t362 := t232 * complex(0.80901699437494745, -0.58778525229247314)
+	t363 := t314 * complex(0.58778525229247314, -0.80901699437494745)
+	t364 := t68 + t362
+	t365 := t68 - t362
+	t366 := t361 + t363
+	t367 := t361 - t363
+	t368 := complex(imag(t367), -real(t367))
+	t369 := t364 + t366
+	t370 := t365 + t368
+	t371 := t364 - t366
+	t372 := t365 - t368
+	t373 := t159 * complex(0.91354545764260087, -0.40673664307580021)
+	t374 := t241 * complex(0.66913060635885824, -0.74314482547739424)
+	t375 := t323 * complex(0.30901699437494745, -0.95105651629515353)
+	t376 := t77 + t374
+	t377 := t77 - t374
+	t378 := t373 + t375
+	t379 := t373 - t375
+	t380 := complex(imag(t379), -real(t379))
+	t381 := t376 + t378
+	t382 := t377 + t380
+	t383 := t376 - t378
+	t384 := t377 - t380
+	t385 := t126 * complex(0.8660254037844386, -0.5)
+	t386 := t208 * complex(0.5, -0.8660254037844386)
+	t387 := complex(imag(t290), -real(t290))
+	t388 := t44 + t386
+	t389 := t44 - t386
+	t390 := t385 + t387
+	t391 := t385 - t387
+	t392 := complex(imag(t391), -real(t391))
+	t393 := t388 + t390
+	t394 := t389 + t392
+	t395 := t388 - t390
+	t396 := t389 - t392
+	t397 := t135 * complex(0.80901699437494745, -0.58778525229247314)
+	t398 := t217 * complex(0.30901699437494745, -0.95105651629515353)
+	t399 := t299 * complex(-0.30901699437494745, -0.95105651629515353)
+	t400 := t53 + t398
+	t401 := t53 - t398
+	t402 := t397 + t399
+	t403 := t397 - t399
+	t404 := complex(imag(t403), -real(t403))
+	t405 := t400 + t402
+	t406 := t401 + t404
+	t407 := t400 - t402
+	t408 := t401 - t404
+	t409 := t144 * complex(0.74314482547739424, -0.66913060635885824)
+	t410 := t226 * complex(0.10452846326765347, -0.99452189536827329)
+	t411 := t308 * complex(-0.58778525229247314, -0.80901699437494745)
+	t412 := t62 + t410
+	t413 := t62 - t410
+	t414 := t409 + t411
+	t415 := t409 - t411
+	t416 := complex(imag(t415), -real(t415))
+	t417 := t412 + t414
+	t418 := t413 + t416
+	t419 := t412 - t414
+	t420 := t413 - t416
+	t421 := t153 * complex(0.66913060635885824, -0.74314482547739424)
+	t422 := t235 * complex(-0.10452846326765347, -0.99452189536827329)
+	t423 := t317 * complex(-0.80901699437494745, -0.58778525229247314)
+	t424 := t71 + t422
+	t425 := t71 - t422
+	t426 := t421 + t423
+	t427 := t421 - t423
+	t428 := complex(imag(t427), -real(t427))
+	t429 := t424 + t426
+	t430 := t425 + t428
+	t431 := t424 - t426
+	t432 := t425 - t428
+	t433 := t162 * complex(0.58778525229247314, -0.80901699437494745)
+	t434 := t244 * complex(-0.30901699437494745, -0.95105651629515353)
+	t435 := t326 * complex(-0.95105651629515353, -0.30901699437494745)
+	t436 := t80 + t434
+	t437 := t80 - t434
+	t438 := t433 + t435
+	t439 := t433 - t435
+	t440 := complex(imag(t439), -real(t439))
+	t441 := t436 + t438
+	t442 := t437 + t440
+	t443 := t436 - t438
+	t444 := t437 - t440
+	t445 := t127 * complex(0.5, -0.8660254037844386)
+	t446 := t209 * complex(-0.5, -0.8660254037844386)
+	t447 := -t291
+	t448 := t45 + t446
+	t449 := t45 - t446
+	t450 := t445 + t447
+	t451 := t445 - t447
+	t452 := complex(imag(t451), -real(t451))
+	t453 := t448 + t450
+	t454 := t449 + t452
+	t455 := t448 - t450
+	t456 := t449 - t452
+	t457 := t136 * complex(0.40673664307580021, -0.91354545764260087)
+	t458 := t218 * complex(-0.66913060635885824, -0.74314482547739424)
+	t459 := t300 * complex(-0.95105651629515353, 0.30901699437494745)
+	t460 := t54 + t458
+	t461 := t54 - t458
+	t462 := t457 + t459
+	t463 := t457 - t459
+	t464 := complex(imag(t463), -real(t463))
+	t465 := t460 + t462
+	t466 := t461 + t464
+	t467 := t460 - t462
+	t468 := t461 - t464
+	t469 := t145 * complex(0.30901699437494745, -0.95105651629515353)
+	t470 := t227 * complex(-0.80901699437494745, -0.58778525229247314)
+	t471 := t309 * complex(-0.80901699437494745, 0.58778525229247314)
+	t472 := t63 + t470
+	t473 := t63 - t470
+	t474 := t469 + t471
+	t475 := t469 - t471
+	t476 := complex(imag(t475), -real(t475))
+	t477 := t472 + t474
+	t478 := t473 + t476
+	t479 := t472 - t474
+	t480 := t473 - t476
+	t481 := t154 * complex(0.20791169081775934, -0.97814760073380569)
+	t482 := t236 * complex(-0.91354545764260087, -0.40673664307580021)
+	t483 := t318 * complex(-0.58778525229247314, 0.80901699437494745)
+	t484 := t72 + t482
+	t485 := t72 - t482
+	t486 := t481 + t483
+	t487 := t481 - t483
+	t488 := complex(imag(t487), -real(t487))
+	t489 := t484 + t486
+	t490 := t485 + t488
+	t491 := t484 - t486
+	t492 := t485 - t488
+	t493 := t163 * complex(0.10452846326765347, -0.99452189536827329)
+	t494 := t245 * complex(-0.97814760073380569, -0.20791169081775934)
+	t495 := t327 * complex(-0.30901699437494745, 0.95105651629515353)
+	t496 := t81 + t494
+	t497 := t81 - t494
+	t498 := t493 + t495
+	t499 := t493 - t495
+	t500 := complex(imag(t499), -real(t499))
+	t501 := t496 + t498
+	t502 := t497 + t500
+	t503 := t496 - t498
+	t504 := t497 - t500
+
+	d[0] = t333
+	d[1] = t345
+	d[2] = t357
+	d[3] = t369
+	d[4] = t381
+	d[5] = t393
+	d[6] = t405
+	d[7] = t417
+	d[8] = t429
+	d[9] = t441
+	d[10] = t453
+	d[11] = t465
+	d[12] = t477
+	d[13] = t489
+	d[14] = t501
+	d[15] = t334
+	d[16] = t346
+	d[17] = t358
+	d[18] = t370
+	d[19] = t382
+	d[20] = t394
+	d[21] = t406
+	d[22] = t418
+	d[23] = t430
+	d[24] = t442
+	d[25] = t454
+	d[26] = t466
+	d[27] = t478
+	d[28] = t490
+	d[29] = t502
+	d[30] = t335
+	d[31] = t347
+	d[32] = t359
+	d[33] = t371
+	d[34] = t383
+	d[35] = t395
+	d[36] = t407
+	d[37] = t419
+	d[38] = t431
+	d[39] = t443
+	d[40] = t455
+	d[41] = t467
+	d[42] = t479
+	d[43] = t491
+	d[44] = t503
+	d[45] = t336
+	d[46] = t348
+	d[47] = t360
+	d[48] = t372
+	d[49] = t384
+	d[50] = t396
+	d[51] = t408
+	d[52] = t420
+	d[53] = t432
+	d[54] = t444
+	d[55] = t456
+	d[56] = t468
+	d[57] = t480
+	d[58] = t492
+	d[59] = t504
+
+	return true
+}
+
+// inverseDIT60GenComplex128 computes a 60-point inverse (scaled by 1/n) FFT for complex128 data as
+// straight-line code (radices 4x3x5). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT60GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 60
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+	x6 := s[6]
+	x7 := s[7]
+	x8 := s[8]
+	x9 := s[9]
+	x10 := s[10]
+	x11 := s[11]
+	x12 := s[12]
+	x13 := s[13]
+	x14 := s[14]
+	x15 := s[15]
+	x16 := s[16]
+	x17 := s[17]
+	x18 := s[18]
+	x19 := s[19]
+	x20 := s[20]
+	x21 := s[21]
+	x22 := s[22]
+	x23 := s[23]
+	x24 := s[24]
+	x25 := s[25]
+	x26 := s[26]
+	x27 := s[27]
+	x28 := s[28]
+	x29 := s[29]
+	x30 := s[30]
+	x31 := s[31]
+	x32 := s[32]
+	x33 := s[33]
+	x34 := s[34]
+	x35 := s[35]
+	x36 := s[36]
+	x37 := s[37]
+	x38 := s[38]
+	x39 := s[39]
+	x40 := s[40]
+	x41 := s[41]
+	x42 := s[42]
+	x43 := s[43]
+	x44 := s[44]
+	x45 := s[45]
+	x46 := s[46]
+	x47 := s[47]
+	x48 := s[48]
+	x49 := s[49]
+	x50 := s[50]
+	x51 := s[51]
+	x52 := s[52]
+	x53 := s[53]
+	x54 := s[54]
+	x55 := s[55]
+	x56 := s[56]
+	x57 := s[57]
+	x58 := s[58]
+	x59 := s[59]
+
+	t0 := x12 + x48
+	t1 := x12 - x48
+	t2 := x24 + x36
+	t3 := x24 - x36
+	t4 := x0 + t0 + t2
+	t5 := complex(real(x0)+0.30901699437494745*real(t0)-0.80901699437494745*real(t2), imag(x0)+0.30901699437494745*imag(t0)-0.80901699437494745*imag(t2))
+	t6 := complex(0.95105651629515353*real(t1)+0.58778525229247314*real(t3), 0.95105651629515353*imag(t1)+0.58778525229247314*imag(t3))
+	t7 := complex(real(t5)-imag(t6), imag(t5)+real(t6))
+	t8 := complex(real(t5)+imag(t6), imag(t5)-real(t6))
+	t9 := complex(real(x0)-0.80901699437494745*real(t0)+0.30901699437494745*real(t2), imag(x0)-0.80901699437494745*imag(t0)+0.30901699437494745*imag(t2))
+	t10 := complex(0.58778525229247314*real(t1)-0.95105651629515353*real(t3), 0.58778525229247314*imag(t1)-0.95105651629515353*imag(t3))
+	t11 := complex(real(t9)-imag(t10), imag(t9)+real(t10))
+	t12 := complex(real(t9)+imag(t10), imag(t9)-real(t10))
+	t13 := x16 + x52
+	t14 := x16 - x52
+	t15 := x28 + x40
+	t16 := x28 - x40
+	t17 := x4 + t13 + t15
+	t18 := complex(real(x4)+0.30901699437494745*real(t13)-0.80901699437494745*real(t15), imag(x4)+0.30901699437494745*imag(t13)-0.80901699437494745*imag(t15))
+	t19 := complex(0.95105651629515353*real(t14)+0.58778525229247314*real(t16), 0.95105651629515353*imag(t14)+0.58778525229247314*imag(t16))
+	t20 := complex(real(t18)-imag(t19), imag(t18)+real(t19))
+	t21 := complex(real(t18)+imag(t19), imag(t18)-real(t19))
+	t22 := complex(real(x4)-0.80901699437494745*real(t13)+0.30901699437494745*real(t15), imag(x4)-0.80901699437494745*imag(t13)+0.30901699437494745*imag(t15))
+	t23 := complex(0.58778525229247314*real(t14)-0.95105651629515353*real(t16), 0.58778525229247314*imag(t14)-0.95105651629515353*imag(t16))
+	t24 := complex(real(t22)-imag(t23), imag(t22)+real(t23))
+	t25 := complex(real(t22)+imag(t23), imag(t22)-real(t23))
+	t26 := x20 + x56
+	t27 := x20 - x56
+	t28 := x32 + x44
+	t29 := x32 - x44
+	t30 := x8 + t26 + t28
+	t31 := complex(real(x8)+0.30901699437494745*real(t26)-0.80901699437494745*real(t28), imag(x8)+0.30901699437494745*imag(t26)-0.80901699437494745*imag(t28))
+	t32 := complex(0.95105651629515353*real(t27)+0.58778525229247314*real(t29), 0.95105651629515353*imag(t27)+0.58778525229247314*imag(t29))
+	t33 := complex(real(t31)-imag(t32), imag(t31)+real(t32))
+	t34 := complex(real(t31)+imag(t32), imag(t31)-real(t32))
+	t35 := complex(real(x8)-0.80901699437494745*real(t26)+0.30901699437494745*real(t28), imag(x8)-0.80901699437494745*imag(t26)+0.30901699437494745*imag(t28))
+	t36 := complex(0.58778525229247314*real(t27)-0.95105651629515353*real(t29), 0.58778525229247314*imag(t27)-0.95105651629515353*imag(t29))
+	t37 := complex(real(t35)-imag(t36), imag(t35)+real(t36))
+	t38 := complex(real(t35)+imag(t36), imag(t35)-real(t36))
+	t39 := t17 + t30
+	t40 := t17 - t30
+	t41 := t4 + t39
+	t42 := complex(real(t4)-0.5*real(t39), imag(t4)-0.5*imag(t39))
+	t43 := complex(0.8660254037844386*real(t40), 0.8660254037844386*imag(t40))
+	t44 := complex(real(t42)-imag(t43), imag(t42)+real(t43))
+	t45 := complex(real(t42)+imag(t43), imag(t42)-real(t43))
+	t46 := t20 * complex(0.91354545764260087, 0.40673664307580021)
+	t47 := t33 * complex(0.66913060635885824, 0.74314482547739424)
+	t48 := t46 + t47
+	t49 := t46 - t47
+	t50 := t7 + t48
+	t51 := complex(real(t7)-0.5*real(t48), imag(t7)-0.5*imag(t48))
+	t52 := complex(0.8660254037844386*real(t49), 0.8660254037844386*imag(t49))
+	t53 := complex(real(t51)-imag(t52), imag(t51)+real(t52))
+	t54 := complex(real(t51)+imag(t52), imag(t51)-real(t52))
+	t55 := t24 * complex(0.66913060635885824, 0.74314482547739424)
+	t56 := t37 * complex(-0.10452846326765347, 0.99452189536827329)
+	t57 := t55 + t56
+	t58 := t55 - t56
+	t59 := t11 + t57
+	t60 := complex(real(t11)-0.5*real(t57), imag(t11)-0.5*imag(t57))
+	t61 := complex(0.8660254037844386*real(t58), 0.8660254037844386*imag(t58))
+	t62 := complex(real(t60)-imag(t61), imag(t60)+real(t61))
+	t63 := complex(real(t60)+imag(t61), imag(t60)-real(t61))
+	t64 := t25 * complex(0.30901699437494745, 0.95105651629515353)
+	t65 := t38 * complex(-0.80901699437494745, 0.58778525229247314)
+	t66 := t64 + t65
+	t67 := t64 - t65
+	t68 := t12 + t66
+	t69 := complex(real(t12)-0.5*real(t66), imag(t12)-0.5*imag(t66))
+	t70 := complex(0.8660254037844386*real(t67), 0.8660254037844386*imag(t67))
+	t71 := complex(real(t69)-imag(t70), imag(t69)+real(t70))
+	t72 := complex(real(t69)+imag(t70), imag(t69)-real(t70))
+	t73 := t21 * complex(-0.10452846326765347, 0.99452189536827329)
+	t74 := t34 * complex(-0.97814760073380569, -0.20791169081775934)
+	t75 := t73 + t74
+	t76 := t73 - t74
+	t77 := t8 + t75
+	t78 := complex(real(t8)-0.5*real(t75), imag(t8)-0.5*imag(t75))
+	t79 := complex(0.8660254037844386*real(t76), 0.8660254037844386*imag(t76))
+	t80 := complex(real(t78)-imag(t79), imag(t78)+real(t79))
+	t81 := complex(real(t78)+imag(t79), imag(t78)-real(t79))
+	t82 := x13 + x49
+	t83 := x13 - x49
+	t84 := x25 + x37
+	t85 := x25 - x37
+	t86 := x1 + t82 + t84
+	t87 := complex(real(x1)+0.30901699437494745*real(t82)-0.80901699437494745*real(t84), imag(x1)+0.30901699437494745*imag(t82)-0.80901699437494745*imag(t84))
+	t88 := complex(0.95105651629515353*real(t83)+0.58778525229247314*real(t85), 0.95105651629515353*imag(t83)+0.58778525229247314*imag(t85))
+	t89 := complex(real(t87)-imag(t88), imag(t87)+real(t88))
+	t90 := complex(real(t87)+imag(t88), imag(t87)-real(t88))
+	t91 := complex(real(x1)-0.80901699437494745*real(t82)+0.30901699437494745*real(t84), imag(x1)-0.80901699437494745*imag(t82)+0.30901699437494745*imag(t84))
+	t92 := complex(0.58778525229247314*real(t83)-0.95105651629515353*real(t85), 0.58778525229247314*imag(t83)-0.95105651629515353*imag(t85))
+	t93 := complex(real(t91)-imag(t92), imag(t91)+real(t92))
+	t94 := complex(real(t91)+imag(t92), imag(t91)-real(t92))
+	t95 := x17 + x53
+	t96 := x17 - x53
+	t97 := x29 + x41
+	t98 := x29 - x41
+	t99 := x5 + t95 + t97
+	t100 := complex(real(x5)+0.30901699437494745*real(t95)-0.80901699437494745*real(t97), imag(x5)+0.30901699437494745*imag(t95)-0.80901699437494745*imag(t97))
+	t101 := complex(0.95105651629515353*real(t96)+0.58778525229247314*real(t98), 0.95105651629515353*imag(t96)+0.58778525229247314*imag(t98))
+	t102 := complex(real(t100)-imag(t101), imag(t100)+real(t101))
+	t103 := complex(real(t100)+imag(t101), imag(t100)-real(t101))
+	t104 := complex(real(x5)-0.80901699437494745*real(t95)+0.30901699437494745*real(t97), imag(x5)-0.80901699437494745*imag(t95)+0.30901699437494745*imag(t97))
+	t105 := complex(0.58778525229247314*real(t96)-0.95105651629515353*real(t98), 0.58778525229247314*imag(t96)-0.95105651629515353*imag(t98))
+	t106 := complex(real(t104)-imag(t105), imag(t104)+real(t105))
+	t107 := complex(real(t104)+imag(t105), imag(t104)-real(t105))
+	t108 := x21 + x57
+	t109 := x21 - x57
+	t110 := x33 + x45
+	t111 := x33 - x45
+	t112 := x9 + t108 + t110
+	t113 := complex(real(x9)+0.30901699437494745*real(t108)-0.80901699437494745*real(t110), imag(x9)+0.30901699437494745*imag(t108)-0.80901699437494745*imag(t110))
+	t114 := complex(0.95105651629515353*real(t109)+0.58778525229247314*real(t111), 0.95105651629515353*imag(t109)+0.58778525229247314*imag(t111))
+	t115 := complex(real(t113)-imag(t114), imag(t113)+real(t114))
+	t116 := complex(real(t113)+imag(t114), imag(t113)-real(t114))
+	t117 := complex(real(x9)-0.80901699437494745*real(t108)+0.30901699437494745*real(t110), imag(x9)-0.80901699437494745*imag(t108)+0.30901699437494745*imag(t110))
+	t118 := complex(0.58778525229247314*real(t109)-0.95105651629515353*real(t111), 0.58778525229247314*imag(t109)-0.95105651629515353*imag(t111))
+	t119 := complex(real(t117)-imag(t118), imag(t117)+real(t118))
+	t120 := complex(real(t117)+imag(t118), imag(t117)-real(t118))
+	t121 := t99 + t112
+	t122 := t99 - t112
+	t123 := t86 + t121
+	t124 := complex(real(t86)-0.5*real(t121), imag(t86)-0.5*imag(t121))
+	t125 := complex(0.8660254037844386*real(t122), 0.8660254037844386*imag(t122))
+	t126 := complex(real(t124)-imag(t125), imag(t124)+real(t125))
+	t127 := complex(real(t124)+imag(t125), imag(t124)-real(t125))
+	t128 := t102 * complex(0.91354545764260087, 0.40673664307580021)
+	t129 := t115 * complex(0.66913060635885824, 0.74314482547739424)
+	t130 := t128 + t129
+	t131 := t128 - t129
+	t132 := t89 + t130
+	t133 := complex(real(t89)-0.5*real(t130), imag(t89)-0.5*imag(t130))
+	t134 := complex(0.8660254037844386*real(t131), 0.8660254037844386*imag(t131))
+	t135 := complex(real(t133)-imag(t134), imag(t133)+real(t134))
+	t136 := complex(real(t133)+imag(t134), imag(t133)-real(t134))
+	t137 := t106 * complex(0.66913060635885824, 0.74314482547739424)
+	t138 := t119 * complex(-0.10452846326765347, 0.99452189536827329)
+	t139 := t137 + t138
+	t140 := t137 - t138
+	t141 := t93 + t139
+	t142 := complex(real(t93)-0.5*real(t139), imag(t93)-0.5*imag(t139))
+	t143 := complex(0.8660254037844386*real(t140), 0.8660254037844386*imag(t140))
+	t144 := complex(real(t142)-imag(t143), imag(t142)+real(t143))
+	t145 := complex(real(t142)+imag(t143), imag(t142)-real(t143))
+	t146 := t107 * complex(0.30901699437494745, 0.95105651629515353)
+	t147 := t120 * complex(-0.80901699437494745, 0.58778525229247314)
+	t148 := t146 + t147
+	t149 := t146 - t147
+	t150 := t94 + t148
+	t151 := complex(real(t94)-0.5*real(t148), imag(t94)-0.5*imag(t148))
+	t152 := complex(0.8660254037844386*real(t149), 0.8660254037844386*imag(t149))
+	t153 := complex(real(t151)-imag(t152), imag(t151)+real(t152))
+	t154 := complex(real(t151)+imag(t152), imag(t151)-real(t152))
+	t155 := t103 * complex(-0.10452846326765347, 0.99452189536827329)
+	t156 := t116 * complex(-0.97814760073380569, -0.20791169081775934)
+	t157 := t155 + t156
+	t158 := t155 - t156
+	t159 := t90 + t157
+	t160 := complex(real(t90)-0.5*real(t157), imag(t90)-0.5*imag(t157))
+	t161 := complex(0.8660254037844386*real(t158), 0.8660254037844386*imag(t158))
+	t162 := complex(real(t160)-imag(t161), imag(t160)+real(t161))
+	t163 := complex(real(t160)+imag(t161), imag(t160)-real(t161))
+	t164 := x14 + x50
+	t165 := x14 - x50
+	t166 := x26 + x38
+	t167 := x26 - x38
+	t168 := x2 + t164 + t166
+	t169 := complex(real(x2)+0.30901699437494745*real(t164)-0.80901699437494745*real(t166), imag(x2)+0.30901699437494745*imag(t164)-0.80901699437494745*imag(t166))
+	t170 := complex(0.95105651629515353*real(t165)+0.58778525229247314*real(t167), 0.95105651629515353*imag(t165)+0.58778525229247314*imag(t167))
+	t171 := complex(real(t169)-imag(t170), imag(t169)+real(t170))
+	t172 := complex(real(t169)+imag(t170), imag(t169)-real(t170))
+	t173 := complex(real(x2)-0.80901699437494745*real(t164)+0.30901699437494745*real(t166), imag(x2)-0.80901699437494745*imag(t164)+0.30901699437494745*imag(t166))
+	t174 := complex(0.58778525229247314*real(t165)-0.95105651629515353*real(t167), 0.58778525229247314*imag(t165)-0.95105651629515353*imag(t167))
+	t175 := complex(real(t173)-imag(t174), imag(t173)+real(t174))
+	t176 := complex(real(t173)+imag(t174), imag(t173)-real(t174))
+	t177 := x18 + x54
+	t178 := x18 - x54
+	t179 := x30 + x42
+	t180 := x30 - x42
+	t181 := x6 + t177 + t179
+	t182 := complex(real(x6)+0.30901699437494745*real(t177)-0.80901699437494745*real(t179), imag(x6)+0.30901699437494745*imag(t177)-0.80901699437494745*imag(t179))
+	t183 := complex(0.95105651629515353*real(t178)+0.58778525229247314*real(t180), 0.95105651629515353*imag(t178)+0.58778525229247314*imag(t180))
+	t184 := complex(real(t182)-imag(t183), imag(t182)+real(t183))
+	t185 := complex(real(t182)+imag(t183), imag(t182)-real(t183))
+	t186 := complex(real(x6)-0.80901699437494745*real(t177)+0.30901699437494745*real(t179), imag(x6)-0.80901699437494745*imag(t177)+0.30901699437494745*imag(t179))
+	t187 := complex(0.58778525229247314*real(t178)-0.95105651629515353*real(t180), 0.58778525229247314*imag(t178)-0.95105651629515353*imag(t180))
+	t188 := complex(real(t186)-imag(t187), imag(t186)+real(t187))
+	t189 := complex(real(t186)+imag(t187), imag(t186)-real(t187))
+	t190 := x22 + x58
+	t191 := x22 - x58
+	t192 := x34 + x46
+	t193 := x34 - x46
+	t194 := x10 + t190 + t192
+	t195 := complex(real(x10)+0.30901699437494745*real(t190)-0.80901699437494745*real(t192), imag(x10)+0.30901699437494745*imag(t190)-0.80901699437494745*imag(t192))
+	t196 := complex(0.95105651629515353*real(t191)+0.58778525229247314*real(t193), 0.95105651629515353*imag(t191)+0.58778525229247314*imag(t193))
+	t197 := complex(real(t195)-imag(t196), imag(t195)+real(t196))
+	t198 := complex(real(t195)+imag(t196), imag(t195)-real(t196))
+	t199 := complex(real(x10)-0.80901699437494745*real(t190)+0.30901699437494745*real(t192), imag(x10)-0.80901699437494745*imag(t190)+0.30901699437494745*imag(t192))
+	t200 := complex(0.58778525229247314*real(t191)-0.95105651629515353*real(t193), 0.58778525229247314*imag(t191)-0.95105651629515353*imag(t193))
+	t201 := complex(real(t199)-imag(t200), imag(t199)+real(t200))
+	t202 := complex(real(t199)+imag(t200), imag(t199)-real(t200))
+	t203 := t181 + t194
+	t204 := t181 - t194
+	t205 := t168 + t203
+	t206 := complex(real(t168)-0.5*real(t203), imag(t168)-0.5*imag(t203))
+	t207 := complex(0.8660254037844386*real(t204), 0.8660254037844386*imag(t204))
+	t208 := complex(real(t206)-imag(t207), imag(t206)+real(t207))
+	t209 := complex(real(t206)+imag(t207), imag(t206)-real(t207))
+	t210 := t184 * complex(0.91354545764260087, 0.40673664307580021)
+	t211 := t197 * complex(0.66913060635885824, 0.74314482547739424)
+	t212 := t210 + t211
+	t213 := t210 - t211
+	t214 := t171 + t212
+	t215 := complex(real(t171)-0.5*real(t212), imag(t171)-0.5*imag(t212))
+	t216 := complex(0.8660254037844386*real(t213), 0.8660254037844386*imag(t213))
+	t217 := complex(real(t215)-imag(t216), imag(t215)+real(t216))
+	t218 := complex(real(t215)+imag(t216), imag(t215)-real(t216))
+	t219 := t188 * complex(0.66913060635885824, 0.74314482547739424)
+	t220 := t201 * complex(-0.10452846326765347, 0.99452189536827329)
+	t221 := t219 + t220
+	t222 := t219 - t220
+	t223 := t175 + t221
+	t224 := complex(real(t175)-0.5*real(t221), imag(t175)-0.5*imag(t221))
+	t225 := complex(0.8660254037844386*real(t222), 0.8660254037844386*imag(t222))
+	t226 := complex(real(t224)-imag(t225), imag(t224)+real(t225))
+	t227 := complex(real(t224)+imag(t225), imag(t224)-real(t225))
+	t228 := t189 * complex(0.30901699437494745, 0.95105651629515353)
+	t229 := t202 * complex(-0.80901699437494745, 0.58778525229247314)
+	t230 := t228 + t229
+	t231 := t228 - t229
+	t232 := t176 + t230
+	t233 := complex(real(t176)-0.5*real(t230), imag(t176)-0.5*imag(t230))
+	t234 := complex(0.8660254037844386*real(t231), 0.8660254037844386*imag(t231))
+	t235 := complex(real(t233)-imag(t234), imag(t233)+real(t234))
+	t236 := complex(real(t233)+imag(t234), imag(t233)-real(t234))
+	t237 := t185 * complex(-0.10452846326765347, 0.99452189536827329)
+	t238 := t198 * complex(-0.97814760073380569, -0.20791169081775934)
+	t239 := t237 + t238
+	t240 := t237 - t238
+	t241 := t172 + t239
+	t242 := complex(real(t172)-0.5*real(t239), imag(t172)-0.5*imag(t239))
+	t243 := complex(0.8660254037844386*real(t240), 0.8660254037844386*imag(t240))
+	t244 := complex(real(t242)-imag(t243), imag(t242)+real(t243))
+	t245 := complex(real(t242)+imag(t243), imag(t242)-real(t243))
+	t246 := x15 + x51
+	t247 := x15 - x51
+	t248 := x27 + x39
+	t249 := x27 - x39
+	t250 := x3 + t246 + t248
+	t251 := complex(real(x3)+0.30901699437494745*real(t246)-0.80901699437494745*real(t248), imag(x3)+0.30901699437494745*imag(t246)-0.80901699437494745*imag(t248))
+	t252 := complex(0.95105651629515353*real(t247)+0.58778525229247314*real(t249), 0.95105651629515353*imag(t247)+0.58778525229247314*imag(t249))
+	t253 := complex(real(t251)-imag(t252), imag(t251)+real(t252))
+	t254 := complex(real(t251)+imag(t252), imag(t251)-real(t252))
+	t255 := complex(real(x3)-0.80901699437494745*real(t246)+0.30901699437494745*real(t248), imag(x3)-0.80901699437494745*imag(t246)+0.30901699437494745*imag(t248))
+	t256 := complex(0.58778525229247314*real(t247)-0.95105651629515353*real(t249), 0.58778525229247314*imag(t247)-0.95105651629515353*imag(t249))
+	t257 := complex(real(t255)-imag(t256), imag(t255)+real(t256))
+	t258 := complex(real(t255)+imag(t256), imag(t255)-real(t256))
+	t259 := x19 + x55
+	t260 := x19 - x55
+	t261 := x31 + x43
+	t262 := x31 - x43
+	t263 := x7 + t259 + t261
+	t264 := complex(real(x7)+0.30901699437494745*real(t259)-0.80901699437494745*real(t261), imag(x7)+0.30901699437494745*imag(t259)-0.80901699437494745*imag(t261))
+	t265 := complex(0.95105651629515353*real(t260)+0.58778525229247314*real(t262), 0.95105651629515353*imag(t260)+0.58778525229247314*imag(t262))
+	t266 := complex(real(t264)-imag(t265), imag(t264)+real(t265))
+	t267 := complex(real(t264)+imag(t265), imag(t264)-real(t265))
+	t268 := complex(real(x7)-0.80901699437494745*real(t259)+0.30901699437494745*real(t261), imag(x7)-0.80901699437494745*imag(t259)+0.30901699437494745*imag(t261))
+	t269 := complex(0.58778525229247314*real(t260)-0.95105651629515353*real(t262), 0.58778525229247314*imag(t260)-0.95105651629515353*imag(t262))
+	t270 := complex(real(t268)-imag(t269), imag(t268)+real(t269))
+	t271 := complex(real(t268)+imag(t269), imag(t268)-real(t269))
+	t272 := x23 + x59
+	t273 := x23 - x59
+	t274 := x35 + x47
+	t275 := x35 - x47
+	t276 := x11 + t272 + t274
+	t277 := complex(real(x11)+0.30901699437494745*real(t272)-0.80901699437494745*real(t274), imag(x11)+0.30901699437494745*imag(t272)-0.80901699437494745*imag(t274))
+	t278 := complex(0.95105651629515353*real(t273)+0.58778525229247314*real(t275), 0.95105651629515353*imag(t273)+0.58778525229247314*imag(t275))
+	t279 := complex(real(t277)-imag(t278), imag(t277)+real(t278))
+	t280 := complex(real(t277)+imag(t278), imag(t277)-real(t278))
+	t281 := complex(real(x11)-0.80901699437494745*real(t272)+0.30901699437494745*real(t274), imag(x11)-0.80901699437494745*imag(t272)+0.30901699437494745*imag(t274))
+	t282 := complex(0.58778525229247314*real(t273)-0.95105651629515353*real(t275), 0.58778525229247314*imag(t273)-0.95105651629515353*imag(t275))
+	t283 := complex(real(t281)-imag(t282), imag(t281)+real(t282))
+	t284 := complex(real(t281)+imag(t282), imag(t281)-real(t282))
+	t285 := t263 + t276
+	t286 := t263 - t276
+	t287 := t250 + t285
+	t288 := complex(real(t250)-0.5*real(t285), imag(t250)-0.5*imag(t285))
+	t289 := complex(0.8660254037844386*real(t286), 0.8660254037844386*imag(t286))
+	t290 := complex(real(t288)-imag(t289), imag(t288)+real(t289))
+	t291 := complex(real(t288)+imag(t289), imag(t288)-real(t289))
+	t292 := t266 * complex(0.91354545764260087, 0.40673664307580021)
+	t293 := t279 * complex(0.66913060635885824, 0.74314482547739424)
+	t294 := t292 + t293
+	t295 := t292 - t293
+	t296 := t253 + t294
+	t297 := complex(real(t253)-0.5*real(t294), imag(t253)-0.5*imag(t294))
+	t298 := complex(0.8660254037844386*real(t295), 0.8660254037844386*imag(t295))
+	t299 := complex(real(t297)-imag(t298), imag(t297)+real(t298))
+	t300 := complex(real(t297)+imag(t298), imag(t297)-real(t298))
+	t301 := t270 * complex(0.66913060635885824, 0.74314482547739424)
+	t302 := t283 * complex(-0.10452846326765347, 0.99452189536827329)
+	t303 := t301 + t302
+	t304 := t301 - t302
+	t305 := t257 + t303
+	t306 := complex(real(t257)-0.5*real(t303), imag(t257)-0.5*imag(t303))
+	t307 := complex(0.8660254037844386*real(t304), 0.8660254037844386*imag(t304))
+	t308 := complex(real(t306)-imag(t307), imag(t306)+real(t307))
+	t309 := complex(real(t306)+imag(t307), imag(t306)-real(t307))
+	t310 := t271 * complex(0.30901699437494745, 0.95105651629515353)
+	t311 := t284 * complex(-0.80901699437494745, 0.58778525229247314)
+	t312 := t310 + t311
+	t313 := t310 - t311
+	t314 := t258 + t312
+	t315 := complex(real(t258)-0.5*real(t312), imag(t258)-0.5*imag(t312))
+	t316 := complex(0.8660254037844386*real(t313), 0.8660254037844386*imag(t313))
+	t317 := complex(real(t315)-imag(t316), imag(t315)+real(t316))
+	t318 := complex(real(t315)+imag(t316), imag(t315)-real(t316))
+	t319 := t267 * complex(-0.10452846326765347, 0.99452189536827329)
+	t320 := t280 * complex(-0.97814760073380569, -0.20791169081775934)
+	t321 := t319 + t320
+	t322 := t319 - t320
+	t323 := t254 + t321
+	t324 := complex(real(t254)-0.5*real(t321), imag(t254)-0.5*imag(t321))
+	t325 := complex(0.8660254037844386*real(t322), 0.8660254037844386*imag(t322))
+	t326 := complex(real(t324)-imag(t325), imag(t324)+real(t325))
+	t327 := complex(real(t324)+imag(t325), imag(t324)-real(t325))
+	t328 := t41 + t205
+	t329 := t41 - t205
+	t330 := t123 + t287
+	t331 := t123 - t287
+	t332 := complex(-imag(t331), real(t331))
+	t333 := t328 + t330
+	t334 := t329 + t332
+	t335 := t328 - t330
+	t336 := t329 - t332
+	t337 := t132 * complex(0.99452189536827329, 0.10452846326765347)
+	t338 := t214 * complex(0.97814760073380569, 0.20791169081775934)
+	t339 := t296 * complex(0.95105651629515353, 0.30901699437494745)
+	t340 := t50 + t338
+	t341 := t50 - t338
+	t342 := t337 + t339
+	t343 := t337 - t339
+	t344 := complex(-imag(t343), real(t343))
+	t345 := t340 + t342
+	t346 := t341 + t344
+	t347 := t340 - t342
+	t348 := t341 - t344
+	t349 := t141 * complex(0.97814760073380569, 0.20791169081775934)
+	t350 := t223 * complex(0.91354545764260087, 0.40673664307580021)
+	t351 := t305 * complex(0.80901699437494745, 0.58778525229247314)
+	t352 := t59 + t350
+	t353 := t59 - t350
+	t354 := t349 + t351
+	t355 := t349 - t351
+	t356 := complex(-imag(t355), real(t355))
+	t357 := t352 + t354
+	t358 := t353 + t356
+	t359 := t352 - t354
+	t360 := t353 - t356
+	t361 := t150 * complex(0.95105651629515353, 0.30901699437494745)
+	t362 := t232 * complex(0.80901699437494745, 0.58778525229247314)
+	t363 := t314 * complex(0.58778525229247314, 0.80901699437494745)
+	t364 := t68 + t362
+	t365 := t68 - t362
+	t366 := t361 + t363
+	t367 := t361 - t363
+	t368 := complex(-imag(t367), real(t367))
+	t369 := t364 + t366
+	t370 := t365 + t368
+	t371 := t364 - t366
+	t372 := t365 - t368
+	t373 := t159 * complex(0.91354545764260087, 0.40673664307580021)
+	t374 := t241 * complex(0.66913060635885824, 0.74314482547739424)
+	t375 := t323 * complex(0.30901699437494745, 0.95105651629515353)
+	t376 := t77 + t374
+	t377 := t77 - t374
+	t378 := t373 + t375
+	t379 := t373 - t375
+	t380 := complex(-imag(t379), real(t379))
+	t381 := t376 + t378
+	t382 := t377 + t380
+	t383 := t376 - t378
+	t384 := t377 - t380
+	t385 := t126 * complex(0.8660254037844386, 0.5)
+	t386 := t208 * complex(0.5, 0.8660254037844386)
+	t387 := complex(-imag(t290), real(t290))
+	t388 := t44 + t386
+	t389 := t44 - t386
+	t390 := t385 + t387
+	t391 := t385 - t387
+	t392 := complex(-imag(t391), real(t391))
+	t393 := t388 + t390
+	t394 := t389 + t392
+	t395 := t388 - t390
+	t396 := t389 - t392
+	t397 := t135 * complex(0.80901699437494745, 0.58778525229247314)
+	t398 := t217 * complex(0.30901699437494745, 0.95105651629515353)
+	t399 := t299 * complex(-0.30901699437494745, 0.95105651629515353)
+	t400 := t53 + t398
+	t401 := t53 - t398
+	t402 := t397 + t399
+	t403 := t397 - t399
+	t404 := complex(-imag(t403), real(t403))
+	t405 := t400 + t402
+	t406 := t401 + t404
+	t407 := t400 - t402
+	t408 := t401 - t404
+	t409 := t144 * complex(0.74314482547739424, 0.66913060635885824)
+	t410 := t226 * complex(0.10452846326765347, 0.99452189536827329)
+	t411 := t308 * complex(-0.58778525229247314, 0.80901699437494745)
+	t412 := t62 + t410
+	t413 := t62 - t410
+	t414 := t409 + t411
+	t415 := t409 - t411
+	t416 := complex(-imag(t415), real(t415))
+	t417 := t412 + t414
+	t418 := t413 + t416
+	t419 := t412 - t414
+	t420 := t413 - t416
+	t421 := t153 * complex(0.66913060635885824, 0.74314482547739424)
+	t422 := t235 * complex(-0.10452846326765347, 0.99452189536827329)
+	t423 := t317 * complex(-0.80901699437494745, 0.58778525229247314)
+	t424 := t71 + t422
+	t425 := t71 - t422
+	t426 := t421 + t423
+	t427 := t421 - t423
+	t428 := complex(-imag(t427), real(t427))
+	t429 := t424 + t426
+	t430 := t425 + t428
+	t431 := t424 - t426
+	t432 := t425 - t428
+	t433 := t162 * complex(0.58778525229247314, 0.80901699437494745)
+	t434 := t244 * complex(-0.30901699437494745, 0.95105651629515353)
+	t435 := t326 * complex(-0.95105651629515353, 0.30901699437494745)
+	t436 := t80 + t434
+	t437 := t80 - t434
+	t438 := t433 + t435
+	t439 := t433 - t435
+	t440 := complex(-imag(t439), real(t439))
+	t441 := t436 + t438
+	t442 := t437 + t440
+	t443 := t436 - t438
+	t444 := t437 - t440
+	t445 := t127 * complex(0.5, 0.8660254037844386)
+	t446 := t209 * complex(-0.5, 0.8660254037844386)
+	t447 := -t291
+	t448 := t45 + t446
+	t449 := t45 - t446
+	t450 := t445 + t447
+	t451 := t445 - t447
+	t452 := complex(-imag(t451), real(t451))
+	t453 := t448 + t450
+	t454 := t449 + t452
+	t455 := t448 - t450
+	t456 := t449 - t452
+	t457 := t136 * complex(0.40673664307580021, 0.91354545764260087)
+	t458 := t218 * complex(-0.66913060635885824, 0.74314482547739424)
+	t459 := t300 * complex(-0.95105651629515353, -0.30901699437494745)
+	t460 := t54 + t458
+	t461 := t54 - t458
+	t462 := t457 + t459
+	t463 := t457 - t459
+	t464 := complex(-imag(t463), real(t463))
+	t465 := t460 + t462
+	t466 := t461 + t464
+	t467 := t460 - t462
+	t468 := t461 - t464
+	t469 := t145 * complex(0.30901699437494745, 0.95105651629515353)
+	t470 := t227 * complex(-0.80901699437494745, 0.58778525229247314)
+	t471 := t309 * complex(-0.80901699437494745, -0.58778525229247314)
+	t472 := t63 + t470
+	t473 := t63 - t470
+	t474 := t469 + t471
+	t475 := t469 - t471
+	t476 := complex(-imag(t475), real(t475))
+	t477 := t472 + t474
+	t478 := t473 + t476
+	t479 := t472 - t474
+	t480 := t473 - t476
+	t481 := t154 * complex(0.20791169081775934, 0.97814760073380569)
+	t482 := t236 * complex(-0.91354545764260087, 0.40673664307580021)
+	t483 := t318 * complex(-0.58778525229247314, -0.80901699437494745)
+	t484 := t72 + t482
+	t485 := t72 - t482
+	t486 := t481 + t483
+	t487 := t481 - t483
+	t488 := complex(-imag(t487), real(t487))
+	t489 := t484 + t486
+	t490 := t485 + t488
+	t491 := t484 - t486
+	t492 := t485 - t488
+	t493 := t163 * complex(0.10452846326765347, 0.99452189536827329)
+	t494 := t245 * complex(-0.97814760073380569, 0.20791169081775934)
+	t495 := t327 * complex(-0.30901699437494745, -0.95105651629515353)
+	t496 := t81 + t494
+	t497 := t81 - t494
+	t498 := t493 + t495
+	t499 := t493 - t495
+	t500 := complex(-imag(t499), real(t499))
+	t501 := t496 + t498
+	t502 := t497 + t500
+	t503 := t496 - t498
+	t504 := t497 - t500
+
+	const scale = 1.0 / 60
+
+	d[0] = complex(real(t333)*scale, imag(t333)*scale)
+	d[1] = complex(real(t345)*scale, imag(t345)*scale)
+	d[2] = complex(real(t357)*scale, imag(t357)*scale)
+	d[3] = complex(real(t369)*scale, imag(t369)*scale)
+	d[4] = complex(real(t381)*scale, imag(t381)*scale)
+	d[5] = complex(real(t393)*scale, imag(t393)*scale)
+	d[6] = complex(real(t405)*scale, imag(t405)*scale)
+	d[7] = complex(real(t417)*scale, imag(t417)*scale)
+	d[8] = complex(real(t429)*scale, imag(t429)*scale)
+	d[9] = complex(real(t441)*scale, imag(t441)*scale)
+	d[10] = complex(real(t453)*scale, imag(t453)*scale)
+	d[11] = complex(real(t465)*scale, imag(t465)*scale)
+	d[12] = complex(real(t477)*scale, imag(t477)*scale)
+	d[13] = complex(real(t489)*scale, imag(t489)*scale)
+	d[14] = complex(real(t501)*scale, imag(t501)*scale)
+	d[15] = complex(real(t334)*scale, imag(t334)*scale)
+	d[16] = complex(real(t346)*scale, imag(t346)*scale)
+	d[17] = complex(real(t358)*scale, imag(t358)*scale)
+	d[18] = complex(real(t370)*scale, imag(t370)*scale)
+	d[19] = complex(real(t382)*scale, imag(t382)*scale)
+	d[20] = complex(real(t394)*scale, imag(t394)*scale)
+	d[21] = complex(real(t406)*scale, imag(t406)*scale)
+	d[22] = complex(real(t418)*scale, imag(t418)*scale)
+	d[23] = complex(real(t430)*scale, imag(t430)*scale)
+	d[24] = complex(real(t442)*scale, imag(t442)*scale)
+	d[25] = complex(real(t454)*scale, imag(t454)*scale)
+	d[26] = complex(real(t466)*scale, imag(t466)*scale)
+	d[27] = complex(real(t478)*scale, imag(t478)*scale)
+	d[28] = complex(real(t490)*scale, imag(t490)*scale)
+	d[29] = complex(real(t502)*scale, imag(t502)*scale)
+	d[30] = complex(real(t335)*scale, imag(t335)*scale)
+	d[31] = complex(real(t347)*scale, imag(t347)*scale)
+	d[32] = complex(real(t359)*scale, imag(t359)*scale)
+	d[33] = complex(real(t371)*scale, imag(t371)*scale)
+	d[34] = complex(real(t383)*scale, imag(t383)*scale)
+	d[35] = complex(real(t395)*scale, imag(t395)*scale)
+	d[36] = complex(real(t407)*scale, imag(t407)*scale)
+	d[37] = complex(real(t419)*scale, imag(t419)*scale)
+	d[38] = complex(real(t431)*scale, imag(t431)*scale)
+	d[39] = complex(real(t443)*scale, imag(t443)*scale)
+	d[40] = complex(real(t455)*scale, imag(t455)*scale)
+	d[41] = complex(real(t467)*scale, imag(t467)*scale)
+	d[42] = complex(real(t479)*scale, imag(t479)*scale)
+	d[43] = complex(real(t491)*scale, imag(t491)*scale)
+	d[44] = complex(real(t503)*scale, imag(t503)*scale)
+	d[45] = complex(real(t336)*scale, imag(t336)*scale)
+	d[46] = complex(real(t348)*scale, imag(t348)*scale)
+	d[47] = complex(real(t360)*scale, imag(t360)*scale)
+	d[48] = complex(real(t372)*scale, imag(t372)*scale)
+	d[49] = complex(real(t384)*scale, imag(t384)*scale)
+	d[50] = complex(real(t396)*scale, imag(t396)*scale)
+	d[51] = complex(real(t408)*scale, imag(t408)*scale)
+	d[52] = complex(real(t420)*scale, imag(t420)*scale)
+	d[53] = complex(real(t432)*scale, imag(t432)*scale)
+	d[54] = complex(real(t444)*scale, imag(t444)*scale)
+	d[55] = complex(real(t456)*scale, imag(t456)*scale)
+	d[56] = complex(real(t468)*scale, imag(t468)*scale)
+	d[57] = complex(real(t480)*scale, imag(t480)*scale)
+	d[58] = complex(real(t492)*scale, imag(t492)*scale)
+	d[59] = complex(real(t504)*scale, imag(t504)*scale)
+
+	return true
+}
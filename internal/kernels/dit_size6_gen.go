@@ -0,0 +1,219 @@
+// Code generated by gencodelets. DO NOT EDIT.
+
+package kernels
+
+// forwardDIT6GenComplex64 computes a 6-point forward FFT for complex64 data as
+// straight-line code (radices 2x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT6GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 6
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+
+	t0 := x2 + x4
+	t1 := x2 - x4
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t6 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t7 := x3 + x5
+	t8 := x3 - x5
+	t9 := x1 + t7
+	t10 := complex(real(x1)-0.5*real(t7), imag(x1)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t13 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t14 := t2 + t9
+	t15 := t2 - t9
+	t16 := t12 * complex(0.5, -0.8660254037844386)
+	t17 := t5 + t16
+	t18 := t5 - t16
+	t19 := t13 * complex(-0.5, -0.8660254037844386)
+	t20 := t6 + t19
+	t21 := t6 - t19
+
+	d[0] = t14
+	d[1] = t17
+	d[2] = t20
+	d[3] = t15
+	d[4] = t18
+	d[5] = t21
+
+	return true
+}
+
+// inverseDIT6GenComplex64 computes a 6-point inverse (scaled by 1/n) FFT for complex64 data as
+// straight-line code (radices 2x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT6GenComplex64(dst, src, twiddle, scratch []complex64, bitrev []int) bool {
+	const n = 6
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+
+	t0 := x2 + x4
+	t1 := x2 - x4
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t6 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t7 := x3 + x5
+	t8 := x3 - x5
+	t9 := x1 + t7
+	t10 := complex(real(x1)-0.5*real(t7), imag(x1)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t13 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t14 := t2 + t9
+	t15 := t2 - t9
+	t16 := t12 * complex(0.5, 0.8660254037844386)
+	t17 := t5 + t16
+	t18 := t5 - t16
+	t19 := t13 * complex(-0.5, 0.8660254037844386)
+	t20 := t6 + t19
+	t21 := t6 - t19
+
+	const scale = 1.0 / 6
+
+	d[0] = complex(real(t14)*scale, imag(t14)*scale)
+	d[1] = complex(real(t17)*scale, imag(t17)*scale)
+	d[2] = complex(real(t20)*scale, imag(t20)*scale)
+	d[3] = complex(real(t15)*scale, imag(t15)*scale)
+	d[4] = complex(real(t18)*scale, imag(t18)*scale)
+	d[5] = complex(real(t21)*scale, imag(t21)*scale)
+
+	return true
+}
+
+// forwardDIT6GenComplex128 computes a 6-point forward FFT for complex128 data as
+// straight-line code (radices 2x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func forwardDIT6GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 6
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+
+	t0 := x2 + x4
+	t1 := x2 - x4
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t6 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t7 := x3 + x5
+	t8 := x3 - x5
+	t9 := x1 + t7
+	t10 := complex(real(x1)-0.5*real(t7), imag(x1)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t13 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t14 := t2 + t9
+	t15 := t2 - t9
+	t16 := t12 * complex(0.5, -0.8660254037844386)
+	t17 := t5 + t16
+	t18 := t5 - t16
+	t19 := t13 * complex(-0.5, -0.8660254037844386)
+	t20 := t6 + t19
+	t21 := t6 - t19
+
+	d[0] = t14
+	d[1] = t17
+	d[2] = t20
+	d[3] = t15
+	d[4] = t18
+	d[5] = t21
+
+	return true
+}
+
+// inverseDIT6GenComplex128 computes a 6-point inverse (scaled by 1/n) FFT for complex128 data as
+// straight-line code (radices 2x3). twiddle, scratch and bitrev are unused;
+// dst may alias src. Returns false if a slice is too small.
+func inverseDIT6GenComplex128(dst, src, twiddle, scratch []complex128, bitrev []int) bool {
+	const n = 6
+
+	if len(dst) < n || len(src) < n {
+		return false
+	}
+
+	s := src[:n]
+	d := dst[:n]
+
+	x0 := s[0]
+	x1 := s[1]
+	x2 := s[2]
+	x3 := s[3]
+	x4 := s[4]
+	x5 := s[5]
+
+	t0 := x2 + x4
+	t1 := x2 - x4
+	t2 := x0 + t0
+	t3 := complex(real(x0)-0.5*real(t0), imag(x0)-0.5*imag(t0))
+	t4 := complex(0.8660254037844386*real(t1), 0.8660254037844386*imag(t1))
+	t5 := complex(real(t3)-imag(t4), imag(t3)+real(t4))
+	t6 := complex(real(t3)+imag(t4), imag(t3)-real(t4))
+	t7 := x3 + x5
+	t8 := x3 - x5
+	t9 := x1 + t7
+	t10 := complex(real(x1)-0.5*real(t7), imag(x1)-0.5*imag(t7))
+	t11 := complex(0.8660254037844386*real(t8), 0.8660254037844386*imag(t8))
+	t12 := complex(real(t10)-imag(t11), imag(t10)+real(t11))
+	t13 := complex(real(t10)+imag(t11), imag(t10)-real(t11))
+	t14 := t2 + t9
+	t15 := t2 - t9
+	t16 := t12 * complex(0.5, 0.8660254037844386)
+	t17 := t5 + t16
+	t18 := t5 - t16
+	t19 := t13 * complex(-0.5, 0.8660254037844386)
+	t20 := t6 + t19
+	t21 := t6 - t19
+
+	const scale = 1.0 / 6
+
+	d[0] = complex(real(t14)*scale, imag(t14)*scale)
+	d[1] = complex(real(t17)*scale, imag(t17)*scale)
+	d[2] = complex(real(t20)*scale, imag(t20)*scale)
+	d[3] = complex(real(t15)*scale, imag(t15)*scale)
+	d[4] = complex(real(t18)*scale, imag(t18)*scale)
+	d[5] = complex(real(t21)*scale, imag(t21)*scale)
+
+	return true
+}
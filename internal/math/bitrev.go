@@ -181,6 +181,26 @@ func ComputeBitReversalIndicesMixed24(n int) []int {
 	return ComputePermutationIndices(n, -24)
 }
 
+// ComputeSequencyIndices returns the permutation that maps sequency (Walsh)
+// order to natural (Hadamard) order for a length-n Walsh-Hadamard transform:
+// the Walsh function with k sign changes is row ReverseBits(gray(k)) of the
+// natural-order Hadamard matrix, where gray(k) = k XOR (k >> 1).
+// n must be a power of 2; returns nil otherwise.
+func ComputeSequencyIndices(n int) []int {
+	if n <= 0 || n&(n-1) != 0 {
+		return nil
+	}
+
+	indices := make([]int, n)
+
+	nbits := bits.Len(uint(n)) - 1
+	for k := range n {
+		indices[k] = ReverseBits(k^(k>>1), nbits)
+	}
+
+	return indices
+}
+
 // ReverseBits reverses the lower 'nbits' bits of x using hardware bit reversal.
 // Example: ReverseBits(6, 3) = ReverseBits(0b110, 3) = 0b011 = 3.
 func ReverseBits(x, nbits int) int {
@@ -187,6 +187,28 @@ func TestComputeBitReversalIndicesNonPowerOfTwo(t *testing.T) {
 	}
 }
 
+func TestComputeSequencyIndices(t *testing.T) {
+	t.Parallel()
+
+	// Sequency order for n=8 expressed as natural-order Hadamard rows.
+	want := []int{0, 4, 6, 2, 3, 7, 5, 1}
+
+	got := ComputeSequencyIndices(8)
+	if len(got) != len(want) {
+		t.Fatalf("length = %d, want %d", len(got), len(want))
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("indices[%d] = %d, want %d", i, got[i], want[i])
+		}
+	}
+
+	if ComputeSequencyIndices(12) != nil {
+		t.Error("ComputeSequencyIndices(12) should return nil for non-power-of-2 size")
+	}
+}
+
 // Helper functions
 
 func isPowerOfTwo(n int) bool {
@@ -28,16 +28,17 @@ func Factorize(n int) []int {
 }
 
 // IsHighlyComposite reports whether n only contains 2, 3, or 5 factors.
+// It does not allocate, as it runs on the transform path.
 func IsHighlyComposite(n int) bool {
 	if n <= 0 {
 		return false
 	}
 
-	for _, factor := range Factorize(n) {
-		if factor != 2 && factor != 3 && factor != 5 {
-			return false
+	for _, p := range [...]int{2, 3, 5} {
+		for n%p == 0 {
+			n /= p
 		}
 	}
 
-	return true
+	return n == 1
 }
@@ -1,6 +1,10 @@
 package memory
 
-import "unsafe"
+import (
+	"unsafe"
+
+	"github.com/MeKo-Christian/algo-fft/internal/fftypes"
+)
 
 // AlignmentBytes defines the byte alignment for SIMD-friendly buffers.
 const AlignmentBytes = 64
@@ -39,6 +43,27 @@ func AllocAlignedComplex128(n int) ([]complex128, []byte) {
 	return data, raw
 }
 
+// AllocAligned allocates a slice of numeric elements aligned to AlignmentBytes.
+// The returned raw slice must be kept alive to avoid GC reclaiming the backing memory.
+// T is restricted to pointer-free scalars because the garbage collector does
+// not scan the []byte backing store for pointers.
+func AllocAligned[T fftypes.Scalar](n int) ([]T, []byte) {
+	if n <= 0 {
+		return nil, nil
+	}
+
+	var zero T
+
+	size := n * int(unsafe.Sizeof(zero))
+	raw := make([]byte, size+AlignmentBytes-1)
+	base := uintptr(unsafe.Pointer(&raw[0]))
+	aligned := AlignPtr(base, AlignmentBytes)
+	offset := int(aligned - base)
+	data := unsafe.Slice((*T)(unsafe.Pointer(&raw[offset])), n)
+
+	return data, raw
+}
+
 // AlignPtr aligns ptr to the given alignment boundary.
 func AlignPtr(ptr uintptr, alignment int) uintptr {
 	mask := uintptr(alignment - 1)
@@ -299,3 +299,30 @@ func BenchmarkAlignPtr(b *testing.B) {
 		})
 	}
 }
+
+// TestAllocAlignedGeneric tests SIMD-aligned allocation for scalar element types.
+func TestAllocAlignedGeneric(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range []int{1, 7, 64, 1000} {
+		data, backing := AllocAligned[float32](size)
+
+		if len(data) != size {
+			t.Errorf("size=%d: len(data)=%d, want %d", size, len(data), size)
+		}
+
+		if backing == nil {
+			t.Errorf("size=%d: backing buffer is nil", size)
+		}
+
+		ptr := uintptr(unsafe.Pointer(&data[0]))
+		if ptr%AlignmentBytes != 0 {
+			t.Errorf("size=%d: data pointer 0x%x not %d-byte aligned", size, ptr, AlignmentBytes)
+		}
+	}
+
+	data, backing := AllocAligned[float64](0)
+	if data != nil || backing != nil {
+		t.Errorf("AllocAligned(0) = %v, %v; want nil, nil", data, backing)
+	}
+}
@@ -0,0 +1,76 @@
+package planner
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MaxMixedRadix is the largest radix a mixed-radix schedule may use.
+const MaxMixedRadix = 16
+
+// mixedRadixAlgorithmPrefix marks wisdom entries that carry a factor order.
+const mixedRadixAlgorithmPrefix = "mixedradix_"
+
+// ValidMixedRadixSchedule reports whether radices is a usable factor order
+// for size n: every radix is in [2, MaxMixedRadix] and their product is n.
+func ValidMixedRadixSchedule(n int, radices []int) bool {
+	if n < 2 || len(radices) == 0 {
+		return false
+	}
+
+	product := 1
+
+	for _, r := range radices {
+		if r < 2 || r > MaxMixedRadix || product > n/r {
+			return false
+		}
+
+		product *= r
+	}
+
+	return product == n
+}
+
+// MixedRadixAlgorithmName returns the algorithm name recorded for a
+// mixed-radix plan with the given factor order, e.g. "mixedradix_8x8x6".
+func MixedRadixAlgorithmName(radices []int) string {
+	return mixedRadixAlgorithmPrefix + FormatRadices(radices)
+}
+
+// FormatRadices renders a factor order as "8x8x6".
+func FormatRadices(radices []int) string {
+	var b strings.Builder
+
+	for i, r := range radices {
+		if i > 0 {
+			b.WriteByte('x')
+		}
+
+		b.WriteString(strconv.Itoa(r))
+	}
+
+	return b.String()
+}
+
+// ParseMixedRadixAlgorithm extracts the factor order from an algorithm name
+// produced by MixedRadixAlgorithmName. Returns false for any other name.
+func ParseMixedRadixAlgorithm(algorithm string) ([]int, bool) {
+	spec, ok := strings.CutPrefix(algorithm, mixedRadixAlgorithmPrefix)
+	if !ok || spec == "" {
+		return nil, false
+	}
+
+	parts := strings.Split(spec, "x")
+	radices := make([]int, len(parts))
+
+	for i, part := range parts {
+		r, err := strconv.Atoi(part)
+		if err != nil || r < 2 {
+			return nil, false
+		}
+
+		radices[i] = r
+	}
+
+	return radices, true
+}
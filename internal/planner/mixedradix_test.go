@@ -0,0 +1,68 @@
+package planner
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/internal/cpu"
+)
+
+func TestMixedRadixAlgorithmNameRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	name := MixedRadixAlgorithmName([]int{8, 8, 6})
+	if name != "mixedradix_8x8x6" {
+		t.Fatalf("MixedRadixAlgorithmName = %q, want mixedradix_8x8x6", name)
+	}
+
+	radices, ok := ParseMixedRadixAlgorithm(name)
+	if !ok || !slices.Equal(radices, []int{8, 8, 6}) {
+		t.Fatalf("ParseMixedRadixAlgorithm(%q) = %v, %v", name, radices, ok)
+	}
+
+	for _, bad := range []string{"stockham", "mixedradix_", "mixedradix_8xx6", "mixedradix_1x8"} {
+		if _, ok := ParseMixedRadixAlgorithm(bad); ok {
+			t.Errorf("ParseMixedRadixAlgorithm(%q) succeeded, want failure", bad)
+		}
+	}
+}
+
+func TestEstimatePlanReproducesMeasuredFactorOrder(t *testing.T) {
+	t.Parallel()
+
+	features := cpu.Features{Architecture: "amd64", HasSSE2: true}
+	mask := CPUFeatureMask(true, false, false, false)
+
+	wisdom := NewWisdom()
+	wisdom.Store(WisdomEntry{Key: WisdomKey{Size: 384, CPUFeatures: mask}, Algorithm: "mixedradix_8x8x6"})
+	wisdom.Store(WisdomEntry{Key: WisdomKey{Size: 1001, CPUFeatures: mask}, Algorithm: "mixedradix_13x11x7"})
+	wisdom.Store(WisdomEntry{Key: WisdomKey{Size: 6000, CPUFeatures: mask}, Algorithm: "recursive"})
+	wisdom.Store(WisdomEntry{Key: WisdomKey{Size: 1500, CPUFeatures: mask}, Algorithm: "mixedradix_8x8x8"})
+
+	estimate := EstimatePlan[complex64](384, features, wisdom, KernelAuto)
+	if estimate.Strategy != KernelDIT || !slices.Equal(estimate.Radices, []int{8, 8, 6}) {
+		t.Fatalf("EstimatePlan(384) = %v %v, want DIT [8 8 6]", estimate.Strategy, estimate.Radices)
+	}
+
+	estimate = EstimatePlan[complex64](1001, features, wisdom, KernelAuto)
+	if estimate.Strategy != KernelDIT || !slices.Equal(estimate.Radices, []int{13, 11, 7}) {
+		t.Fatalf("EstimatePlan(1001) = %v %v, want DIT [13 11 7]", estimate.Strategy, estimate.Radices)
+	}
+
+	estimate = EstimatePlan[complex64](6000, features, wisdom, KernelAuto)
+	if estimate.Strategy != KernelRecursive {
+		t.Fatalf("EstimatePlan(6000) = %v, want KernelRecursive", estimate.Strategy)
+	}
+
+	// A factor order that does not multiply to the size is ignored.
+	estimate = EstimatePlan[complex64](1500, features, wisdom, KernelAuto)
+	if estimate.Radices != nil {
+		t.Fatalf("EstimatePlan(1500) used invalid wisdom radices %v", estimate.Radices)
+	}
+
+	// Precision mismatch: no wisdom for complex128.
+	estimate128 := EstimatePlan[complex128](1001, features, wisdom, KernelAuto)
+	if estimate128.Strategy != KernelBluestein {
+		t.Fatalf("EstimatePlan[complex128](1001) = %v, want KernelBluestein", estimate128.Strategy)
+	}
+}
@@ -28,6 +28,10 @@ type PlanEstimate[T Complex] struct {
 
 	// BitrevFunc is the bit-reversal generator for this codelet (nil if no bit-reversal needed)
 	BitrevFunc BitrevFunc
+
+	// Radices is the mixed-radix factor order to execute, outermost stage
+	// first (nil = the kernel's default schedule).
+	Radices []int
 }
 
 // EstimatePlan determines the best kernel/codelet for the given size.
@@ -47,8 +51,23 @@ func EstimatePlan[T Complex](n int, features cpu.Features, wisdom WisdomStore, f
 		strategy = forcedStrategy
 	}
 
-	// For Bluestein, there are no codelets
+	// For Bluestein, there are no codelets. Measured wisdom may still have
+	// found a mixed-radix order (radices up to MaxMixedRadix) or a recursive
+	// decomposition that beats it.
 	if !IsPowerOf2(n) && !IsHighlyComposite(n) {
+		if algorithm, found := lookupWisdom[T](n, features, wisdom); found && forcedStrategy == KernelAuto {
+			if estimate, ok := mixedRadixEstimate[T](n, algorithm); ok {
+				return estimate
+			}
+
+			if algorithm == StrategyToAlgorithmName(KernelRecursive) {
+				return PlanEstimate[T]{
+					Strategy:  KernelRecursive,
+					Algorithm: algorithm,
+				}
+			}
+		}
+
 		return PlanEstimate[T]{
 			Strategy:  KernelBluestein,
 			Algorithm: "bluestein",
@@ -77,21 +96,7 @@ func EstimatePlan[T Complex](n int, features cpu.Features, wisdom WisdomStore, f
 wisdomFallback:
 	// 2. Try wisdom cache (if provided)
 	if wisdom != nil {
-		var (
-			precision uint8
-			zero      T
-		)
-
-		switch any(zero).(type) {
-		case complex64:
-			precision = 0
-		case complex128:
-			precision = 1
-		}
-
-		cpuFeatures := CPUFeatureMask(features.HasSSE2, features.HasAVX2, features.HasAVX512, features.HasNEON)
-
-		if algorithm, found := wisdom.LookupWisdom(n, precision, cpuFeatures); found {
+		if algorithm, found := lookupWisdom[T](n, features, wisdom); found {
 			// Wisdom provides algorithm name, try to bind specific codelet by signature
 			if registry != nil {
 				if codelet := registry.LookupBySignature(n, algorithm); codelet != nil {
@@ -109,6 +114,13 @@ wisdomFallback:
 				}
 			}
 
+			// Measured mixed-radix winners carry their factor order.
+			if forcedStrategy == KernelAuto || forcedStrategy == KernelDIT {
+				if estimate, ok := mixedRadixEstimate[T](n, algorithm); ok {
+					return estimate
+				}
+			}
+
 			// Wisdom algorithm doesn't match a codelet, apply as kernel strategy
 			switch algorithm {
 			case "dit_fallback":
@@ -121,6 +133,8 @@ wisdomFallback:
 				strategy = KernelEightStep
 			case "bluestein":
 				strategy = KernelBluestein
+			case "recursive":
+				strategy = KernelRecursive
 			}
 
 			if forcedStrategy != KernelAuto && strategy != forcedStrategy {
@@ -139,6 +153,44 @@ strategyFallback:
 	}
 }
 
+// lookupWisdom returns the recorded algorithm name for size n at T's precision.
+func lookupWisdom[T Complex](n int, features cpu.Features, wisdom WisdomStore) (string, bool) {
+	if wisdom == nil {
+		return "", false
+	}
+
+	var (
+		precision uint8
+		zero      T
+	)
+
+	switch any(zero).(type) {
+	case complex64:
+		precision = 0
+	case complex128:
+		precision = 1
+	}
+
+	cpuFeatures := CPUFeatureMask(features.HasSSE2, features.HasAVX2, features.HasAVX512, features.HasNEON)
+
+	return wisdom.LookupWisdom(n, precision, cpuFeatures)
+}
+
+// mixedRadixEstimate turns a "mixedradix_<order>" wisdom entry into an
+// estimate that reproduces the measured factor order.
+func mixedRadixEstimate[T Complex](n int, algorithm string) (PlanEstimate[T], bool) {
+	radices, ok := ParseMixedRadixAlgorithm(algorithm)
+	if !ok || !ValidMixedRadixSchedule(n, radices) {
+		return PlanEstimate[T]{}, false
+	}
+
+	return PlanEstimate[T]{
+		Strategy:  KernelDIT,
+		Algorithm: algorithm,
+		Radices:   radices,
+	}, true
+}
+
 // HasCodelet returns true if a codelet is available for the given size.
 func HasCodelet[T Complex](n int, features cpu.Features) bool {
 	registry := GetRegistry[T]()
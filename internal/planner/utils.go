@@ -64,6 +64,8 @@ func StrategyToAlgorithmName(strategy KernelStrategy) string {
 		return "eightstep"
 	case KernelBluestein:
 		return "bluestein"
+	case KernelRecursive:
+		return "recursive"
 	default:
 		return "unknown"
 	}
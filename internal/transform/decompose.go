@@ -18,7 +18,7 @@ type DecomposeStrategy struct {
 // It recursively decomposes the problem until reaching sizes with codelets.
 //
 // Parameters:
-//   - n: FFT size (must be power of 2)
+//   - n: FFT size (non-powers of two split on any divisor; prime leaves use a direct DFT)
 //   - codeletSizes: Available codelet sizes (sorted ascending)
 //   - cacheSize: L1 cache size in bytes for optimization
 //
@@ -67,9 +67,18 @@ func PlanDecomposition(n int, codeletSizes []int, cacheSize int) *DecomposeStrat
 		}
 	}
 
-	// Fallback: if no strategy found, use radix-2 split
-	if bestStrategy == nil && len(factors) > 0 {
-		radix := 2
+	// Prime sizes cannot be split; they are computed directly at the leaf.
+	if len(factors) == 0 {
+		return &DecomposeStrategy{
+			Size:       n,
+			UseCodelet: true,
+		}
+	}
+
+	// Fallback: if no strategy scored, use the smallest split
+	// (radix-2 for powers of two).
+	if bestStrategy == nil {
+		radix := factors[len(factors)-1]
 		subSize := n / radix
 		bestStrategy = &DecomposeStrategy{
 			Size:        n,
@@ -134,20 +143,27 @@ func min(a, b int) int {
 	return b
 }
 
-// findFactors returns all divisors of n that are power-of-2, EXCLUDING n itself.
-// Returns them in descending order (largest first).
+// findFactors returns the split candidates for n, EXCLUDING n itself, in
+// descending order (largest first). For powers of two these are the
+// power-of-two divisors; for other sizes every proper divisor is a candidate.
+// Primes have no candidates and become leaves.
 //
 // For example, findFactors(8192) returns [4096, 2048, 1024, 512, 256, 128, 64, 32, 16, 8, 4, 2].
 func findFactors(n int) []int {
-	if !IsPowerOf2(n) {
-		return []int{2} // Fallback to radix-2 for non-power-of-2
-	}
-
 	factors := []int{}
-	// Start from 2, go up to n/2 (exclude n itself, since that would give subSize=1)
-	for divisor := 2; divisor < n; divisor *= 2 {
-		if n%divisor == 0 {
-			factors = append(factors, divisor)
+
+	if !IsPowerOf2(n) {
+		for divisor := 2; divisor < n; divisor++ {
+			if n%divisor == 0 {
+				factors = append(factors, divisor)
+			}
+		}
+	} else {
+		// Start from 2, go up to n/2 (exclude n itself, since that would give subSize=1)
+		for divisor := 2; divisor < n; divisor *= 2 {
+			if n%divisor == 0 {
+				factors = append(factors, divisor)
+			}
 		}
 	}
 
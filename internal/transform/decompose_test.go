@@ -212,10 +212,11 @@ func TestFindFactors(t *testing.T) {
 		want    []int
 		wantLen int
 	}{
-		{8, []int{4, 2}, 2},     // Excludes 8 itself
-		{16, []int{8, 4, 2}, 3}, // Excludes 16 itself
-		{1024, nil, 9},          // 2, 4, 8, ..., 512 (9 factors, excludes 1024)
-		{7, []int{2}, 1},        // Non-power-of-2, fallback to radix-2
+		{8, []int{4, 2}, 2},        // Excludes 8 itself
+		{16, []int{8, 4, 2}, 3},    // Excludes 16 itself
+		{1024, nil, 9},             // 2, 4, 8, ..., 512 (9 factors, excludes 1024)
+		{12, []int{6, 4, 3, 2}, 4}, // Non-power-of-2: every proper divisor
+		{7, []int{}, 0},            // Prime: no split, becomes a leaf
 	}
 
 	for _, tt := range tests {
@@ -240,12 +241,10 @@ func TestFindFactors(t *testing.T) {
 				}
 			}
 
-			// All factors should divide n evenly (except for non-power-of-2 fallback)
-			if IsPowerOf2(tt.n) {
-				for _, f := range factors {
-					if tt.n%f != 0 {
-						t.Errorf("Factor %d does not divide %d", f, tt.n)
-					}
+			// All factors should divide n evenly
+			for _, f := range factors {
+				if tt.n%f != 0 {
+					t.Errorf("Factor %d does not divide %d", f, tt.n)
 				}
 			}
 
@@ -61,6 +61,12 @@ func recursiveForwardWithTwiddle[T Complex](
 
 			return twiddleOffset + n
 		}
+		if !IsPowerOf2(n) {
+			dftLeaf(dst, src, twiddleSlice, scratch, false)
+
+			return twiddleOffset + n
+		}
+
 		// Fallback to generic DIT if codelet missing (should not happen if registry is correct)
 		ditForward(dst, src, twiddleSlice, scratch, ComputeBitReversalIndices(n))
 
@@ -189,6 +195,12 @@ func recursiveInverseWithTwiddle[T Complex](
 
 			return twiddleOffset + n
 		}
+		if !IsPowerOf2(n) {
+			dftLeaf(dst, src, twiddleSlice, scratch, true)
+
+			return twiddleOffset + n
+		}
+
 		// Fallback
 		ditInverse(dst, src, twiddleSlice, scratch, ComputeBitReversalIndices(n))
 
@@ -261,6 +273,35 @@ func recursiveInverseWithTwiddle[T Complex](
 	return twiddleOffset
 }
 
+// dftLeaf computes a direct O(n²) DFT for leaves that have no codelet and
+// cannot be split further (prime sizes of non-power-of-two decompositions).
+// twiddle holds W_n^k for k = 0..n-1; the result is built in scratch so dst
+// may alias src. The inverse is scaled by 1/n like the DIT fallback.
+func dftLeaf[T Complex](dst, src, twiddle, scratch []T, inverse bool) {
+	n := len(src)
+
+	for k := range n {
+		var sum T
+
+		for j := range n {
+			w := twiddle[(j*k)%n]
+			if inverse {
+				w = conj(w)
+			}
+
+			sum += src[j] * w
+		}
+
+		scratch[k] = sum
+	}
+
+	copy(dst[:n], scratch[:n])
+
+	if inverse {
+		scaleComplexSlice(dst[:n], 1.0/float64(n))
+	}
+}
+
 // Helper functions for generating twiddle factors on-the-fly
 // (These are temporary; we'll optimize with precomputation in twiddle_recursive.go)
 
@@ -253,6 +253,46 @@ func TestRecursiveFFTComplex128(t *testing.T) {
 	}
 }
 
+// TestRecursiveFFTNonPowerOf2 checks decompositions that split on arbitrary
+// divisors and finish in direct-DFT prime leaves.
+func TestRecursiveFFTNonPowerOf2(t *testing.T) {
+	t.Parallel()
+
+	codeletSizes := []int{4, 8, 16, 32, 64, 128, 256, 512}
+	features := cpu.DetectFeatures()
+
+	for _, size := range []int{6, 48, 384, 1000, 323} {
+		strategy := PlanDecomposition(size, codeletSizes, 32768)
+
+		input := make([]complex128, size)
+		for i := range input {
+			input[i] = complex(float64(i%7)-3, float64(i%3))
+		}
+
+		output := make([]complex128, size)
+		back := make([]complex128, size)
+		twiddle := TwiddleFactorsRecursive[complex128](strategy)
+		scratch := make([]complex128, ScratchSizeRecursive(strategy))
+
+		recursiveForward(output, input, strategy, twiddle, scratch, Registry128, features)
+
+		expected := naiveDFTComplex128(input)
+		for i := range output {
+			if diff := cmplx128Abs(output[i] - expected[i]); diff > 1e-8 {
+				t.Fatalf("size %d index %d: got %v, want %v", size, i, output[i], expected[i])
+			}
+		}
+
+		recursiveInverse(back, output, strategy, twiddle, scratch, Registry128, features)
+
+		for i := range back {
+			if diff := cmplx128Abs(back[i] - input[i]); diff > 1e-9 {
+				t.Fatalf("size %d round trip index %d: got %v, want %v", size, i, back[i], input[i])
+			}
+		}
+	}
+}
+
 // TestRecursiveFFTSmallSizes tests sizes smaller than smallest codelet.
 func TestRecursiveFFTSmallSizes(t *testing.T) {
 	t.Parallel()
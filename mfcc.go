@@ -0,0 +1,322 @@
+package algofft
+
+import "math"
+
+// Defaults of MFCCOptions.
+const (
+	defaultMFCCCoefficients = 13
+	defaultMFCCLogFloor     = 1e-10
+	defaultMFCCDeltaWidth   = 2
+)
+
+// MFCCOptions configures an MFCC extractor.
+type MFCCOptions struct {
+	// SampleRate is the sample rate of the signal in Hz. It is required.
+	SampleRate float64
+
+	// STFT selects the framing: frame length, hop, window, FFT length,
+	// centring and padding, with the defaults of NewPlanSTFT. Speech
+	// features typically use 25 ms frames with a 10 ms hop and a Hamming
+	// window.
+	STFT STFTOptions
+
+	// PreEmphasis is the coefficient a of the pre-emphasis filter
+	// y[i] = x[i] - a·x[i-1], typically 0.97. Zero disables it.
+	PreEmphasis float64
+
+	// Filterbank configures the mel or Bark filterbank.
+	Filterbank FilterbankOptions
+
+	// LogFloor is the smallest filterbank energy passed to the natural
+	// logarithm. Zero selects 1e-10.
+	LogFloor float64
+
+	// Coefficients is the number of cepstral coefficients kept from the
+	// orthonormal DCT-II of the log energies, including c0. Zero selects
+	// 13, or the number of filters if that is smaller.
+	Coefficients int
+
+	// Lifter is the sinusoidal liftering parameter L of HTK, which scales
+	// coefficient k by 1 + (L/2)·sin(πk/L), typically 22. Zero disables it.
+	Lifter int
+
+	// Deltas appends delta (1) or delta and delta-delta (2) features to
+	// each frame. Zero selects the static coefficients only.
+	Deltas int
+
+	// DeltaWidth is the number of frames N on each side used by the delta
+	// regression Σ n·(c[t+n] - c[t-n]) / (2·Σ n²). Zero selects 2.
+	DeltaWidth int
+}
+
+// MFCC extracts mel-frequency cepstral coefficients (or their Bark
+// counterparts) from signals: pre-emphasis, windowed frames transformed in
+// batches with PlanRealT, power spectra, filterbank energies, log
+// compression, DCT-II, liftering and delta features.
+//
+// Compute does not allocate. An MFCC must not be used by multiple
+// goroutines at the same time.
+type MFCC[F Float, C Complex] struct {
+	stftGeometry
+
+	bins        int
+	coeffs      int
+	features    int
+	deltas      int
+	deltaWidth  int
+	preEmphasis float64
+	logFloor    float64
+
+	fb   *Filterbank[F]
+	plan *PlanRealT[F, C]
+	dct  []float64 // coeffs×filters DCT-II matrix with the lifter applied
+
+	frameBuf []F       // stftBatch frames of fftLen samples
+	spec     []C       // stftBatch spectra of bins values
+	power    []F       // power spectrum of one frame
+	energies []F       // filterbank energies of one frame
+	logs     []float64 // log energies of one frame
+}
+
+// NewMFCC creates an MFCC extractor.
+//
+// Example:
+//
+//	m, err := algofft.NewMFCC[float32, complex64](algofft.MFCCOptions{
+//		SampleRate: 16000,
+//		STFT:       algofft.STFTOptions{FrameLen: 400, Hop: 160, FFTLen: 512},
+//		Lifter:     22,
+//		Deltas:     2,
+//	})
+func NewMFCC[F Float, C Complex](opts MFCCOptions) (*MFCC[F, C], error) {
+	geom, err := newSTFTGeometry(opts.STFT)
+	if err != nil {
+		return nil, err
+	}
+
+	fb, err := NewFilterbank[F](geom.fftLen, opts.SampleRate, opts.Filterbank)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := fb.Filters()
+
+	coeffs := opts.Coefficients
+	if coeffs == 0 {
+		coeffs = min(defaultMFCCCoefficients, filters)
+	}
+
+	if coeffs < 0 || coeffs > filters {
+		return nil, ErrInvalidLength
+	}
+
+	if opts.Lifter < 0 || opts.Deltas < 0 || opts.Deltas > 2 || opts.DeltaWidth < 0 ||
+		!(opts.LogFloor >= 0) || !(opts.PreEmphasis >= 0 && opts.PreEmphasis <= 1) {
+		return nil, ErrInvalidParameter
+	}
+
+	m := &MFCC[F, C]{
+		stftGeometry: geom,
+		bins:         geom.fftLen/2 + 1,
+		coeffs:       coeffs,
+		features:     coeffs * (opts.Deltas + 1),
+		deltas:       opts.Deltas,
+		deltaWidth:   opts.DeltaWidth,
+		preEmphasis:  opts.PreEmphasis,
+		logFloor:     opts.LogFloor,
+		fb:           fb,
+		dct:          make([]float64, coeffs*filters),
+		frameBuf:     make([]F, stftBatch*geom.fftLen),
+		spec:         make([]C, stftBatch*(geom.fftLen/2+1)),
+		power:        make([]F, geom.fftLen/2+1),
+		energies:     make([]F, filters),
+		logs:         make([]float64, filters),
+	}
+
+	if m.deltaWidth == 0 {
+		m.deltaWidth = defaultMFCCDeltaWidth
+	}
+
+	if m.logFloor == 0 {
+		m.logFloor = defaultMFCCLogFloor
+	}
+
+	// Frames are batched through ForwardBatch, as in the spectrogram.
+	planOpts := opts.STFT.PlanOptions
+	planOpts.Batch = 0
+	planOpts.Stride = 0
+
+	m.plan, err = NewPlanRealTWithOptions[F, C](geom.fftLen, planOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	for k := range coeffs {
+		scale := math.Sqrt(2 / float64(filters))
+		if k == 0 {
+			scale = math.Sqrt(1 / float64(filters))
+		}
+
+		if opts.Lifter > 0 {
+			l := float64(opts.Lifter)
+			scale *= 1 + l/2*math.Sin(math.Pi*float64(k)/l)
+		}
+
+		for i := range filters {
+			m.dct[k*filters+i] = scale * math.Cos(math.Pi*float64(k)*(float64(i)+0.5)/float64(filters))
+		}
+	}
+
+	return m, nil
+}
+
+// Frames returns the number of frames of a signal of signalLen samples.
+func (m *MFCC[F, C]) Frames(signalLen int) int {
+	return m.frames(signalLen)
+}
+
+// Coefficients returns the number of cepstral coefficients per frame.
+func (m *MFCC[F, C]) Coefficients() int {
+	return m.coeffs
+}
+
+// Features returns the number of values per frame: the coefficients,
+// followed by their deltas and delta-deltas if enabled.
+func (m *MFCC[F, C]) Features() int {
+	return m.features
+}
+
+// Filterbank returns the filterbank applied to the power spectra.
+func (m *MFCC[F, C]) Filterbank() *Filterbank[F] {
+	return m.fb
+}
+
+// Compute writes the features of signal to dst as a row-major
+// Frames(len(signal))×Features() matrix.
+func (m *MFCC[F, C]) Compute(dst, signal []F) error {
+	if dst == nil || signal == nil {
+		return ErrNilSlice
+	}
+
+	frames := m.frames(len(signal))
+	if frames == 0 {
+		return ErrInvalidLength
+	}
+
+	if len(dst) != frames*m.features {
+		return ErrLengthMismatch
+	}
+
+	for t0 := 0; t0 < frames; t0 += stftBatch {
+		count := min(stftBatch, frames-t0)
+
+		for b := range count {
+			m.fill(m.frameBuf[b*m.fftLen:(b+1)*m.fftLen], signal, (t0+b)*m.hop-m.pad)
+		}
+
+		err := m.plan.ForwardBatch(m.spec, m.frameBuf, count)
+		if err != nil {
+			return err
+		}
+
+		for b := range count {
+			err = m.cepstrum(dst[(t0+b)*m.features:], m.spec[b*m.bins:(b+1)*m.bins])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for d := range m.deltas {
+		m.delta(dst, frames, d*m.coeffs)
+	}
+
+	return nil
+}
+
+// fill pre-emphasizes and windows the frame of x that starts at sample
+// start, extending x by the padding rule.
+func (m *MFCC[F, C]) fill(frame, x []F, start int) {
+	n := len(x)
+
+	for j, w := range m.window {
+		i := start + j
+
+		if i < 0 || i >= n {
+			if m.padding == BoundaryZero {
+				frame[j] = 0
+				continue
+			}
+
+			i = boundaryIndex(i, n, m.padding)
+		}
+
+		v := float64(x[i])
+		if i > 0 {
+			v -= m.preEmphasis * float64(x[i-1])
+		}
+
+		frame[j] = F(w * v)
+	}
+
+	clear(frame[m.frameLen:])
+}
+
+// cepstrum writes the liftered cepstral coefficients of one spectrum to
+// the start of row.
+func (m *MFCC[F, C]) cepstrum(row []F, spec []C) error {
+	for k, v := range spec {
+		c := complex128(v)
+		m.power[k] = F(real(c)*real(c) + imag(c)*imag(c))
+	}
+
+	err := m.fb.Apply(m.energies, m.power)
+	if err != nil {
+		return err
+	}
+
+	for i, e := range m.energies {
+		m.logs[i] = math.Log(max(float64(e), m.logFloor))
+	}
+
+	filters := len(m.logs)
+
+	for k := range m.coeffs {
+		var sum float64
+		for i, v := range m.logs {
+			sum += m.dct[k*filters+i] * v
+		}
+
+		row[k] = F(sum)
+	}
+
+	return nil
+}
+
+// delta writes the regression deltas of the features at offset from of
+// every frame to offset from+coeffs, holding the first and last frames
+// beyond the ends.
+func (m *MFCC[F, C]) delta(dst []F, frames, from int) {
+	var norm float64
+	for n := 1; n <= m.deltaWidth; n++ {
+		norm += 2 * float64(n*n)
+	}
+
+	to := from + m.coeffs
+
+	for t := range frames {
+		row := dst[t*m.features:]
+
+		for k := range m.coeffs {
+			var sum float64
+
+			for n := 1; n <= m.deltaWidth; n++ {
+				next := dst[min(t+n, frames-1)*m.features+from+k]
+				prev := dst[max(t-n, 0)*m.features+from+k]
+				sum += float64(n) * float64(next-prev)
+			}
+
+			row[to+k] = F(sum / norm)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/window"
+)
+
+// naiveMFCC computes the static MFCCs of x frame by frame without
+// centring, with zero padding at the end.
+func naiveMFCC(x []float64, fb *Filterbank[float64], frameLen, hop, fftLen, coeffs, lifter int, preEmphasis float64) [][]float64 {
+	y := make([]float64, len(x))
+	for i, v := range x {
+		y[i] = v
+		if i > 0 {
+			y[i] -= preEmphasis * x[i-1]
+		}
+	}
+
+	win := window.Hamming(frameLen, window.Symmetric)
+	filters := fb.Filters()
+
+	var out [][]float64
+
+	for start := 0; ; start += hop {
+		frame := make([]complex128, fftLen)
+		for j := range frameLen {
+			if start+j < len(y) {
+				frame[j] = complex(win[j]*y[start+j], 0)
+			}
+		}
+
+		spec := naiveDFT(frame, false)
+		power := make([]float64, fftLen/2+1)
+
+		for k := range power {
+			power[k] = math.Pow(cmplx.Abs(spec[k]), 2)
+		}
+
+		energies := make([]float64, filters)
+		_ = fb.Apply(energies, power)
+
+		row := make([]float64, coeffs)
+		for k := range row {
+			for m, e := range energies {
+				row[k] += math.Log(max(e, 1e-10)) * math.Cos(math.Pi*float64(k)*(float64(m)+0.5)/float64(filters))
+			}
+
+			row[k] *= math.Sqrt(2 / float64(filters))
+			if k == 0 {
+				row[k] /= math.Sqrt2
+			}
+
+			if lifter > 0 {
+				row[k] *= 1 + float64(lifter)/2*math.Sin(math.Pi*float64(k)/float64(lifter))
+			}
+		}
+
+		out = append(out, row)
+
+		if start+frameLen >= len(x) {
+			return out
+		}
+	}
+}
+
+// naiveDeltas returns the regression deltas of rows with width n.
+func naiveDeltas(rows [][]float64, n int) [][]float64 {
+	out := make([][]float64, len(rows))
+	last := len(rows) - 1
+
+	for t := range rows {
+		out[t] = make([]float64, len(rows[t]))
+
+		for k := range out[t] {
+			var num, den float64
+			for i := 1; i <= n; i++ {
+				num += float64(i) * (rows[min(t+i, last)][k] - rows[max(t-i, 0)][k])
+				den += 2 * float64(i*i)
+			}
+
+			out[t][k] = num / den
+		}
+	}
+
+	return out
+}
+
+func TestMFCCMatchesNaive(t *testing.T) {
+	t.Parallel()
+
+	const (
+		fs       = 8000.0
+		frameLen = 200
+		hop      = 80
+		fftLen   = 256
+		coeffs   = 13
+		lifter   = 22
+	)
+
+	x := randomFloat64s(rand.New(rand.NewSource(23)), 2000)
+
+	opts := MFCCOptions{
+		SampleRate:  fs,
+		STFT:        STFTOptions{FrameLen: frameLen, Hop: hop, FFTLen: fftLen, Window: window.Hamming(frameLen, window.Symmetric)},
+		PreEmphasis: 0.97,
+		Filterbank:  FilterbankOptions{Filters: 26, MinFreq: 20},
+		Lifter:      lifter,
+		Deltas:      2,
+	}
+
+	m, err := NewMFCC[float64, complex128](opts)
+	if err != nil {
+		t.Fatalf("NewMFCC() returned error: %v", err)
+	}
+
+	frames := m.Frames(len(x))
+	if m.Coefficients() != coeffs || m.Features() != 3*coeffs {
+		t.Fatalf("Coefficients() = %d, Features() = %d", m.Coefficients(), m.Features())
+	}
+
+	got := make([]float64, frames*m.Features())
+	if err := m.Compute(got, x); err != nil {
+		t.Fatalf("Compute() returned error: %v", err)
+	}
+
+	static := naiveMFCC(x, m.Filterbank(), frameLen, hop, fftLen, coeffs, lifter, 0.97)
+	if len(static) != frames {
+		t.Fatalf("Frames() = %d, want %d", frames, len(static))
+	}
+
+	deltas := naiveDeltas(static, 2)
+	deltas2 := naiveDeltas(deltas, 2)
+
+	for f := range frames {
+		row := got[f*m.Features():]
+		for k := range coeffs {
+			assertApproxFloat64(t, row[k], static[f][k], 1e-8, "frame %d c%d", f, k)
+			assertApproxFloat64(t, row[coeffs+k], deltas[f][k], 1e-8, "frame %d Δc%d", f, k)
+			assertApproxFloat64(t, row[2*coeffs+k], deltas2[f][k], 1e-8, "frame %d ΔΔc%d", f, k)
+		}
+	}
+
+	// float32 agrees with float64.
+	m32, err := NewMFCC[float32, complex64](opts)
+	if err != nil {
+		t.Fatalf("NewMFCC[float32]() returned error: %v", err)
+	}
+
+	got32 := make([]float32, len(got))
+	if err := m32.Compute(got32, toFloat32(x)); err != nil {
+		t.Fatalf("Compute[float32]() returned error: %v", err)
+	}
+
+	for i := range got {
+		assertApproxFloat64(t, float64(got32[i]), got[i], 1e-3*max(1, math.Abs(got[i])), "float32 [%d]", i)
+	}
+
+	// A Batch or Stride in the plan options does not leak into the frame
+	// transforms.
+	batched := opts
+	batched.STFT.PlanOptions = PlanOptions{Batch: 4, Stride: 2 * fftLen}
+
+	mb, err := NewMFCC[float64, complex128](batched)
+	if err != nil {
+		t.Fatalf("NewMFCC(Batch) returned error: %v", err)
+	}
+
+	gotBatched := make([]float64, len(got))
+	if err := mb.Compute(gotBatched, x); err != nil {
+		t.Fatalf("Compute(Batch) returned error: %v", err)
+	}
+
+	for i := range got {
+		assertApproxFloat64(t, gotBatched[i], got[i], 1e-12, "batched plan options [%d]", i)
+	}
+}
+
+func TestMFCCSilenceHitsLogFloor(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewMFCC[float32, complex64](MFCCOptions{
+		SampleRate: 16000,
+		STFT:       STFTOptions{FrameLen: 400, Hop: 160, FFTLen: 512, Center: true},
+		Filterbank: FilterbankOptions{Scale: ScaleMelSlaney, Filters: 40},
+	})
+	if err != nil {
+		t.Fatalf("NewMFCC() returned error: %v", err)
+	}
+
+	x := make([]float32, 1600)
+	got := make([]float32, m.Frames(len(x))*m.Features())
+
+	if err := m.Compute(got, x); err != nil {
+		t.Fatalf("Compute() returned error: %v", err)
+	}
+
+	// Constant log energies leave only c0 = √M·ln(floor).
+	for f := range m.Frames(len(x)) {
+		row := got[f*m.Features():]
+		assertApproxFloat64(t, float64(row[0]), math.Sqrt(40)*math.Log(1e-10), 1e-3, "frame %d c0", f)
+
+		for k := 1; k < m.Features(); k++ {
+			assertApproxFloat64(t, float64(row[k]), 0, 1e-4, "frame %d c%d", f, k)
+		}
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestMFCCComputeNoAllocs(t *testing.T) {
+	m, err := NewMFCC[float32, complex64](MFCCOptions{
+		SampleRate:  16000,
+		STFT:        STFTOptions{FrameLen: 400, Hop: 160, FFTLen: 512},
+		PreEmphasis: 0.97,
+		Lifter:      22,
+		Deltas:      2,
+	})
+	if err != nil {
+		t.Fatalf("NewMFCC() returned error: %v", err)
+	}
+
+	x := toFloat32(randomFloat64s(rand.New(rand.NewSource(3)), 4000))
+	dst := make([]float32, m.Frames(len(x))*m.Features())
+
+	assertNoAllocs(t, "MFCC.Compute", func() error {
+		return m.Compute(dst, x)
+	})
+}
+
+func TestMFCCErrors(t *testing.T) {
+	t.Parallel()
+
+	stft := STFTOptions{FrameLen: 64, Hop: 32}
+
+	if _, err := NewMFCC[float32, complex64](MFCCOptions{STFT: stft}); !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("missing sample rate error = %v, want ErrInvalidParameter", err)
+	}
+
+	cases := []MFCCOptions{
+		{SampleRate: 8000, STFT: stft, Deltas: 3},
+		{SampleRate: 8000, STFT: stft, Lifter: -1},
+		{SampleRate: 8000, STFT: stft, PreEmphasis: 1.5},
+	}
+
+	for _, opts := range cases {
+		if _, err := NewMFCC[float32, complex64](opts); !errors.Is(err, ErrInvalidParameter) {
+			t.Fatalf("NewMFCC(%+v) error = %v, want ErrInvalidParameter", opts, err)
+		}
+	}
+
+	_, err := NewMFCC[float32, complex64](MFCCOptions{
+		SampleRate: 8000, STFT: stft, Filterbank: FilterbankOptions{Filters: 10}, Coefficients: 11,
+	})
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("too many coefficients error = %v, want ErrInvalidLength", err)
+	}
+
+	m, err := NewMFCC[float32, complex64](MFCCOptions{SampleRate: 8000, STFT: stft, Filterbank: FilterbankOptions{Filters: 10}})
+	if err != nil {
+		t.Fatalf("NewMFCC() returned error: %v", err)
+	}
+
+	if m.Coefficients() != 10 {
+		t.Fatalf("default Coefficients() with 10 filters = %d, want 10", m.Coefficients())
+	}
+
+	if err := m.Compute(nil, make([]float32, 64)); !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil dst error = %v, want ErrNilSlice", err)
+	}
+
+	if err := m.Compute([]float32{}, []float32{}); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("empty signal error = %v, want ErrInvalidLength", err)
+	}
+
+	if err := m.Compute(make([]float32, 5), make([]float32, 64)); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("short dst error = %v, want ErrLengthMismatch", err)
+	}
+}
@@ -0,0 +1,341 @@
+package algofft
+
+import (
+	"math"
+
+	"github.com/MeKo-Christian/algo-fft/window"
+)
+
+// MultitaperWeighting selects how Multitaper combines the eigenspectra of
+// the individual tapers.
+type MultitaperWeighting uint8
+
+const (
+	// MultitaperAdaptive iterates Thomson's adaptive weights, which
+	// down-weight the higher-order tapers where their broadband leakage
+	// would dominate a weak spectrum. It is the default.
+	MultitaperAdaptive MultitaperWeighting = iota
+
+	// MultitaperEigen weights every eigenspectrum by its concentration
+	// ratio.
+	MultitaperEigen
+
+	// MultitaperUnity averages the eigenspectra with equal weights.
+	MultitaperUnity
+)
+
+// String returns the name of the weighting.
+func (w MultitaperWeighting) String() string {
+	switch w {
+	case MultitaperAdaptive:
+		return "adaptive"
+	case MultitaperEigen:
+		return "eigen"
+	case MultitaperUnity:
+		return "unity"
+	default:
+		return "unknown"
+	}
+}
+
+// Limits of the adaptive weighting iteration.
+const (
+	multitaperMaxIterations = 100
+	multitaperTolerance     = 1e-10
+)
+
+// MultitaperOptions configures Multitaper.
+type MultitaperOptions struct {
+	// Fs is the sampling frequency. Zero selects 1.
+	Fs float64
+
+	// FFTLen is the transform length, at least len(x); the tapered
+	// records are zero-padded to it. Zero selects len(x). Odd lengths are
+	// supported.
+	FFTLen int
+
+	// Detrend selects the trend removed from x before tapering.
+	Detrend Detrend
+
+	// Weighting selects how the eigenspectra are combined.
+	Weighting MultitaperWeighting
+
+	// FTest requests Thomson's harmonic F-test and the estimated line
+	// amplitudes. It needs at least two tapers.
+	FTest bool
+
+	// PlanOptions is passed to the FFT plan constructor.
+	PlanOptions PlanOptions
+}
+
+// MultitaperResult holds a one-sided multitaper estimate.
+type MultitaperResult struct {
+	// Freqs is the frequency of every bin, 0..Fs/2.
+	Freqs []float64
+
+	// PSD is the power spectral density in units²/Hz.
+	PSD []float64
+
+	// DOF is the equivalent number of degrees of freedom of the chi-squared
+	// distribution of PSD at every bin: 2K for equal weights, fewer where
+	// adaptive weighting discounts tapers.
+	DOF []float64
+
+	// FTest is Thomson's F statistic for a sinusoid at every bin, with 2
+	// and 2K-2 degrees of freedom under the null hypothesis of a smooth
+	// spectrum. A bin whose tapered spectra fit a line exactly, with no
+	// residual, reports +Inf; a silent bin reports 0. It is nil unless
+	// MultitaperOptions.FTest is set.
+	FTest []float64
+
+	// LineAmplitude is the estimated complex amplitude μ of a line
+	// component μ·e^(2πift) + conj(μ)·e^(-2πift) at every bin, so a
+	// sinusoid A·cos(2πft + φ) gives μ = (A/2)·e^(iφ). It is nil unless
+	// MultitaperOptions.FTest is set.
+	LineAmplitude []complex128
+}
+
+// Multitaper estimates the power spectral density of x with Thomson's
+// multitaper method: x is multiplied by k orthogonal DPSS (Slepian)
+// tapers of time-half-bandwidth product nw, and the k eigenspectra,
+// computed in one batch of real FFTs, are combined. The estimate has the
+// resolution bandwidth 2·nw·Fs/len(x) and, for k ≈ 2·nw-1, far lower
+// variance and leakage than a single-window periodogram, which suits short
+// and noisy records.
+//
+// nw must satisfy 0 < nw < len(x)/2. Zero k selects ⌊2·nw⌋-1 tapers, at
+// least one; k must not exceed len(x).
+func Multitaper(x []float64, nw float64, k int, opts MultitaperOptions) (*MultitaperResult, error) {
+	if x == nil {
+		return nil, ErrNilSlice
+	}
+
+	n := len(x)
+	if n < 1 {
+		return nil, ErrInvalidLength
+	}
+
+	if k == 0 {
+		k = max(int(2*nw)-1, 1)
+	}
+
+	if k < 1 || k > n {
+		return nil, ErrInvalidLength
+	}
+
+	fs := opts.Fs
+	if fs == 0 {
+		fs = 1
+	}
+
+	if !(nw > 0) || nw >= float64(n)/2 || !(fs > 0) || math.IsInf(fs, 1) || (opts.FTest && k < 2) {
+		return nil, ErrInvalidParameter
+	}
+
+	if opts.Detrend > DetrendLinear || opts.Weighting > MultitaperUnity {
+		return nil, ErrInvalidMode
+	}
+
+	fftLen := opts.FFTLen
+	if fftLen == 0 {
+		fftLen = n
+	}
+
+	if fftLen < n {
+		return nil, ErrInvalidLength
+	}
+
+	tapers, ratios, err := window.DPSSTapers(n, nw, k)
+	if err != nil {
+		return nil, ErrInvalidParameter
+	}
+
+	// The tapers are batched through forwardBatch, so a Batch or Stride in
+	// the caller's options must not reach the plan.
+	planOpts := opts.PlanOptions
+	planOpts.Batch = 0
+	planOpts.Stride = 0
+
+	plan, err := newRealTransform[float64, complex128](fftLen, planOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	signal := append([]float64(nil), x...)
+	detrendInPlace(signal, opts.Detrend)
+
+	bins := plan.bins()
+	frames := make([]float64, k*fftLen)
+	spec := make([]complex128, k*bins)
+
+	for j, taper := range tapers {
+		frame := frames[j*fftLen : (j+1)*fftLen]
+		for i, v := range signal {
+			frame[i] = v * taper[i]
+		}
+	}
+
+	err = plan.forwardBatch(spec, frames, k)
+	if err != nil {
+		return nil, err
+	}
+
+	// Eigenspectra as two-sided densities, k values per bin.
+	eigen := make([]float64, bins*k)
+
+	for j := range k {
+		for f, v := range spec[j*bins : (j+1)*bins] {
+			eigen[f*k+j] = (real(v)*real(v) + imag(v)*imag(v)) / fs
+		}
+	}
+
+	var variance float64
+	for _, v := range signal {
+		variance += v * v
+	}
+
+	variance /= float64(n)
+
+	res := &MultitaperResult{
+		Freqs: RFFTFreq(fftLen, 1/fs),
+		PSD:   make([]float64, bins),
+		DOF:   make([]float64, bins),
+	}
+
+	weights := make([]float64, k)
+
+	for f := range bins {
+		sk := eigen[f*k : (f+1)*k]
+
+		switch opts.Weighting {
+		case MultitaperAdaptive:
+			res.PSD[f] = adaptiveWeights(weights, sk, ratios, variance/fs)
+		case MultitaperEigen:
+			copy(weights, ratios)
+			res.PSD[f] = weightedMean(weights, sk)
+		default:
+			for j := range weights {
+				weights[j] = 1
+			}
+
+			res.PSD[f] = weightedMean(weights, sk)
+		}
+
+		var sum, sumSq float64
+		for _, w := range weights {
+			sum += w
+			sumSq += w * w
+		}
+
+		res.DOF[f] = 2 * sum * sum / sumSq
+
+		// DC and, for even lengths, Nyquist have no mirror image.
+		if f > 0 && (fftLen%2 != 0 || f < bins-1) {
+			res.PSD[f] *= 2
+		}
+	}
+
+	if opts.FTest {
+		res.FTest, res.LineAmplitude = harmonicFTest(spec, tapers, bins)
+	}
+
+	return res, nil
+}
+
+// adaptiveWeights iterates Thomson's adaptive weighting (Percival and
+// Walden 1993, eq. 369a) at one frequency: the squared weights
+// d_k² = λ_k·S² / (λ_k·S + (1-λ_k)·σ²)² balance each eigenspectrum S_k
+// against its expected broadband leakage for the white noise level
+// σ², and S is their weighted mean. It stores d_k² in weights and returns
+// S.
+func adaptiveWeights(weights, eigen, ratios []float64, noise float64) float64 {
+	// Start from the mean of the two best concentrated tapers.
+	s := eigen[0]
+	if len(eigen) > 1 {
+		s = (eigen[0] + eigen[1]) / 2
+	}
+
+	if len(eigen) == 1 || s == 0 {
+		for j, lambda := range ratios {
+			weights[j] = lambda
+		}
+
+		return weightedMean(weights, eigen)
+	}
+
+	for range multitaperMaxIterations {
+		for j, lambda := range ratios {
+			d := math.Sqrt(lambda) * s / (lambda*s + (1-lambda)*noise)
+			weights[j] = d * d
+		}
+
+		next := weightedMean(weights, eigen)
+		done := math.Abs(next-s) <= multitaperTolerance*s
+		s = next
+
+		if done {
+			break
+		}
+	}
+
+	return s
+}
+
+// weightedMean returns Σ w_k·S_k / Σ w_k.
+func weightedMean(weights, eigen []float64) float64 {
+	var sum, norm float64
+	for j, w := range weights {
+		sum += w * eigen[j]
+		norm += w
+	}
+
+	return sum / norm
+}
+
+// harmonicFTest computes Thomson's F statistic and line amplitude at every
+// bin from the tapered spectra y_k (Thomson 1982, eq. 13.9): the least
+// squares fit μ = Σ U_k·y_k / Σ U_k² of a line through the taper sums
+// U_k = Σ_t h_k[t], and the ratio of the fitted to the residual energy.
+// Where both energies vanish, as for a silent record, the statistic is 0;
+// where only the residual vanishes, a line fitted exactly, it is +Inf.
+func harmonicFTest(spec []complex128, tapers [][]float64, bins int) ([]float64, []complex128) {
+	k := len(tapers)
+	dc := make([]float64, k)
+
+	var dcEnergy float64
+
+	for j, taper := range tapers {
+		for _, v := range taper {
+			dc[j] += v
+		}
+
+		dcEnergy += dc[j] * dc[j]
+	}
+
+	fstat := make([]float64, bins)
+	amps := make([]complex128, bins)
+
+	for f := range bins {
+		var mu complex128
+		for j := range k {
+			mu += complex(dc[j], 0) * spec[j*bins+f]
+		}
+
+		mu /= complex(dcEnergy, 0)
+
+		var residual float64
+		for j := range k {
+			r := spec[j*bins+f] - mu*complex(dc[j], 0)
+			residual += real(r)*real(r) + imag(r)*imag(r)
+		}
+
+		fitted := (real(mu)*real(mu) + imag(mu)*imag(mu)) * dcEnergy
+		if fitted > 0 || residual > 0 {
+			fstat[f] = float64(k-1) * fitted / residual
+		}
+
+		amps[f] = mu
+	}
+
+	return fstat, amps
+}
@@ -0,0 +1,284 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/window"
+)
+
+func TestMultitaperMatchesNaive(t *testing.T) {
+	t.Parallel()
+
+	const n, nw, k, fs = 101, 3.0, 5, 50.0
+
+	x := randomFloat64s(rand.New(rand.NewSource(29)), n)
+	tapers, ratios, err := window.DPSSTapers(n, nw, k)
+	if err != nil {
+		t.Fatalf("DPSSTapers() returned error: %v", err)
+	}
+
+	// Eigenspectra of the detrended signal, zero-padded to 128.
+	var mean float64
+	for _, v := range x {
+		mean += v / n
+	}
+
+	eigen := make([][]float64, k)
+
+	for j, taper := range tapers {
+		frame := make([]complex128, 128)
+		for i, v := range x {
+			frame[i] = complex((v-mean)*taper[i], 0)
+		}
+
+		for _, v := range naiveDFT(frame, false)[:65] {
+			eigen[j] = append(eigen[j], math.Pow(cmplx.Abs(v), 2)/fs)
+		}
+	}
+
+	for _, weighting := range []MultitaperWeighting{MultitaperUnity, MultitaperEigen} {
+		res, err := Multitaper(x, nw, k, MultitaperOptions{Fs: fs, FFTLen: 128, Weighting: weighting})
+		if err != nil {
+			t.Fatalf("Multitaper(%v) returned error: %v", weighting, err)
+		}
+
+		if len(res.Freqs) != 65 || res.Freqs[64] != fs/2 {
+			t.Fatalf("%v: Freqs has %d bins ending at %g", weighting, len(res.Freqs), res.Freqs[len(res.Freqs)-1])
+		}
+
+		if res.FTest != nil || res.LineAmplitude != nil {
+			t.Fatalf("%v: F-test returned without being requested", weighting)
+		}
+
+		for f := range res.PSD {
+			var want, norm, normSq float64
+			for j := range k {
+				w := 1.0
+				if weighting == MultitaperEigen {
+					w = ratios[j]
+				}
+
+				want += w * eigen[j][f]
+				norm += w
+				normSq += w * w
+			}
+
+			want /= norm
+			if f > 0 && f < 64 {
+				want *= 2
+			}
+
+			assertApproxFloat64(t, res.PSD[f], want, 1e-10, "%v PSD [%d]", weighting, f)
+			assertApproxFloat64(t, res.DOF[f], 2*norm*norm/normSq, 1e-10, "%v DOF [%d]", weighting, f)
+		}
+	}
+}
+
+func TestMultitaperWhiteNoise(t *testing.T) {
+	t.Parallel()
+
+	const n, fs, sigma = 4096, 1000.0, 0.5
+
+	rng := rand.New(rand.NewSource(31))
+	x := make([]float64, n)
+
+	for i := range x {
+		x[i] = sigma * rng.NormFloat64()
+	}
+
+	res, err := Multitaper(x, 4, 0, MultitaperOptions{Fs: fs})
+	if err != nil {
+		t.Fatalf("Multitaper() returned error: %v", err)
+	}
+
+	var level, dof float64
+
+	interior := res.PSD[1 : len(res.PSD)-1]
+	for f, v := range interior {
+		level += v
+		dof += res.DOF[f+1]
+	}
+
+	level /= float64(len(interior))
+	dof /= float64(len(interior))
+
+	assertApproxFloat64(t, level, 2*sigma*sigma/fs, 0.03*2*sigma*sigma/fs, "white noise level")
+
+	// Seven well-concentrated tapers give about 14 degrees of freedom.
+	if dof < 13 || dof > 14 {
+		t.Fatalf("mean adaptive DOF = %g, want close to 14", dof)
+	}
+}
+
+func TestMultitaperAdaptiveLeakage(t *testing.T) {
+	t.Parallel()
+
+	// A strong low-frequency AR(2) peak leaks into the weak high band
+	// through the higher-order tapers unless adaptively down-weighted.
+	const n = 1024
+
+	rng := rand.New(rand.NewSource(37))
+	x := make([]float64, n+100)
+
+	for i := 2; i < len(x); i++ {
+		x[i] = 1.98*x[i-1] - 0.9801*x[i-2] + rng.NormFloat64()
+	}
+
+	x = x[100:]
+
+	adaptive, err := Multitaper(x, 4, 7, MultitaperOptions{})
+	if err != nil {
+		t.Fatalf("Multitaper(adaptive) returned error: %v", err)
+	}
+
+	unity, err := Multitaper(x, 4, 7, MultitaperOptions{Weighting: MultitaperUnity})
+	if err != nil {
+		t.Fatalf("Multitaper(unity) returned error: %v", err)
+	}
+
+	var highAdaptive, highUnity float64
+	for f := n / 4; f <= n/2; f++ {
+		highAdaptive += adaptive.PSD[f]
+		highUnity += unity.PSD[f]
+	}
+
+	if highAdaptive >= highUnity {
+		t.Fatalf("adaptive high-band power %g not below unity weighting %g", highAdaptive, highUnity)
+	}
+}
+
+func TestMultitaperFTest(t *testing.T) {
+	t.Parallel()
+
+	const n, bin, amp, phase = 512, 60, 0.8, 0.7
+
+	rng := rand.New(rand.NewSource(41))
+	x := make([]float64, n)
+
+	for i := range x {
+		x[i] = amp*math.Cos(2*math.Pi*bin*float64(i)/n+phase) + rng.NormFloat64()
+	}
+
+	res, err := Multitaper(x, 4, 7, MultitaperOptions{FTest: true})
+	if err != nil {
+		t.Fatalf("Multitaper() returned error: %v", err)
+	}
+
+	peak := 1
+	for f := 1; f < len(res.FTest); f++ {
+		if res.FTest[f] > res.FTest[peak] {
+			peak = f
+		}
+	}
+
+	// The 99.9% point of F(2, 12) is about 13.
+	if peak != bin || res.FTest[bin] < 13 {
+		t.Fatalf("F-test peak at bin %d (F = %g), want bin %d above 13", peak, res.FTest[peak], bin)
+	}
+
+	mu := res.LineAmplitude[bin]
+	assertApproxFloat64(t, 2*cmplx.Abs(mu), amp, 0.15, "line amplitude")
+	assertApproxFloat64(t, cmplx.Phase(mu), phase, 0.2, "line phase")
+}
+
+func TestMultitaperOddLength(t *testing.T) {
+	t.Parallel()
+
+	const n, nw, k = 101, 3.0, 5
+
+	x := randomFloat64s(rand.New(rand.NewSource(31)), n)
+	tapers, _, err := window.DPSSTapers(n, nw, k)
+	if err != nil {
+		t.Fatalf("DPSSTapers() returned error: %v", err)
+	}
+
+	// The default transform length is the odd record length itself, so the
+	// grid is k/n with no padding and no Nyquist bin.
+	res, err := Multitaper(x, nw, k, MultitaperOptions{Weighting: MultitaperUnity})
+	if err != nil {
+		t.Fatalf("Multitaper() returned error: %v", err)
+	}
+
+	if len(res.Freqs) != n/2+1 {
+		t.Fatalf("Freqs has %d bins, want %d", len(res.Freqs), n/2+1)
+	}
+
+	var mean float64
+	for _, v := range x {
+		mean += v / n
+	}
+
+	for f := range res.PSD {
+		assertApproxFloat64(t, res.Freqs[f], float64(f)/n, 1e-12, "Freqs [%d]", f)
+
+		var want float64
+
+		for _, taper := range tapers {
+			frame := make([]complex128, n)
+			for i, v := range x {
+				frame[i] = complex((v-mean)*taper[i], 0)
+			}
+
+			want += math.Pow(cmplx.Abs(naiveDFT(frame, false)[f]), 2) / k
+		}
+
+		if f > 0 {
+			want *= 2
+		}
+
+		assertApproxFloat64(t, res.PSD[f], want, 1e-10, "PSD [%d]", f)
+	}
+}
+
+func TestMultitaperFTestSilence(t *testing.T) {
+	t.Parallel()
+
+	res, err := Multitaper(make([]float64, 128), 3, 5, MultitaperOptions{FTest: true})
+	if err != nil {
+		t.Fatalf("Multitaper() returned error: %v", err)
+	}
+
+	for f, v := range res.FTest {
+		if v != 0 {
+			t.Fatalf("silent record FTest[%d] = %v, want 0", f, v)
+		}
+	}
+}
+
+func TestMultitaperErrors(t *testing.T) {
+	t.Parallel()
+
+	x := make([]float64, 64)
+
+	if _, err := Multitaper(nil, 2, 3, MultitaperOptions{}); !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil signal error = %v, want ErrNilSlice", err)
+	}
+
+	if _, err := Multitaper(x, 2, 65, MultitaperOptions{}); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("too many tapers error = %v, want ErrInvalidLength", err)
+	}
+
+	if _, err := Multitaper(x, 32, 3, MultitaperOptions{}); !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("nw = n/2 error = %v, want ErrInvalidParameter", err)
+	}
+
+	if _, err := Multitaper(x, 2, 1, MultitaperOptions{FTest: true}); !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("F-test with one taper error = %v, want ErrInvalidParameter", err)
+	}
+
+	if _, err := Multitaper(x, 2, 3, MultitaperOptions{FFTLen: 63}); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("short FFT length error = %v, want ErrInvalidLength", err)
+	}
+
+	if _, err := Multitaper(x, 2, 3, MultitaperOptions{Weighting: MultitaperUnity + 1}); !errors.Is(err, ErrInvalidMode) {
+		t.Fatalf("bad weighting error = %v, want ErrInvalidMode", err)
+	}
+
+	if got := MultitaperAdaptive.String(); got != "adaptive" {
+		t.Fatalf("MultitaperAdaptive.String() = %q", got)
+	}
+}
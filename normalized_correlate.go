@@ -0,0 +1,341 @@
+package algofft
+
+import "math"
+
+// NCCPeak is the location of the maximum of a 1D normalized
+// cross-correlation.
+type NCCPeak struct {
+	// Index is the integer offset of the maximum in the correlation output.
+	Index int
+
+	// Position is Index refined to sub-sample accuracy by fitting a parabola
+	// through the maximum and its two neighbours.
+	Position float64
+
+	// Value is the interpolated correlation coefficient at Position,
+	// clamped to [-1, 1].
+	Value float64
+}
+
+// NCCPeak2D is the location of the maximum of a 2D normalized
+// cross-correlation.
+type NCCPeak2D struct {
+	// Row and Col are the integer offsets of the maximum in the correlation
+	// output.
+	Row, Col int
+
+	// RowPosition and ColPosition are Row and Col refined to sub-pixel
+	// accuracy by separable parabolic fits along each axis.
+	RowPosition, ColPosition float64
+
+	// Value is the interpolated correlation coefficient at the refined
+	// position, clamped to [-1, 1].
+	Value float64
+}
+
+// NormalizedCrossCorrelate computes the zero-mean normalized
+// cross-correlation of template against every position of signal where it
+// fits entirely (Lewis' fast NCC). dst[k] is the Pearson correlation
+// coefficient, in [-1, 1], between template and signal[k:k+len(template)].
+//
+// The numerator is an FFT correlation with the zero-mean template; the
+// local signal energy comes from running sums. Windows where signal is
+// constant, and all windows when template is constant, yield 0.
+//
+// The dst slice must have length len(signal)-len(template)+1. The returned
+// peak is the maximum of dst.
+func NormalizedCrossCorrelate(dst, signal, template []float32) (NCCPeak, error) {
+	return normalizedCrossCorrelate[float32, complex64](dst, signal, template)
+}
+
+// NormalizedCrossCorrelate64 is the float64 variant of
+// NormalizedCrossCorrelate.
+func NormalizedCrossCorrelate64(dst, signal, template []float64) (NCCPeak, error) {
+	return normalizedCrossCorrelate[float64, complex128](dst, signal, template)
+}
+
+// NormalizedCrossCorrelate2D computes the zero-mean normalized
+// cross-correlation of a tRows×tCols template against every position of a
+// rows×cols image where it fits entirely, for template matching and image
+// registration. dst[r*outCols+c] is the correlation coefficient between the
+// template and the image window whose top-left corner is (r, c).
+//
+// Local image energy is taken from summed-area tables. Windows where the
+// image is constant, and all windows when the template is constant, yield 0.
+//
+// The dst slice must have length (rows-tRows+1)*(cols-tCols+1). The returned
+// peak is the maximum of dst.
+func NormalizedCrossCorrelate2D(
+	dst, img []float32, rows, cols int, template []float32, tRows, tCols int,
+) (NCCPeak2D, error) {
+	return normalizedCrossCorrelate2D[float32, complex64](dst, img, rows, cols, template, tRows, tCols)
+}
+
+// NormalizedCrossCorrelate2D64 is the float64 variant of
+// NormalizedCrossCorrelate2D.
+func NormalizedCrossCorrelate2D64(
+	dst, img []float64, rows, cols int, template []float64, tRows, tCols int,
+) (NCCPeak2D, error) {
+	return normalizedCrossCorrelate2D[float64, complex128](dst, img, rows, cols, template, tRows, tCols)
+}
+
+func normalizedCrossCorrelate[F Float, C Complex](dst, signal, template []F) (NCCPeak, error) {
+	if dst == nil || signal == nil || template == nil {
+		return NCCPeak{}, ErrNilSlice
+	}
+
+	n := len(template)
+	if n == 0 {
+		return NCCPeak{}, ErrInvalidLength
+	}
+
+	if n > len(signal) || len(dst) != len(signal)-n+1 {
+		return NCCPeak{}, ErrLengthMismatch
+	}
+
+	zeroMean, templateEnergy := zeroMeanTemplate(template)
+
+	// The coefficient does not depend on a global offset of the signal, so
+	// remove its mean: a large DC component would otherwise swamp the
+	// window energies and the precision of the numerator.
+	signal = subtractMean(signal)
+
+	err := crossCorrelateReal[F, C](dst, signal, zeroMean, ConvValid)
+	if err != nil {
+		return NCCPeak{}, err
+	}
+
+	// Prefix sums of the signal and its square give every window's energy
+	// in O(1).
+	sum := make([]float64, len(signal)+1)
+	sumSq := make([]float64, len(signal)+1)
+
+	for i, v := range signal {
+		x := float64(v)
+		sum[i+1] = sum[i] + x
+		sumSq[i+1] = sumSq[i] + x*x
+	}
+
+	tol := nccFlatTolerance[F]()
+
+	for k := range dst {
+		s := sum[k+n] - sum[k]
+		s2 := sumSq[k+n] - sumSq[k]
+		dst[k] = F(nccCoefficient(float64(dst[k]), s, s2, n, templateEnergy, tol))
+	}
+
+	return nccPeak(dst), nil
+}
+
+func normalizedCrossCorrelate2D[F Float, C Complex](
+	dst, img []F, rows, cols int, template []F, tRows, tCols int,
+) (NCCPeak2D, error) {
+	if dst == nil || img == nil || template == nil {
+		return NCCPeak2D{}, ErrNilSlice
+	}
+
+	if rows < 1 || cols < 1 || tRows < 1 || tCols < 1 {
+		return NCCPeak2D{}, ErrInvalidLength
+	}
+
+	if tRows > rows || tCols > cols || len(img) != rows*cols || len(template) != tRows*tCols {
+		return NCCPeak2D{}, ErrLengthMismatch
+	}
+
+	outRows, outCols := rows-tRows+1, cols-tCols+1
+	if len(dst) != outRows*outCols {
+		return NCCPeak2D{}, ErrLengthMismatch
+	}
+
+	zeroMean, templateEnergy := zeroMeanTemplate(template)
+
+	// As in 1D, a global offset does not change the coefficients.
+	img = subtractMean(img)
+
+	corr, err := newRealConvolver2D[F, C](rows, cols, zeroMean, tRows, tCols, Conv2DOptions{Mode: ConvValid}, true)
+	if err != nil {
+		return NCCPeak2D{}, err
+	}
+
+	err = corr.Apply(dst, img)
+	if err != nil {
+		return NCCPeak2D{}, err
+	}
+
+	// Summed-area tables: sat[r*(cols+1)+c] holds the sum over
+	// img[:r, :c].
+	stride := cols + 1
+	sat := make([]float64, (rows+1)*stride)
+	satSq := make([]float64, (rows+1)*stride)
+
+	for r := range rows {
+		var rowSum, rowSumSq float64
+
+		for c := range cols {
+			x := float64(img[r*cols+c])
+			rowSum += x
+			rowSumSq += x * x
+			sat[(r+1)*stride+c+1] = sat[r*stride+c+1] + rowSum
+			satSq[(r+1)*stride+c+1] = satSq[r*stride+c+1] + rowSumSq
+		}
+	}
+
+	boxSum := func(t []float64, r, c int) float64 {
+		return t[(r+tRows)*stride+c+tCols] - t[r*stride+c+tCols] - t[(r+tRows)*stride+c] + t[r*stride+c]
+	}
+
+	n := tRows * tCols
+	tol := nccFlatTolerance[F]()
+
+	for r := range outRows {
+		for c := range outCols {
+			i := r*outCols + c
+			dst[i] = F(nccCoefficient(float64(dst[i]), boxSum(sat, r, c), boxSum(satSq, r, c), n, templateEnergy, tol))
+		}
+	}
+
+	return nccPeak2D(dst, outRows, outCols), nil
+}
+
+// zeroMeanTemplate returns template minus its mean and the energy of the
+// result, or 0 energy for a (numerically) constant template. Correlating
+// with a zero-mean template makes the numerator independent of the local
+// signal mean.
+func zeroMeanTemplate[F Float](template []F) ([]F, float64) {
+	var sumSq float64
+	for _, v := range template {
+		sumSq += float64(v) * float64(v)
+	}
+
+	out := subtractMean(template)
+
+	var energy float64
+
+	for _, v := range out {
+		energy += float64(v) * float64(v)
+	}
+
+	// The mean is removed in float64, so only float64 rounding can leave
+	// energy in a constant template, whatever the sample precision.
+	if energy <= nccFlatTolerance[float64]()*sumSq {
+		energy = 0
+	}
+
+	return out, energy
+}
+
+// subtractMean returns a copy of x minus its mean, accumulated in float64.
+func subtractMean[F Float](x []F) []F {
+	var mean float64
+	for _, v := range x {
+		mean += float64(v)
+	}
+
+	mean /= float64(len(x))
+
+	out := make([]F, len(x))
+	for i, v := range x {
+		out[i] = F(float64(v) - mean)
+	}
+
+	return out
+}
+
+// nccCoefficient normalizes the correlation numerator by the energies of
+// the zero-mean window and template. sum and sumSq are the window's sum and
+// sum of squares over n samples.
+func nccCoefficient(num, sum, sumSq float64, n int, templateEnergy, tol float64) float64 {
+	windowEnergy := sumSq - sum*sum/float64(n)
+
+	// Subtracting the squared mean cancels catastrophically for nearly flat
+	// windows; treat those as constant.
+	if windowEnergy <= tol*sumSq || templateEnergy <= 0 {
+		return 0
+	}
+
+	return max(-1, min(1, num/math.Sqrt(windowEnergy*templateEnergy)))
+}
+
+// nccFlatTolerance is the relative window energy below which a window is
+// considered constant, scaled to the precision of the FFT numerator.
+func nccFlatTolerance[F Float]() float64 {
+	var zero F
+	if _, ok := any(zero).(float32); ok {
+		return 1e-6
+	}
+
+	return 1e-12
+}
+
+func nccPeak[F Float](ncc []F) NCCPeak {
+	best := 0
+
+	for i, v := range ncc {
+		if v > ncc[best] {
+			best = i
+		}
+	}
+
+	peak := NCCPeak{Index: best, Position: float64(best), Value: float64(ncc[best])}
+
+	if best > 0 && best < len(ncc)-1 {
+		delta, value := parabolicPeak(float64(ncc[best-1]), float64(ncc[best]), float64(ncc[best+1]))
+		peak.Position += delta
+		peak.Value = clampCorrelation(value)
+	}
+
+	return peak
+}
+
+func nccPeak2D[F Float](ncc []F, rows, cols int) NCCPeak2D {
+	best := 0
+
+	for i, v := range ncc {
+		if v > ncc[best] {
+			best = i
+		}
+	}
+
+	r, c := best/cols, best%cols
+	center := float64(ncc[best])
+	peak := NCCPeak2D{Row: r, Col: c, RowPosition: float64(r), ColPosition: float64(c), Value: center}
+
+	// Each axis contributes its parabola's rise above the centre sample.
+	if r > 0 && r < rows-1 {
+		delta, value := parabolicPeak(float64(ncc[best-cols]), center, float64(ncc[best+cols]))
+		peak.RowPosition += delta
+		peak.Value += value - center
+	}
+
+	if c > 0 && c < cols-1 {
+		delta, value := parabolicPeak(float64(ncc[best-1]), center, float64(ncc[best+1]))
+		peak.ColPosition += delta
+		peak.Value += value - center
+	}
+
+	// The rises of the two axes add up, which can overshoot the range of a
+	// correlation coefficient near a perfect match.
+	peak.Value = clampCorrelation(peak.Value)
+
+	return peak
+}
+
+// clampCorrelation limits an interpolated correlation coefficient to
+// [-1, 1].
+func clampCorrelation(v float64) float64 {
+	return max(-1, min(1, v))
+}
+
+// parabolicPeak fits a parabola through (-1, ym), (0, y0), (1, yp) and
+// returns the offset of its vertex, clamped to [-0.5, 0.5], and the value
+// there.
+func parabolicPeak(ym, y0, yp float64) (delta, value float64) {
+	denom := ym - 2*y0 + yp
+	if denom >= 0 {
+		return 0, y0
+	}
+
+	delta = max(-0.5, min(0.5, 0.5*(ym-yp)/denom))
+
+	return delta, y0 + 0.5*(yp-ym)*delta + 0.5*denom*delta*delta
+}
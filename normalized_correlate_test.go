@@ -0,0 +1,368 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func naiveNCC(signal, template []float64) []float64 {
+	n := len(template)
+	out := make([]float64, len(signal)-n+1)
+
+	var tMean float64
+	for _, v := range template {
+		tMean += v / float64(n)
+	}
+
+	for k := range out {
+		var sMean float64
+		for _, v := range signal[k : k+n] {
+			sMean += v / float64(n)
+		}
+
+		var num, sEnergy, tEnergy float64
+
+		for i, tv := range template {
+			ds, dt := signal[k+i]-sMean, tv-tMean
+			num += ds * dt
+			sEnergy += ds * ds
+			tEnergy += dt * dt
+		}
+
+		out[k] = num / math.Sqrt(sEnergy*tEnergy)
+	}
+
+	return out
+}
+
+func TestNormalizedCrossCorrelateMatchesNaive(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(38))
+
+	for _, sizes := range [][2]int{{10, 2}, {64, 64}, {300, 17}} {
+		signal := randomFloat64s(rng, sizes[0])
+		template := randomFloat64s(rng, sizes[1])
+
+		// A large DC offset must not affect the result.
+		for i := range signal {
+			signal[i] += 50
+		}
+
+		want := naiveNCC(signal, template)
+		got := make([]float64, len(want))
+
+		_, err := NormalizedCrossCorrelate64(got, signal, template)
+		if err != nil {
+			t.Fatalf("NormalizedCrossCorrelate64(%v) returned error: %v", sizes, err)
+		}
+
+		for i := range want {
+			assertApproxFloat64(t, got[i], want[i], 1e-9, "sizes %v got[%d]", sizes, i)
+		}
+
+		got32 := make([]float32, len(want))
+
+		_, err = NormalizedCrossCorrelate(got32, toFloat32(signal), toFloat32(template))
+		if err != nil {
+			t.Fatalf("NormalizedCrossCorrelate(%v) returned error: %v", sizes, err)
+		}
+
+		for i := range want {
+			assertApproxFloat64(t, float64(got32[i]), want[i], 2e-3, "sizes %v got32[%d]", sizes, i)
+		}
+	}
+}
+
+func TestNormalizedCrossCorrelateFindsScaledTemplate(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(39))
+	signal := randomFloat64s(rng, 500)
+	template := make([]float64, 40)
+
+	// Amplitude and offset changes leave the coefficient at 1.
+	for i := range template {
+		template[i] = 0.25*signal[321+i] - 3
+	}
+
+	dst := make([]float64, len(signal)-len(template)+1)
+
+	peak, err := NormalizedCrossCorrelate64(dst, signal, template)
+	if err != nil {
+		t.Fatalf("NormalizedCrossCorrelate64() returned error: %v", err)
+	}
+
+	if peak.Index != 321 {
+		t.Fatalf("peak.Index = %d, want 321", peak.Index)
+	}
+
+	assertApproxFloat64(t, dst[321], 1, 1e-9, "dst[321]")
+	assertApproxFloat64(t, peak.Position, 321, 0.5, "peak.Position")
+
+	for i, v := range dst {
+		if v < -1 || v > 1 {
+			t.Fatalf("dst[%d] = %v outside [-1, 1]", i, v)
+		}
+	}
+}
+
+func TestNormalizedCrossCorrelateSubSamplePeak(t *testing.T) {
+	t.Parallel()
+
+	// A wide Gaussian pulse centred between samples; the template is the
+	// pulse sampled on the integer grid.
+	const shift = 0.3
+
+	pulse := func(x float64) float64 { return math.Exp(-x * x / 50) }
+
+	signal := make([]float64, 200)
+	for i := range signal {
+		signal[i] = pulse(float64(i) - 100 - shift)
+	}
+
+	template := make([]float64, 61)
+	for i := range template {
+		template[i] = pulse(float64(i) - 30)
+	}
+
+	dst := make([]float64, len(signal)-len(template)+1)
+
+	peak, err := NormalizedCrossCorrelate64(dst, signal, template)
+	if err != nil {
+		t.Fatalf("NormalizedCrossCorrelate64() returned error: %v", err)
+	}
+
+	if peak.Index != 70 {
+		t.Fatalf("peak.Index = %d, want 70", peak.Index)
+	}
+
+	assertApproxFloat64(t, peak.Position, 70+shift, 0.05, "peak.Position")
+
+	if peak.Value < dst[peak.Index] || peak.Value > 1 {
+		t.Fatalf("peak.Value = %v, want in [%v, 1]", peak.Value, dst[peak.Index])
+	}
+}
+
+func TestNormalizedCrossCorrelateFlatRegions(t *testing.T) {
+	t.Parallel()
+
+	signal := []float32{2, 2, 2, 2, 1, 5, 3, 2}
+	dst := make([]float32, 6)
+
+	_, err := NormalizedCrossCorrelate(dst, signal, []float32{1, 2, 4})
+	if err != nil {
+		t.Fatalf("NormalizedCrossCorrelate() returned error: %v", err)
+	}
+
+	if dst[0] != 0 || dst[1] != 0 {
+		t.Fatalf("constant windows = %v, want 0", dst[:2])
+	}
+
+	_, err = NormalizedCrossCorrelate(dst, signal, []float32{0.1, 0.1, 0.1})
+	if err != nil {
+		t.Fatalf("NormalizedCrossCorrelate(flat template) returned error: %v", err)
+	}
+
+	for i, v := range dst {
+		if v != 0 {
+			t.Fatalf("flat template dst[%d] = %v, want 0", i, v)
+		}
+	}
+}
+
+func TestNormalizedCrossCorrelateLargeOffset(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(39))
+
+	// Small variations on a large DC level: relative to the raw signal
+	// energy, every window looks flat in float32.
+	const offset, spread = 1000, 0.05
+
+	signal64 := randomFloat64s(rng, 400)
+	template64 := append([]float64(nil), signal64[150:182]...)
+
+	for i := range signal64 {
+		signal64[i] = offset + spread*signal64[i]
+	}
+
+	want := naiveNCC(signal64, template64)
+	signal := toFloat32(signal64)
+
+	got := make([]float32, len(want))
+
+	peak, err := NormalizedCrossCorrelate(got, signal, toFloat32(template64))
+	if err != nil {
+		t.Fatalf("NormalizedCrossCorrelate() returned error: %v", err)
+	}
+
+	if peak.Index != 150 || peak.Value < 0.999 {
+		t.Fatalf("peak = %+v, want index 150 with value near 1", peak)
+	}
+
+	for k := range want {
+		assertApproxFloat64(t, float64(got[k]), want[k], 2e-3, "ncc[%d]", k)
+	}
+
+	// The same holds in 2D, with the signal as a 20x20 image.
+	got2D := make([]float32, 11*11)
+	template2D := make([]float32, 10*10)
+
+	for r := range 10 {
+		copy(template2D[r*10:(r+1)*10], signal[(r+4)*20+3:(r+4)*20+13])
+	}
+
+	peak2D, err := NormalizedCrossCorrelate2D(got2D, signal, 20, 20, template2D, 10, 10)
+	if err != nil {
+		t.Fatalf("NormalizedCrossCorrelate2D() returned error: %v", err)
+	}
+
+	if peak2D.Row != 4 || peak2D.Col != 3 || peak2D.Value < 0.999 {
+		t.Fatalf("2D peak = %+v, want (4, 3) with value near 1", peak2D)
+	}
+}
+
+func TestNormalizedCrossCorrelate2DFindsTemplate(t *testing.T) {
+	t.Parallel()
+
+	const rows, cols, tRows, tCols = 40, 53, 9, 12
+
+	rng := rand.New(rand.NewSource(40))
+	img := randomFloat64s(rng, rows*cols)
+	template := make([]float64, tRows*tCols)
+
+	for r := range tRows {
+		for c := range tCols {
+			template[r*tCols+c] = 2*img[(r+17)*cols+c+30] + 1
+		}
+	}
+
+	outRows, outCols := rows-tRows+1, cols-tCols+1
+	dst := make([]float64, outRows*outCols)
+
+	peak, err := NormalizedCrossCorrelate2D64(dst, img, rows, cols, template, tRows, tCols)
+	if err != nil {
+		t.Fatalf("NormalizedCrossCorrelate2D64() returned error: %v", err)
+	}
+
+	if peak.Row != 17 || peak.Col != 30 {
+		t.Fatalf("peak = (%d, %d), want (17, 30)", peak.Row, peak.Col)
+	}
+
+	assertApproxFloat64(t, dst[17*outCols+30], 1, 1e-9, "dst at match")
+
+	// Spot-check a few positions against the 1D definition applied to the
+	// flattened window.
+	for _, pos := range [][2]int{{0, 0}, {5, 41}, {outRows - 1, outCols - 1}} {
+		window := make([]float64, 0, tRows*tCols)
+		for r := range tRows {
+			window = append(window, img[(pos[0]+r)*cols+pos[1]:(pos[0]+r)*cols+pos[1]+tCols]...)
+		}
+
+		want := naiveNCC(window, template)[0]
+		assertApproxFloat64(t, dst[pos[0]*outCols+pos[1]], want, 1e-9, "dst at %v", pos)
+	}
+
+	dst32 := make([]float32, len(dst))
+
+	peak32, err := NormalizedCrossCorrelate2D(dst32, toFloat32(img), rows, cols, toFloat32(template), tRows, tCols)
+	if err != nil {
+		t.Fatalf("NormalizedCrossCorrelate2D() returned error: %v", err)
+	}
+
+	if peak32.Row != 17 || peak32.Col != 30 {
+		t.Fatalf("float32 peak = (%d, %d), want (17, 30)", peak32.Row, peak32.Col)
+	}
+}
+
+func TestNormalizedCrossCorrelate2DSubPixelPeak(t *testing.T) {
+	t.Parallel()
+
+	const rows, cols, size = 64, 64, 25
+
+	blob := func(y, x float64) float64 { return math.Exp(-(x*x + y*y) / 40) }
+
+	img := make([]float64, rows*cols)
+	for r := range rows {
+		for c := range cols {
+			img[r*cols+c] = blob(float64(r)-30.2, float64(c)-35.6)
+		}
+	}
+
+	template := make([]float64, size*size)
+	for r := range size {
+		for c := range size {
+			template[r*size+c] = blob(float64(r-size/2), float64(c-size/2))
+		}
+	}
+
+	dst := make([]float64, (rows-size+1)*(cols-size+1))
+
+	peak, err := NormalizedCrossCorrelate2D64(dst, img, rows, cols, template, size, size)
+	if err != nil {
+		t.Fatalf("NormalizedCrossCorrelate2D64() returned error: %v", err)
+	}
+
+	assertApproxFloat64(t, peak.RowPosition, 30.2-size/2, 0.05, "peak.RowPosition")
+	assertApproxFloat64(t, peak.ColPosition, 35.6-size/2, 0.05, "peak.ColPosition")
+}
+
+func TestNormalizedCrossCorrelateErrors(t *testing.T) {
+	t.Parallel()
+
+	signal := []float64{1, 2, 3, 4}
+
+	_, err := NormalizedCrossCorrelate64(nil, signal, signal)
+	if !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil dst error = %v, want ErrNilSlice", err)
+	}
+
+	_, err = NormalizedCrossCorrelate64(make([]float64, 5), signal, []float64{})
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("empty template error = %v, want ErrInvalidLength", err)
+	}
+
+	_, err = NormalizedCrossCorrelate64(make([]float64, 1), signal, make([]float64, 5))
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("long template error = %v, want ErrLengthMismatch", err)
+	}
+
+	_, err = NormalizedCrossCorrelate64(make([]float64, 2), signal, []float64{1, 2})
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("wrong dst error = %v, want ErrLengthMismatch", err)
+	}
+
+	_, err = NormalizedCrossCorrelate2D64(make([]float64, 1), signal, 2, 2, signal, 3, 1)
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("tall template error = %v, want ErrLengthMismatch", err)
+	}
+
+	_, err = NormalizedCrossCorrelate2D64(make([]float64, 1), signal, 2, 2, signal, 0, 4)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("zero rows error = %v, want ErrInvalidLength", err)
+	}
+}
+
+func TestNCCPeakValueStaysInRange(t *testing.T) {
+	t.Parallel()
+
+	// A sharp peak whose neighbours are nearly as high on both axes: each
+	// parabola rises above the centre sample.
+	ncc := []float64{
+		0.5, 0.99, 0.5,
+		0.99, 1, 0.98,
+		0.5, 0.98, 0.5,
+	}
+
+	peak := nccPeak2D(ncc, 3, 3)
+	if peak.Value > 1 || peak.Value < 0.99 {
+		t.Fatalf("2D peak value = %v, want in [0.99, 1]", peak.Value)
+	}
+
+	if p := nccPeak(ncc[3:6]); p.Value > 1 || p.Value < 0.99 {
+		t.Fatalf("1D peak value = %v, want in [0.99, 1]", p.Value)
+	}
+}
@@ -0,0 +1,314 @@
+package algofft
+
+// PartitionedOptions configures a PartitionedConvolver.
+type PartitionedOptions struct {
+	// BlockSize is the partition and processing block size B. It is also
+	// the latency in samples. Powers of two give the fastest transforms.
+	BlockSize int
+
+	// MaxIRLen is the longest impulse response that SetImpulseResponse
+	// accepts. Zero means the length of the initial impulse response. All
+	// storage for swapping is allocated up front, so swaps never allocate.
+	MaxIRLen int
+
+	// CrossfadeBlocks is the number of blocks over which SetImpulseResponse
+	// fades from the old to the new impulse response. Zero means one block.
+	CrossfadeBlocks int
+
+	// PlanOptions is passed to the real FFT plan constructor.
+	PlanOptions PlanOptions
+}
+
+// PartitionedConvolver is a low-latency streaming convolver for long impulse
+// responses, such as convolution reverb.
+//
+// The impulse response is split into partitions of BlockSize samples whose
+// spectra are precomputed. Each block of input is transformed once and kept
+// in a frequency-domain delay line; the output block is the inverse transform
+// of the sum of delayed input spectra times partition spectra. Every block
+// therefore costs one forward and one inverse real FFT of length
+// 2*BlockSize, independent of the impulse response length, and the output
+// lags the input by exactly BlockSize samples.
+//
+// Process does not allocate. A PartitionedConvolver must not be used by
+// multiple goroutines at the same time.
+type PartitionedConvolver[F Float, C Complex] struct {
+	block   int
+	maxPart int
+	bins    int
+
+	plan *PlanRealT[F, C]
+
+	// Partition spectra for two impulse responses, so a new one can be
+	// faded in while the old one is still audible.
+	irFreq  [2][]C
+	irParts [2]int
+	active  int
+
+	fdl  []C // maxPart input spectra, newest at head
+	head int
+
+	window  []F // previous and current input block
+	inFill  int
+	outBuf  []F // output block being emitted
+	accum   []C
+	timeBuf []F // inverse transform of the active response
+	fadeBuf []F // inverse transform of the response being faded in
+
+	fadeBlocks int
+	fadeTotal  int
+	fadePos    int // samples faded so far; fadeTotal when idle
+}
+
+// NewPartitionedConvolver creates a PartitionedConvolver for ir with the
+// given block size.
+//
+// Example:
+//
+//	conv, err := algofft.NewPartitionedConvolver[float32, complex64](reverbIR, 256)
+func NewPartitionedConvolver[F Float, C Complex](ir []F, blockSize int) (*PartitionedConvolver[F, C], error) {
+	return NewPartitionedConvolverWithOptions[F, C](ir, PartitionedOptions{BlockSize: blockSize})
+}
+
+// NewPartitionedConvolverWithOptions creates a PartitionedConvolver for ir
+// with explicit options.
+func NewPartitionedConvolverWithOptions[F Float, C Complex](
+	ir []F, opts PartitionedOptions,
+) (*PartitionedConvolver[F, C], error) {
+	if ir == nil {
+		return nil, ErrNilSlice
+	}
+
+	maxIRLen := opts.MaxIRLen
+	if maxIRLen == 0 {
+		maxIRLen = len(ir)
+	}
+
+	if len(ir) == 0 || opts.BlockSize < 1 || opts.CrossfadeBlocks < 0 || len(ir) > maxIRLen {
+		return nil, ErrInvalidLength
+	}
+
+	plan, err := NewPlanRealTWithOptions[F, C](2*opts.BlockSize, opts.PlanOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	block := opts.BlockSize
+	maxPart := (maxIRLen + block - 1) / block
+	bins := plan.SpectrumLen()
+
+	c := &PartitionedConvolver[F, C]{
+		block:      block,
+		maxPart:    maxPart,
+		bins:       bins,
+		plan:       plan,
+		irFreq:     [2][]C{make([]C, maxPart*bins), make([]C, maxPart*bins)},
+		fdl:        make([]C, maxPart*bins),
+		window:     make([]F, 2*block),
+		outBuf:     make([]F, block),
+		accum:      make([]C, bins),
+		timeBuf:    make([]F, 2*block),
+		fadeBuf:    make([]F, 2*block),
+		fadeBlocks: max(opts.CrossfadeBlocks, 1),
+	}
+
+	err = c.loadImpulseResponse(c.active, ir)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// BlockSize returns the partition and processing block size.
+func (c *PartitionedConvolver[F, C]) BlockSize() int {
+	return c.block
+}
+
+// Latency returns the delay between input and output in samples, which
+// equals BlockSize().
+func (c *PartitionedConvolver[F, C]) Latency() int {
+	return c.block
+}
+
+// MaxIRLen returns the longest impulse response SetImpulseResponse accepts:
+// the configured maximum rounded up to whole partitions.
+func (c *PartitionedConvolver[F, C]) MaxIRLen() int {
+	return c.maxPart * c.block
+}
+
+// Process convolves src with the impulse response and writes len(src)
+// samples to dst, delayed by Latency() samples. Chunks may have any length;
+// state is carried across calls. dst and src may be the same slice.
+func (c *PartitionedConvolver[F, C]) Process(dst, src []F) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != len(src) {
+		return ErrLengthMismatch
+	}
+
+	for pos := 0; pos < len(src); {
+		n := min(c.block-c.inFill, len(src)-pos)
+
+		// Read the input before writing the output so dst may alias src.
+		copy(c.window[c.block+c.inFill:], src[pos:pos+n])
+		copy(dst[pos:pos+n], c.outBuf[c.inFill:c.inFill+n])
+
+		c.inFill += n
+		pos += n
+
+		if c.inFill == c.block {
+			err := c.processBlock()
+			if err != nil {
+				return err
+			}
+
+			c.inFill = 0
+		}
+	}
+
+	return nil
+}
+
+// SetImpulseResponse replaces the impulse response. The new response is
+// faded in over the configured number of blocks, starting with the next
+// completed block; because the delay line stores input spectra, the new
+// response immediately sees the full input history. If a previous fade is
+// still running it is completed at once. While fading, each block needs a
+// second inverse FFT.
+//
+// The response may be at most MaxIRLen() samples long. SetImpulseResponse
+// does not allocate, but it runs one forward FFT per partition, so call it
+// outside time-critical processing where possible.
+func (c *PartitionedConvolver[F, C]) SetImpulseResponse(ir []F) error {
+	if ir == nil {
+		return ErrNilSlice
+	}
+
+	if len(ir) == 0 || len(ir) > c.MaxIRLen() {
+		return ErrInvalidLength
+	}
+
+	if c.fading() {
+		c.active = 1 - c.active
+	}
+
+	err := c.loadImpulseResponse(1-c.active, ir)
+	if err != nil {
+		return err
+	}
+
+	c.fadeTotal = c.fadeBlocks * c.block
+	c.fadePos = 0
+
+	return nil
+}
+
+// Reset clears the input history and pending output. The impulse response
+// is kept; a running crossfade is completed.
+func (c *PartitionedConvolver[F, C]) Reset() {
+	if c.fading() {
+		c.active = 1 - c.active
+		c.fadePos = c.fadeTotal
+	}
+
+	clear(c.fdl)
+	clear(c.window)
+	clear(c.outBuf)
+
+	c.head = 0
+	c.inFill = 0
+}
+
+func (c *PartitionedConvolver[F, C]) fading() bool {
+	return c.fadePos < c.fadeTotal
+}
+
+// loadImpulseResponse computes the partition spectra of ir into slot.
+func (c *PartitionedConvolver[F, C]) loadImpulseResponse(slot int, ir []F) error {
+	parts := (len(ir) + c.block - 1) / c.block
+	scratch := c.timeBuf
+
+	for p := range parts {
+		clear(scratch)
+		copy(scratch, ir[p*c.block:min((p+1)*c.block, len(ir))])
+
+		err := c.plan.Forward(c.irFreq[slot][p*c.bins:(p+1)*c.bins], scratch)
+		if err != nil {
+			return err
+		}
+	}
+
+	clear(c.irFreq[slot][parts*c.bins:])
+	c.irParts[slot] = parts
+
+	return nil
+}
+
+// processBlock runs one block through the delay line and fills outBuf with
+// the next block of output.
+func (c *PartitionedConvolver[F, C]) processBlock() error {
+	c.head = (c.head + 1) % c.maxPart
+
+	err := c.plan.Forward(c.fdl[c.head*c.bins:(c.head+1)*c.bins], c.window)
+	if err != nil {
+		return err
+	}
+
+	// Slide the window: the current block becomes the previous one.
+	copy(c.window[:c.block], c.window[c.block:])
+
+	err = c.filterBlock(c.active, c.timeBuf)
+	if err != nil {
+		return err
+	}
+
+	copy(c.outBuf, c.timeBuf[c.block:])
+
+	if c.fading() {
+		err = c.filterBlock(1-c.active, c.fadeBuf)
+		if err != nil {
+			return err
+		}
+
+		total := F(c.fadeTotal)
+		for i := range c.outBuf {
+			g := F(c.fadePos+i+1) / total
+			c.outBuf[i] += g * (c.fadeBuf[c.block+i] - c.outBuf[i])
+		}
+
+		c.fadePos += c.block
+		if !c.fading() {
+			c.active = 1 - c.active
+		}
+	}
+
+	return nil
+}
+
+// filterBlock multiplies the delay line with the partition spectra of slot
+// and writes the inverse transform to dst. Only dst[block:2*block] is valid
+// linear convolution output; the first half holds circular wrap-around.
+func (c *PartitionedConvolver[F, C]) filterBlock(slot int, dst []F) error {
+	clear(c.accum)
+
+	ir := c.irFreq[slot]
+
+	for p := range c.irParts[slot] {
+		idx := c.head - p
+		if idx < 0 {
+			idx += c.maxPart
+		}
+
+		x := c.fdl[idx*c.bins : (idx+1)*c.bins]
+		h := ir[p*c.bins : (p+1)*c.bins]
+
+		for k := range c.accum {
+			c.accum[k] += x[k] * h[k]
+		}
+	}
+
+	return c.plan.Inverse(dst, c.accum)
+}
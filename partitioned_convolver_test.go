@@ -0,0 +1,190 @@
+package algofft
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func randomFloat64s(rng *rand.Rand, n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = rng.Float64()*2 - 1
+	}
+
+	return out
+}
+
+func TestPartitionedConvolverMatchesDelayedConvolution(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(34))
+
+	for _, tc := range []struct{ irLen, block int }{{1, 4}, {100, 16}, {64, 64}, {333, 32}, {50, 100}} {
+		ir := randomFloat64s(rng, tc.irLen)
+		signal := randomFloat64s(rng, 900)
+		want := naiveConvolveFloat64(signal, ir)
+
+		conv, err := NewPartitionedConvolver[float64, complex128](ir, tc.block)
+		if err != nil {
+			t.Fatalf("NewPartitionedConvolver(ir=%d, block=%d) returned error: %v", tc.irLen, tc.block, err)
+		}
+
+		if conv.Latency() != tc.block {
+			t.Fatalf("Latency() = %d, want %d", conv.Latency(), tc.block)
+		}
+
+		got := streamChunks(t, rng, signal, conv.Process)
+
+		for i := range got {
+			var expected float64
+			if i >= tc.block {
+				expected = want[i-tc.block]
+			}
+
+			assertApproxFloat64(t, got[i], expected, 1e-10, "ir=%d block=%d got[%d]", tc.irLen, tc.block, i)
+		}
+	}
+}
+
+func TestPartitionedConvolverFloat32(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(35))
+	ir := toFloat32(randomFloat64s(rng, 200))
+	signal := toFloat32(randomFloat64s(rng, 600))
+	want := naiveConvolveReal(signal, ir)
+
+	const block = 64
+
+	conv, err := NewPartitionedConvolver[float32, complex64](ir, block)
+	if err != nil {
+		t.Fatalf("NewPartitionedConvolver() returned error: %v", err)
+	}
+
+	got := streamChunks(t, rng, signal, conv.Process)
+
+	for i := block; i < len(got); i++ {
+		assertApproxFloat64(t, float64(got[i]), float64(want[i-block]), 1e-4, "got[%d]", i)
+	}
+}
+
+func TestPartitionedConvolverCrossfade(t *testing.T) {
+	t.Parallel()
+
+	const (
+		block       = 32
+		fadeBlocks  = 2
+		swapAtBlock = 5
+	)
+
+	rng := rand.New(rand.NewSource(36))
+	oldIR := randomFloat64s(rng, 150)
+	newIR := randomFloat64s(rng, 70)
+	signal := randomFloat64s(rng, 20*block)
+
+	conv, err := NewPartitionedConvolverWithOptions[float64, complex128](oldIR, PartitionedOptions{
+		BlockSize:       block,
+		MaxIRLen:        200,
+		CrossfadeBlocks: fadeBlocks,
+	})
+	if err != nil {
+		t.Fatalf("NewPartitionedConvolverWithOptions() returned error: %v", err)
+	}
+
+	got := make([]float64, len(signal))
+	swap := swapAtBlock * block
+
+	err = conv.Process(got[:swap], signal[:swap])
+	if err != nil {
+		t.Fatalf("Process() returned error: %v", err)
+	}
+
+	err = conv.SetImpulseResponse(newIR)
+	if err != nil {
+		t.Fatalf("SetImpulseResponse() returned error: %v", err)
+	}
+
+	err = conv.Process(got[swap:], signal[swap:])
+	if err != nil {
+		t.Fatalf("Process() returned error: %v", err)
+	}
+
+	wantOld := naiveConvolveFloat64(signal, oldIR)
+	wantNew := naiveConvolveFloat64(signal, newIR)
+
+	// Output sample i is convolution sample i-block. Convolution samples
+	// before the swap block use the old response; from the end of the fade
+	// on they use the new one with its full input history.
+	fadeEnd := swap + fadeBlocks*block
+
+	for i := block; i < len(got); i++ {
+		y := i - block
+
+		switch {
+		case y < swap:
+			assertApproxFloat64(t, got[i], wantOld[y], 1e-10, "before fade got[%d]", i)
+		case y >= fadeEnd:
+			assertApproxFloat64(t, got[i], wantNew[y], 1e-10, "after fade got[%d]", i)
+		default:
+			g := float64(y-swap+1) / float64(fadeBlocks*block)
+			assertApproxFloat64(t, got[i], (1-g)*wantOld[y]+g*wantNew[y], 1e-10, "during fade got[%d]", i)
+		}
+	}
+}
+
+func TestPartitionedConvolverErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewPartitionedConvolver[float32, complex64](nil, 8)
+	if !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil ir error = %v, want ErrNilSlice", err)
+	}
+
+	_, err = NewPartitionedConvolver[float32, complex64]([]float32{1}, 0)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("block 0 error = %v, want ErrInvalidLength", err)
+	}
+
+	conv, err := NewPartitionedConvolver[float32, complex64](make([]float32, 20), 8)
+	if err != nil {
+		t.Fatalf("NewPartitionedConvolver() returned error: %v", err)
+	}
+
+	if conv.MaxIRLen() != 24 {
+		t.Fatalf("MaxIRLen() = %d, want 24", conv.MaxIRLen())
+	}
+
+	err = conv.SetImpulseResponse(make([]float32, 25))
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("oversized ir error = %v, want ErrInvalidLength", err)
+	}
+
+	err = conv.Process(make([]float32, 2), make([]float32, 3))
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("Process(mismatch) error = %v, want ErrLengthMismatch", err)
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestPartitionedConvolverProcessNoAllocs(t *testing.T) {
+	ir := make([]float32, 4800)
+	for i := range ir {
+		ir[i] = float32(i%13) / 13
+	}
+
+	conv, err := NewPartitionedConvolver[float32, complex64](ir, 128)
+	if err != nil {
+		t.Fatalf("NewPartitionedConvolver() returned error: %v", err)
+	}
+
+	buf := make([]float32, 300)
+
+	assertNoAllocs(t, "PartitionedConvolver.Process", func() error {
+		return conv.Process(buf, buf)
+	})
+
+	assertNoAllocs(t, "PartitionedConvolver.SetImpulseResponse", func() error {
+		return conv.SetImpulseResponse(ir[:1000])
+	})
+}
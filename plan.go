@@ -164,12 +164,17 @@ func (p *Plan[T]) String() string {
 		strategyName = "Bluestein"
 	}
 
+	schedule := ""
+	if len(p.meta.Radices) > 0 {
+		schedule = ", radices=" + fft.FormatRadices(p.meta.Radices)
+	}
+
 	pooled := ""
 	if p.pool != nil {
 		pooled = ", pooled"
 	}
 
-	return "Plan[" + typeName + "](" + itoa(p.n) + ", " + strategyName + pooled + ")"
+	return "Plan[" + typeName + "](" + itoa(p.n) + ", " + strategyName + schedule + pooled + ")"
 }
 
 // itoa converts an int to a string without importing strconv.
@@ -215,6 +220,43 @@ func planBitReversal[T Complex](n int, estimate fft.PlanEstimate[T]) []int {
 	return fft.ComputeBitReversalIndices(n)
 }
 
+// planRadices resolves the mixed-radix factor order for a plan of size n.
+//
+// An explicit PlanOptions.Radices schedule is validated and overrides the
+// estimate: codelets and the planner's strategy choice are bypassed and the
+// mixed-radix kernel runs with exactly that order. Otherwise a factor order
+// chosen by measurement or wisdom is used, and finally the default schedule
+// is reported for sizes that end up on the mixed-radix kernel. Returns nil
+// when the plan does not use mixed-radix stages.
+func planRadices[T Complex](n int, opts PlanOptions, estimate *fft.PlanEstimate[T]) ([]int, error) {
+	if len(opts.Radices) > 0 {
+		if !fft.ValidMixedRadixSchedule(n, opts.Radices) {
+			return nil, ErrInvalidRadices
+		}
+
+		radices := append([]int(nil), opts.Radices...)
+		*estimate = fft.PlanEstimate[T]{
+			Strategy:  fft.KernelDIT,
+			Algorithm: fft.MixedRadixAlgorithmName(radices),
+			Radices:   radices,
+		}
+
+		return radices, nil
+	}
+
+	// Measured (or wisdom-reproduced) factor order.
+	if estimate.Radices != nil {
+		return estimate.Radices, nil
+	}
+
+	if m.IsPowerOf2(n) || estimate.ForwardCodelet != nil ||
+		estimate.Strategy == fft.KernelBluestein || estimate.Strategy == fft.KernelRecursive {
+		return nil, nil
+	}
+
+	return fft.MixedRadixSchedule(n), nil
+}
+
 // Forward computes the forward (time-to-frequency) FFT.
 //
 // The transform is computed as:
@@ -477,12 +519,20 @@ func newPlanWithFeatures[T Complex](n int, features cpu.Features, opts PlanOptio
 		estimate = fft.EstimatePlan[T](n, features, opts.Wisdom, opts.Strategy)
 	}
 
+	radices, err := planRadices(n, opts, &estimate)
+	if err != nil {
+		return nil, err
+	}
+
 	useBluestein := estimate.Strategy == fft.KernelBluestein
 	useRecursive := estimate.Strategy == fft.KernelRecursive
 	strategy := estimate.Strategy
 
 	// Get fallback kernels (used when no codelet is available)
 	kernels := fft.SelectKernelsWithStrategy[T](features, strategy)
+	if estimate.Radices != nil {
+		kernels = fft.MixedRadixKernels[T](radices)
+	}
 
 	var (
 		zero           T
@@ -560,9 +610,7 @@ func newPlanWithFeatures[T Complex](n int, features cpu.Features, opts PlanOptio
 		bluesteinFilterInv = fft.ComputeBluesteinFilter(n, bluesteinM, bluesteinChirpInv, bluesteinTwiddle, bluesteinBitrev, bluesteinScratch)
 	} else if useRecursive {
 		// Recursive decomposition: plan decomposition and generate specialized twiddles
-		codeletSizes := []int{4, 8, 16, 32, 64, 128, 256, 512}
-		cacheSize := 32768 // L1 cache size estimate
-		decompStrategy = fft.PlanDecomposition(n, codeletSizes, cacheSize)
+		decompStrategy = fft.PlanDecomposition(n, fft.RecursiveCodeletSizes, fft.RecursiveCacheSize)
 
 		// Generate twiddles for recursive decomposition
 		var twiddleSize int
@@ -671,6 +719,7 @@ func newPlanWithFeatures[T Complex](n int, features cpu.Features, opts PlanOptio
 		meta: PlanMeta{
 			Planner:  opts.Planner,
 			Strategy: strategy,
+			Radices:  radices,
 			Batch:    opts.Batch,
 			Stride:   opts.Stride,
 			InPlace:  opts.InPlace,
@@ -741,12 +790,20 @@ func NewPlanFromPoolWithOptions[T Complex](n int, pool *fft.BufferPool, opts Pla
 	features := cpu.DetectFeatures()
 	estimate := fft.EstimatePlan[T](n, features, opts.Wisdom, opts.Strategy)
 
+	radices, err := planRadices(n, opts, &estimate)
+	if err != nil {
+		return nil, err
+	}
+
 	strategy := estimate.Strategy
 	if strategy == fft.KernelBluestein {
 		return nil, ErrNotImplemented
 	}
 
 	kernels := fft.SelectKernelsWithStrategy[T](features, strategy)
+	if estimate.Radices != nil {
+		kernels = fft.MixedRadixKernels[T](radices)
+	}
 
 	twiddle, scratch, stridedScratch, twiddleBacking, scratchBacking, stridedBacking := getBuffersFromPool[T](n, pool)
 
@@ -776,6 +833,7 @@ func NewPlanFromPoolWithOptions[T Complex](n int, pool *fft.BufferPool, opts Pla
 		meta: PlanMeta{
 			Planner:  opts.Planner,
 			Strategy: strategy,
+			Radices:  radices,
 			Batch:    opts.Batch,
 			Stride:   opts.Stride,
 			InPlace:  opts.InPlace,
@@ -915,6 +973,10 @@ func (p *Plan[T]) Clone() *Plan[T] {
 		scratchSize = p.bluesteinM
 	}
 
+	if p.decompStrategy != nil {
+		scratchSize = fft.ScratchSizeRecursive(p.decompStrategy)
+	}
+
 	switch any(zero).(type) {
 	case complex64:
 		scratchAligned, scratchRaw := mem.AllocAlignedComplex64(scratchSize)
@@ -958,6 +1020,7 @@ func (p *Plan[T]) Clone() *Plan[T] {
 		forwardKernel:     p.forwardKernel,
 		inverseKernel:     p.inverseKernel,
 		kernelStrategy:    p.kernelStrategy,
+		decompStrategy:    p.decompStrategy, // Shared (immutable)
 		meta:              p.meta,
 		twiddleBacking:    p.twiddleBacking, // Shared reference (keeps original alive)
 		scratchBacking:    scratchBacking,   // New allocation
@@ -58,6 +58,7 @@ func NewPlan2DWithOptions[T Complex](rows, cols int, opts PlanOptions) (*Plan2D[
 	childOpts.Batch = 0
 	childOpts.Stride = 0
 	childOpts.InPlace = false
+	childOpts.Radices = nil
 
 	// Create 1D plans for rows and columns
 	rowPlan, err := newPlanWithFeatures[T](cols, features, childOpts)
@@ -133,6 +134,12 @@ func (p *Plan2D[T]) Cols() int {
 	return p.cols
 }
 
+// Dims returns the dimensions {rows, cols}, for the ND helpers such as
+// FFTShiftND.
+func (p *Plan2D[T]) Dims() []int {
+	return []int{p.rows, p.cols}
+}
+
 // Len returns the total number of elements (rows × cols).
 func (p *Plan2D[T]) Len() int {
 	return p.rows * p.cols
@@ -58,6 +58,7 @@ func NewPlan3DWithOptions[T Complex](depth, height, width int, opts PlanOptions)
 	childOpts.Batch = 0
 	childOpts.Stride = 0
 	childOpts.InPlace = false
+	childOpts.Radices = nil
 
 	// Create 1D plans for each dimension
 	widthPlan, err := newPlanWithFeatures[T](width, features, childOpts)
@@ -143,6 +144,12 @@ func (p *Plan3D[T]) Width() int {
 	return p.width
 }
 
+// Dims returns the dimensions {depth, height, width}, for the ND helpers
+// such as FFTShiftND.
+func (p *Plan3D[T]) Dims() []int {
+	return []int{p.depth, p.height, p.width}
+}
+
 // Len returns the total number of elements (depth × height × width).
 func (p *Plan3D[T]) Len() int {
 	return p.depth * p.height * p.width
@@ -4,9 +4,12 @@ package algofft
 type PlanMeta struct {
 	Planner  PlannerMode
 	Strategy KernelStrategy
-	Batch    int
-	Stride   int
-	InPlace  bool
+	// Radices is the mixed-radix factor order the plan executes, outermost
+	// stage first. Nil when the plan does not use mixed-radix stages.
+	Radices []int
+	Batch   int
+	Stride  int
+	InPlace bool
 }
 
 // Meta returns metadata about how the plan was constructed.
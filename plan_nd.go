@@ -74,6 +74,7 @@ func NewPlanNDWithOptions[T Complex](dims []int, opts PlanOptions) (*PlanND[T],
 	childOpts.Batch = 0
 	childOpts.Stride = 0
 	childOpts.InPlace = false
+	childOpts.Radices = nil
 
 	// Create 1D plans for each dimension
 	plans := make([]*Plan[T], len(dims))
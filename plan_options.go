@@ -10,8 +10,15 @@ import "time"
 //   - PlannerPatient: Moderate benchmark including SixStep
 //   - PlannerExhaustive: Thorough benchmark testing all strategies
 //
+// For non-power-of-two sizes whose prime factors are at most 16, every
+// measuring mode instead compares several mixed-radix factor orders (3 for
+// Measure, 6 for Patient, all candidates for Exhaustive) against recursive
+// decomposition and Bluestein.
+//
 // When using PlannerMeasure or higher with a WisdomStore, the planner
 // automatically records benchmark results for future plan creations.
+// Mixed-radix winners are recorded with their factor order (for example
+// "mixedradix_8x8x6"), so a later PlannerEstimate plan reproduces them.
 type PlannerMode uint8
 
 const (
@@ -52,7 +59,12 @@ type PlanOptions struct {
 	// to let the planner choose based on size and benchmarks.
 	Strategy KernelStrategy
 
-	// Radices hints at which radices to prefer for mixed-radix FFT.
+	// Radices forces an explicit mixed-radix factor order, outermost stage
+	// first (e.g. []int{8, 8, 6} for n=384). The product must equal the
+	// transform length and every radix must be in [2, 16]; otherwise plan
+	// creation fails with ErrInvalidRadices. When set, the schedule overrides
+	// Strategy and any codelet. For real plans it applies to the internal
+	// N/2-point complex transform; multi-dimensional plans ignore it.
 	Radices []int
 
 	// Batch specifies the number of transforms to execute in a batch.
@@ -127,21 +139,13 @@ func normalizePlanOptions(opts PlanOptions) PlanOptions {
 		opts.Stride = 0 // 0 means use default stride
 	}
 
-	// Normalize radices: drop invalid entries (<= 1)
-	// If none remain, fall back to planner defaults by clearing the slice
+	// Copy the radix schedule so that plans never alias the caller's slice.
+	// It is validated as a whole, including entries <= 1, when the plan is
+	// built.
 	if len(opts.Radices) > 0 {
-		validRadices := opts.Radices[:0]
-		for _, r := range opts.Radices {
-			if r > 1 {
-				validRadices = append(validRadices, r)
-			}
-		}
-
-		if len(validRadices) == 0 {
-			opts.Radices = nil
-		} else {
-			opts.Radices = validRadices
-		}
+		opts.Radices = append([]int(nil), opts.Radices...)
+	} else {
+		opts.Radices = nil
 	}
 
 	return opts
@@ -0,0 +1,222 @@
+package algofft
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/internal/reference"
+)
+
+func TestPlanRadicesDriveMixedRadix(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		n       int
+		radices []int
+	}{
+		{384, []int{8, 8, 6}},
+		{384, []int{6, 8, 8}},
+		{512, []int{8, 8, 8}},
+		{56, []int{7, 8}},
+		{60, []int{3, 4, 5}},
+	}
+
+	for _, tc := range cases {
+		plan, err := NewPlanWithOptions[complex128](tc.n, PlanOptions{Radices: tc.radices})
+		if err != nil {
+			t.Fatalf("NewPlanWithOptions(%d, %v) returned error: %v", tc.n, tc.radices, err)
+		}
+
+		if got := plan.Meta().Radices; !slices.Equal(got, tc.radices) {
+			t.Fatalf("Meta().Radices = %v, want %v", got, tc.radices)
+		}
+
+		src := make([]complex128, tc.n)
+		for i := range src {
+			src[i] = complex(float64(i%7)-3, float64(i%5)*0.5)
+		}
+
+		got := make([]complex128, tc.n)
+		if err := plan.Forward(got, src); err != nil {
+			t.Fatalf("Forward() returned error: %v", err)
+		}
+
+		want := reference.NaiveDFT128(src)
+		for i := range got {
+			assertApproxComplex128Tolf(t, got[i], want[i], 1e-9, "n=%d radices=%v got[%d]", tc.n, tc.radices, i)
+		}
+
+		back := make([]complex128, tc.n)
+		if err := plan.Inverse(back, got); err != nil {
+			t.Fatalf("Inverse() returned error: %v", err)
+		}
+
+		for i := range back {
+			assertApproxComplex128Tolf(t, back[i], src[i], 1e-9, "n=%d radices=%v back[%d]", tc.n, tc.radices, i)
+		}
+	}
+}
+
+func TestPlanRadicesStringAndMeta(t *testing.T) {
+	t.Parallel()
+
+	plan, err := NewPlanWithOptions[complex64](384, PlanOptions{Radices: []int{8, 8, 6}})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions() returned error: %v", err)
+	}
+
+	if s := plan.String(); !strings.Contains(s, "radices=8x8x6") {
+		t.Fatalf("String() = %q, want radices=8x8x6", s)
+	}
+
+	if got := plan.Clone().Meta().Radices; !slices.Equal(got, []int{8, 8, 6}) {
+		t.Fatalf("Clone().Meta().Radices = %v, want [8 8 6]", got)
+	}
+
+	auto, err := NewPlanT[complex64](120)
+	if err != nil {
+		t.Fatalf("NewPlanT(120) returned error: %v", err)
+	}
+
+	if got := auto.Meta().Radices; !slices.Equal(got, []int{5, 4, 3, 2}) {
+		t.Fatalf("default Meta().Radices = %v, want [5 4 3 2]", got)
+	}
+
+	// Sizes with a generated codelet do not run mixed-radix stages.
+	codelet, err := NewPlanT[complex64](60)
+	if err != nil {
+		t.Fatalf("NewPlanT(60) returned error: %v", err)
+	}
+
+	if got := codelet.Meta().Radices; got != nil {
+		t.Fatalf("codelet plan Meta().Radices = %v, want nil", got)
+	}
+
+	if algo := codelet.Algorithm(); algo != "dit60_gen_generic" {
+		t.Fatalf("NewPlanT(60).Algorithm() = %q, want dit60_gen_generic", algo)
+	}
+
+	pow2, err := NewPlanT[complex64](64)
+	if err != nil {
+		t.Fatalf("NewPlanT(64) returned error: %v", err)
+	}
+
+	if got := pow2.Meta().Radices; got != nil {
+		t.Fatalf("power-of-two Meta().Radices = %v, want nil", got)
+	}
+
+	if s := pow2.String(); strings.Contains(s, "radices=") {
+		t.Fatalf("String() = %q, want no radices", s)
+	}
+}
+
+func TestPlanRadicesInvalid(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		n       int
+		radices []int
+	}{
+		{384, []int{8, 8, 8}},
+		{34, []int{17, 2}},
+		{64, []int{4, 4}},
+		{384, []int{8, 0, 8, 6}},
+		{384, []int{1, 8, 8, 6}},
+		{384, []int{-1, -8, 8, 6}},
+	}
+
+	for _, tc := range cases {
+		_, err := NewPlanWithOptions[complex64](tc.n, PlanOptions{Radices: tc.radices})
+		if !errors.Is(err, ErrInvalidRadices) {
+			t.Fatalf("NewPlanWithOptions(%d, %v) = %v, want ErrInvalidRadices", tc.n, tc.radices, err)
+		}
+	}
+}
+
+func TestPlanRadicesNotAliased(t *testing.T) {
+	t.Parallel()
+
+	radices := []int{8, 8, 6}
+
+	plan, err := NewPlanWithOptions[complex64](384, PlanOptions{Radices: radices})
+	if err != nil {
+		t.Fatalf("NewPlanWithOptions() returned error: %v", err)
+	}
+
+	radices[0] = 2
+
+	if got := plan.Meta().Radices; !slices.Equal(got, []int{8, 8, 6}) {
+		t.Fatalf("Meta().Radices = %v after changing the caller's slice, want [8 8 6]", got)
+	}
+
+	opts := normalizePlanOptions(PlanOptions{Radices: radices})
+	opts.Radices[1] = 3
+
+	if !slices.Equal(radices, []int{2, 8, 6}) {
+		t.Fatalf("normalizePlanOptions aliased the caller's slice: %v", radices)
+	}
+}
+
+// memoryWisdomStore is a minimal WisdomStore for planner round-trip tests.
+type memoryWisdomStore struct {
+	entries map[WisdomKey]WisdomEntry
+}
+
+func (s *memoryWisdomStore) LookupWisdom(size int, precision uint8, cpuFeatures uint64) (string, bool) {
+	entry, ok := s.entries[WisdomKey{Size: size, Precision: precision, CPUFeatures: cpuFeatures}]
+	return entry.Algorithm, ok
+}
+
+func (s *memoryWisdomStore) Lookup(key WisdomKey) (WisdomEntry, bool) {
+	entry, ok := s.entries[key]
+	return entry, ok
+}
+
+func (s *memoryWisdomStore) Store(entry WisdomEntry) {
+	s.entries[entry.Key] = entry
+}
+
+func TestPlanMeasureNonPowerOfTwoReproducibleFromWisdom(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{384, 1000, 1001} {
+		wisdom := &memoryWisdomStore{entries: map[WisdomKey]WisdomEntry{}}
+
+		measured, err := NewPlanWithOptions[complex128](n, PlanOptions{Planner: PlannerMeasure, Wisdom: wisdom})
+		if err != nil {
+			t.Fatalf("measure plan n=%d: %v", n, err)
+		}
+
+		if len(wisdom.entries) != 1 {
+			t.Fatalf("n=%d: wisdom has %d entries, want 1", n, len(wisdom.entries))
+		}
+
+		estimated, err := NewPlanWithOptions[complex128](n, PlanOptions{Wisdom: wisdom})
+		if err != nil {
+			t.Fatalf("estimate plan n=%d: %v", n, err)
+		}
+
+		if measured.Algorithm() != estimated.Algorithm() ||
+			!slices.Equal(measured.Meta().Radices, estimated.Meta().Radices) {
+			t.Fatalf("n=%d: estimate from wisdom chose %q %v, measure chose %q %v", n,
+				estimated.Algorithm(), estimated.Meta().Radices, measured.Algorithm(), measured.Meta().Radices)
+		}
+
+		src := make([]complex128, n)
+		for i := range src {
+			src[i] = complex(float64(i%11)-5, float64(i%4))
+		}
+
+		got := make([]complex128, n)
+		if err := estimated.Forward(got, src); err != nil {
+			t.Fatalf("Forward() returned error: %v", err)
+		}
+
+		want := reference.NaiveDFT128(src)
+		for i := range got {
+			assertApproxComplex128Tolf(t, got[i], want[i], 1e-8, "n=%d got[%d]", n, i)
+		}
+	}
+}
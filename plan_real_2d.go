@@ -26,6 +26,7 @@ type PlanReal2D struct {
 	colPlans       []*Plan[complex64] // Complex FFT for each column (size M)
 	scratchCompact []complex64        // Working buffer (M×(N/2+1))
 	scratchFull    []complex64        // Full spectrum buffer (M×N) for ForwardFull
+	colScratch     []complex64        // Single-column buffer (M) for column transforms
 	options        PlanOptions
 
 	// backing keeps aligned buffers alive for GC
@@ -61,6 +62,7 @@ func NewPlanReal2DWithOptions(rows, cols int, opts PlanOptions) (*PlanReal2D, er
 	childOpts.Batch = 0
 	childOpts.Stride = 0
 	childOpts.InPlace = false
+	childOpts.Radices = nil
 
 	// Create 1D real plan for rows
 	rowPlan, err := newPlanRealWithFeatures(cols, features, childOpts)
@@ -96,6 +98,7 @@ func NewPlanReal2DWithOptions(rows, cols int, opts PlanOptions) (*PlanReal2D, er
 		colPlans:              colPlans,
 		scratchCompact:        scratchCompact,
 		scratchFull:           scratchFull,
+		colScratch:            make([]complex64, rows),
 		scratchCompactBacking: scratchCompactBacking,
 		scratchFullBacking:    scratchFullBacking,
 		options:               opts,
@@ -112,6 +115,12 @@ func (p *PlanReal2D) Cols() int {
 	return p.cols
 }
 
+// Dims returns the real input dimensions {rows, cols}, for the ND helpers
+// such as ExpandRealSpectrum.
+func (p *PlanReal2D) Dims() []int {
+	return []int{p.rows, p.cols}
+}
+
 // Len returns the total number of real input elements (rows × cols).
 func (p *PlanReal2D) Len() int {
 	return p.rows * p.cols
@@ -192,7 +201,7 @@ func (p *PlanReal2D) forwardSingle(dst []complex64, src []float32) error {
 	}
 
 	// Step 2: Complex FFT on each column of the half-spectrum
-	colData := make([]complex64, p.rows)
+	colData := p.colScratch
 
 	for col := range p.halfCols {
 		// Extract column
@@ -323,7 +332,7 @@ func (p *PlanReal2D) inverseSingle(dst []float32, src []complex64) error {
 	copy(p.scratchCompact, src)
 
 	// Step 1: Complex IFFT on each column
-	colData := make([]complex64, p.rows)
+	colData := p.colScratch
 
 	for col := range p.halfCols {
 		// Extract column
@@ -420,6 +429,7 @@ func (p *PlanReal2D) Clone() *PlanReal2D {
 		colPlans:              colPlans,
 		scratchCompact:        scratchCompact,
 		scratchFull:           scratchFull,
+		colScratch:            make([]complex64, p.rows),
 		scratchCompactBacking: scratchCompactBacking,
 		scratchFullBacking:    scratchFullBacking,
 	}
@@ -115,6 +115,12 @@ func (p *PlanReal3D) Width() int {
 	return p.width
 }
 
+// Dims returns the real input dimensions {depth, height, width}, for the
+// ND helpers such as ExpandRealSpectrum.
+func (p *PlanReal3D) Dims() []int {
+	return []int{p.depth, p.height, p.width}
+}
+
 // Len returns the total number of real input elements (depth × height × width).
 func (p *PlanReal3D) Len() int {
 	return p.depth * p.height * p.width
@@ -286,6 +286,71 @@ func (p *PlanRealT[F, C]) Inverse(dst []F, src []C) error {
 	return nil
 }
 
+// ForwardBatch computes count real-to-complex FFTs on sequential data.
+//
+// The data layout is sequential, with N/2+1 bins per spectrum:
+//   - FFT i: src[i*N:(i+1)*N] → dst[i*(N/2+1):(i+1)*(N/2+1)]
+//
+// Unlike PlanOptions.Batch, the count is chosen per call.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrInvalidLength if count < 1.
+// Returns ErrLengthMismatch if slice lengths are insufficient.
+func (p *PlanRealT[F, C]) ForwardBatch(dst []C, src []F, count int) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if count < 1 {
+		return ErrInvalidLength
+	}
+
+	bins := p.half + 1
+	if len(dst) < count*bins || len(src) < count*p.n {
+		return ErrLengthMismatch
+	}
+
+	for i := range count {
+		err := p.forwardSingle(dst[i*bins:(i+1)*bins], src[i*p.n:(i+1)*p.n])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InverseBatch computes count complex-to-real inverse FFTs on sequential
+// data, with the layout of ForwardBatch:
+//   - FFT i: src[i*(N/2+1):(i+1)*(N/2+1)] → dst[i*N:(i+1)*N]
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrInvalidLength if count < 1.
+// Returns ErrLengthMismatch if slice lengths are insufficient.
+func (p *PlanRealT[F, C]) InverseBatch(dst []F, src []C, count int) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if count < 1 {
+		return ErrInvalidLength
+	}
+
+	bins := p.half + 1
+	if len(dst) < count*p.n || len(src) < count*bins {
+		return ErrLengthMismatch
+	}
+
+	for i := range count {
+		err := p.inverseSingle(dst[i*p.n:(i+1)*p.n], src[i*bins:(i+1)*bins])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 //nolint:gocognit
 func (p *PlanRealT[F, C]) inverseSingle(dst []F, src []C) error {
 	if dst == nil || src == nil {
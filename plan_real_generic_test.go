@@ -1,6 +1,7 @@
 package algofft
 
 import (
+	"errors"
 	"math"
 	"math/cmplx"
 	"testing"
@@ -374,6 +375,64 @@ func TestPlanReal64_ZeroAlloc(t *testing.T) {
 	}
 }
 
+// TestPlanRealT_ForwardBatch checks that batch transforms match single
+// transforms and round-trip.
+func TestPlanRealT_ForwardBatch(t *testing.T) {
+	t.Parallel()
+
+	const n, count = 64, 5
+
+	plan, err := NewPlanRealT[float64, complex128](n)
+	if err != nil {
+		t.Fatalf("NewPlanRealT failed: %v", err)
+	}
+
+	bins := plan.SpectrumLen()
+	src := make([]float64, n*count)
+
+	for i := range src {
+		src[i] = math.Sin(0.37*float64(i)) + 0.1*float64(i%7)
+	}
+
+	batch := make([]complex128, bins*count)
+	if err := plan.ForwardBatch(batch, src, count); err != nil {
+		t.Fatalf("ForwardBatch failed: %v", err)
+	}
+
+	single := make([]complex128, bins)
+
+	for i := range count {
+		if err := plan.Forward(single, src[i*n:(i+1)*n]); err != nil {
+			t.Fatalf("Forward failed: %v", err)
+		}
+
+		for k := range single {
+			if !complexNear128(batch[i*bins+k], single[k], 1e-12) {
+				t.Fatalf("transform %d bin %d = %v, want %v", i, k, batch[i*bins+k], single[k])
+			}
+		}
+	}
+
+	recovered := make([]float64, n*count)
+	if err := plan.InverseBatch(recovered, batch, count); err != nil {
+		t.Fatalf("InverseBatch failed: %v", err)
+	}
+
+	for i := range src {
+		if math.Abs(recovered[i]-src[i]) > 1e-12 {
+			t.Fatalf("recovered[%d] = %v, want %v", i, recovered[i], src[i])
+		}
+	}
+
+	if err := plan.ForwardBatch(batch, src, 0); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("count 0 error = %v, want ErrInvalidLength", err)
+	}
+
+	if err := plan.InverseBatch(recovered[:n], batch, 2); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("short dst error = %v, want ErrLengthMismatch", err)
+	}
+}
+
 // Helper functions
 
 func complexify64(realData []float64) []complex128 {
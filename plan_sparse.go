@@ -0,0 +1,505 @@
+package algofft
+
+import (
+	"math"
+	"math/bits"
+	"math/cmplx"
+	"math/rand/v2"
+	"sort"
+
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+)
+
+const (
+	// sparseDefaultFailureProbability is used when SparseOptions leaves it unset.
+	sparseDefaultFailureProbability = 1e-3
+
+	// sparseBucketsPerTone controls the default hash table load (B ≈ 4k).
+	sparseBucketsPerTone = 4
+
+	// sparseNoiseFactor scales the median bucket magnitude to obtain the
+	// detection threshold for a round.
+	sparseNoiseFactor = 4.0
+
+	// sparseMaxResidual is the largest relative RMS mismatch between a bucket
+	// and its single-tone model that is still accepted as an isolated tone.
+	sparseMaxResidual = 0.25
+)
+
+// SparseCoefficient is a single non-zero frequency bin recovered by SparseFFT.
+type SparseCoefficient[T Complex] struct {
+	// Freq is the bin index in [0, n).
+	Freq int
+
+	// Value is the unnormalized DFT coefficient X[Freq], matching Plan.Forward.
+	Value T
+}
+
+// SparseOptions configures a SparseFFT.
+type SparseOptions struct {
+	// FailureProbability bounds the probability that one of the k dominant
+	// tones is missed. Smaller values run more hashing rounds.
+	// Default is 1e-3.
+	FailureProbability float64
+
+	// Buckets overrides the number of hash buckets B per round. It must be a
+	// power of two no larger than n. Zero selects about 4k buckets.
+	Buckets int
+
+	// Seed seeds the random spectral permutations. Zero uses a fixed seed so
+	// results are reproducible by default.
+	Seed uint64
+
+	// Plan is passed through to the inner size-B complex plan.
+	Plan PlanOptions
+}
+
+// SparseFFT is an experimental sublinear-time FFT for signals whose spectrum
+// is dominated by at most k tones.
+//
+// Each round applies a random spectral permutation, subsamples the input so
+// that the spectrum aliases into B buckets, and transforms the buckets with a
+// small Plan. Buckets holding a single tone are located bit by bit from a set
+// of time-shifted subsamples; recovered tones are peeled from later rounds.
+//
+// Odd-multiplier permutations cannot separate tones whose bin distance is a
+// multiple of B, so buckets that stay occupied after the planned rounds are
+// retried with twice as many buckets until they resolve.
+//
+// The hashing uses plain subsampling, without the flat-window filters of
+// SFFT or the peeling codes of FFAST, so a bucket receives the aliases of
+// its bins with no suppression of neighbouring bins. Tones must therefore
+// lie exactly on the DFT grid: off-grid tones and windowed signals leak
+// into many bins, which is not a sparse spectrum, and are not recovered
+// reliably. Broadband noise is tolerated up to the threshold of each
+// bucket.
+//
+// Only power-of-two lengths are supported. The returned values match
+// Plan.Forward (unnormalized DFT), so a time-domain tone of amplitude a at bin f
+// yields Value ≈ a·n.
+//
+// A SparseFFT is not safe for concurrent use.
+type SparseFFT[T Complex] struct {
+	n      int
+	k      int
+	rounds int
+	opts   PlanOptions
+
+	// levels[i] hashes into Buckets()·2^i buckets; levels beyond 0 are
+	// created on demand.
+	levels []*sparseLevel[T]
+
+	rng *rand.Rand
+}
+
+// sparseLevel holds the plan and buffers for one bucket count.
+type sparseLevel[T Complex] struct {
+	n       int
+	buckets int
+	stride  int   // n / buckets
+	shifts  []int // time shifts: 0, L/2, L/4, ..., 1
+
+	plan    *Plan[T]
+	samples []T
+	spectra [][]T          // one B-point spectrum per shift
+	resid   [][]complex128 // scaled spectra minus already recovered tones
+	mags    []float64
+	rots    []complex128
+}
+
+// NewSparseFFT creates a sparse FFT for length n expecting at most k dominant tones.
+func NewSparseFFT[T Complex](n, k int) (*SparseFFT[T], error) {
+	return NewSparseFFTWithOptions[T](n, k, SparseOptions{})
+}
+
+// NewSparseFFTWithOptions creates a sparse FFT with explicit options.
+func NewSparseFFTWithOptions[T Complex](n, k int, opts SparseOptions) (*SparseFFT[T], error) {
+	if n < 1 || !m.IsPowerOf2(n) || k < 1 || k > n {
+		return nil, ErrInvalidLength
+	}
+
+	delta := opts.FailureProbability
+	if delta <= 0 || delta >= 1 {
+		delta = sparseDefaultFailureProbability
+	}
+
+	buckets := opts.Buckets
+	if buckets == 0 {
+		buckets = min(m.NextPowerOfTwo(sparseBucketsPerTone*k), n)
+	}
+
+	if buckets < 1 || buckets > n || !m.IsPowerOf2(buckets) {
+		return nil, ErrInvalidLength
+	}
+
+	level, err := newSparseLevel[T](n, buckets, opts.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	// A tone collides with another in a round with probability about k/B.
+	// Requiring the union bound k·(k/B)^R ≤ δ gives the round count.
+	collision := math.Min(float64(k)/float64(buckets), 0.5)
+
+	rounds := 1
+	if buckets < n {
+		rounds = max(1, int(math.Ceil(math.Log(float64(k)/delta)/-math.Log(collision))))
+	}
+
+	seed := opts.Seed
+	if seed == 0 {
+		seed = 0x5eed5eed
+	}
+
+	return &SparseFFT[T]{
+		n:      n,
+		k:      k,
+		rounds: rounds,
+		opts:   opts.Plan,
+		levels: []*sparseLevel[T]{level},
+		rng:    rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15)),
+	}, nil
+}
+
+func newSparseLevel[T Complex](n, buckets int, opts PlanOptions) (*sparseLevel[T], error) {
+	plan, err := NewPlanWithOptions[T](buckets, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	stride := n / buckets
+
+	// Shift L/2^j resolves bit j-1 of the bucket-local index q = (f'-b)/B.
+	shifts := []int{0}
+	for s := stride / 2; s >= 1; s /= 2 {
+		shifts = append(shifts, s)
+	}
+
+	spectra := make([][]T, len(shifts))
+	resid := make([][]complex128, len(shifts))
+
+	for i := range shifts {
+		spectra[i] = make([]T, buckets)
+		resid[i] = make([]complex128, buckets)
+	}
+
+	return &sparseLevel[T]{
+		n:       n,
+		buckets: buckets,
+		stride:  stride,
+		shifts:  shifts,
+		plan:    plan,
+		samples: make([]T, buckets),
+		spectra: spectra,
+		resid:   resid,
+		mags:    make([]float64, buckets),
+		rots:    make([]complex128, len(shifts)),
+	}, nil
+}
+
+// Len returns the signal length n.
+func (s *SparseFFT[T]) Len() int {
+	return s.n
+}
+
+// Sparsity returns the maximum number of tones k.
+func (s *SparseFFT[T]) Sparsity() int {
+	return s.k
+}
+
+// Buckets returns the number of hash buckets B used by the planned rounds.
+func (s *SparseFFT[T]) Buckets() int {
+	return s.levels[0].buckets
+}
+
+// Rounds returns the number of planned hashing rounds run by Forward.
+func (s *SparseFFT[T]) Rounds() int {
+	return s.rounds
+}
+
+// SamplesPerRound returns the number of input samples read in each planned round.
+func (s *SparseFFT[T]) SamplesPerRound() int {
+	return s.levels[0].buckets * len(s.levels[0].shifts)
+}
+
+// Forward estimates the k largest DFT coefficients of src.
+// src must have length n. The result is sorted by frequency and holds at
+// most k entries; fewer are returned when fewer tones rise above the noise.
+func (s *SparseFFT[T]) Forward(src []T) ([]SparseCoefficient[T], error) {
+	if src == nil {
+		return nil, ErrNilSlice
+	}
+
+	if len(src) != s.n {
+		return nil, ErrLengthMismatch
+	}
+
+	found := make(map[int]complex128, s.k)
+
+	var floor float64
+
+	level := 0
+	pending := true
+
+	for round := 0; pending; round++ {
+		if round >= s.rounds {
+			// Planned rounds are exhausted; escalate to finer buckets for
+			// tones that collide under every odd permutation.
+			level++
+
+			err := s.ensureLevel(level)
+			if err != nil {
+				return nil, err
+			}
+
+			if level >= len(s.levels) {
+				break
+			}
+		}
+
+		lv := s.levels[level]
+
+		// σ must be odd to be invertible modulo a power of two.
+		sigma, tau := uint64(1), uint64(0)
+		if s.n > 1 {
+			sigma = uint64(s.rng.IntN(s.n/2))*2 + 1
+			tau = uint64(s.rng.IntN(s.n))
+		}
+
+		err := lv.hash(src, sigma, tau)
+		if err != nil {
+			return nil, err
+		}
+
+		if round == 0 {
+			floor = lv.relativeFloor(relativeEpsilon[T]())
+		}
+
+		lv.peel(found, sigma, tau)
+
+		threshold := math.Max(lv.threshold(), floor)
+		sigmaInv := modInversePow2(sigma, uint64(s.n))
+		pending = false
+
+		for b := range lv.buckets {
+			if cmplx.Abs(lv.resid[0][b]) <= threshold {
+				continue
+			}
+
+			freq, value, ok := lv.locate(b, sigmaInv, tau)
+			if !ok {
+				pending = true
+				continue
+			}
+
+			found[freq] += value
+		}
+
+		// Keep running the planned rounds while anything is left to peel.
+		if round+1 < s.rounds && len(found) < s.k {
+			pending = true
+		}
+	}
+
+	return s.collect(found, floor), nil
+}
+
+// ensureLevel creates the bucket level with index i if it fits in n.
+func (s *SparseFFT[T]) ensureLevel(i int) error {
+	if i < len(s.levels) {
+		return nil
+	}
+
+	buckets := s.levels[len(s.levels)-1].buckets * 2
+	if buckets > s.n {
+		return nil
+	}
+
+	lv, err := newSparseLevel[T](s.n, buckets, s.opts)
+	if err != nil {
+		return err
+	}
+
+	s.levels = append(s.levels, lv)
+
+	return nil
+}
+
+// collect keeps the k strongest tones above floor, sorted by frequency.
+func (s *SparseFFT[T]) collect(found map[int]complex128, floor float64) []SparseCoefficient[T] {
+	out := make([]SparseCoefficient[T], 0, len(found))
+
+	for freq, value := range found {
+		if cmplx.Abs(value) <= floor {
+			continue
+		}
+
+		out = append(out, SparseCoefficient[T]{Freq: freq, Value: T(value)})
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return cmplx.Abs(complex128(out[i].Value)) > cmplx.Abs(complex128(out[j].Value))
+	})
+
+	if len(out) > s.k {
+		out = out[:s.k]
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Freq < out[j].Freq
+	})
+
+	return out
+}
+
+// hash fills resid with the scaled bucket spectra L·Z_s[b] of the permuted
+// signal x'[t] = x[(σt+τ) mod n] for every time shift.
+func (lv *sparseLevel[T]) hash(src []T, sigma, tau uint64) error {
+	n := uint64(lv.n)
+	scale := complex(float64(lv.stride), 0)
+
+	for i, shift := range lv.shifts {
+		for j := range lv.buckets {
+			t := uint64(j*lv.stride + shift)
+			lv.samples[j] = src[(mulMod(sigma, t, n)+tau)%n]
+		}
+
+		err := lv.plan.Forward(lv.spectra[i], lv.samples)
+		if err != nil {
+			return err
+		}
+
+		for b, v := range lv.spectra[i] {
+			lv.resid[i][b] = complex128(v) * scale
+		}
+	}
+
+	return nil
+}
+
+// peel subtracts the contribution of already recovered tones from resid.
+// A tone X[f] appears in the permuted spectrum at f' = σf with value
+// X[f]·exp(2πi·fτ/n), and in shift s with an extra factor exp(2πi·f's/n).
+func (lv *sparseLevel[T]) peel(found map[int]complex128, sigma, tau uint64) {
+	n := uint64(lv.n)
+
+	for freq, value := range found {
+		fp := mulMod(sigma, uint64(freq), n)
+		b := int(fp % uint64(lv.buckets))
+		v := value * unitPhase(mulMod(uint64(freq), tau, n), n)
+
+		for i, shift := range lv.shifts {
+			lv.resid[i][b] -= v * unitPhase(mulMod(fp, uint64(shift), n), n)
+		}
+	}
+}
+
+// locate recovers the frequency and value of an isolated tone in bucket b.
+// It returns ok=false when the bucket does not fit a single-tone model.
+func (lv *sparseLevel[T]) locate(b int, sigmaInv, tau uint64) (int, complex128, bool) {
+	n := uint64(lv.n)
+	z0 := lv.resid[0][b]
+
+	// f' = b + B·q. Shift L/2^j rotates by exp(2πi·b·shift/n)·exp(2πi·q/2^j),
+	// so each shift decides one more bit of q given the lower bits.
+	q := 0
+
+	for j, shift := range lv.shifts[1:] {
+		r := lv.resid[j+1][b] / z0
+		r *= cmplx.Conj(unitPhase(uint64(b*shift), n))
+
+		half := 1 << j
+		r *= cmplx.Exp(complex(0, -math.Pi*float64(q)/float64(half)))
+
+		if real(r) < 0 {
+			q += half
+		}
+	}
+
+	fp := uint64(b + lv.buckets*q)
+
+	var sum complex128
+
+	for i, shift := range lv.shifts {
+		lv.rots[i] = cmplx.Conj(unitPhase(mulMod(fp, uint64(shift), n), n))
+		sum += lv.resid[i][b] * lv.rots[i]
+	}
+
+	mean := sum / complex(float64(len(lv.shifts)), 0)
+
+	var mismatch float64
+
+	for i := range lv.shifts {
+		d := cmplx.Abs(lv.resid[i][b]*lv.rots[i] - mean)
+		mismatch += d * d
+	}
+
+	mismatch = math.Sqrt(mismatch / float64(len(lv.shifts)))
+	if mismatch > sparseMaxResidual*cmplx.Abs(mean) {
+		return 0, 0, false
+	}
+
+	freq := mulMod(sigmaInv, fp, n)
+	value := mean * cmplx.Conj(unitPhase(mulMod(freq, tau, n), n))
+
+	return int(freq), value, true
+}
+
+// threshold estimates the noise level from the median residual bucket magnitude.
+func (lv *sparseLevel[T]) threshold() float64 {
+	for b := range lv.buckets {
+		lv.mags[b] = cmplx.Abs(lv.resid[0][b])
+	}
+
+	sort.Float64s(lv.mags)
+
+	return sparseNoiseFactor * lv.mags[len(lv.mags)/2]
+}
+
+// relativeFloor returns the smallest magnitude distinguishable from rounding
+// error relative to the strongest bucket.
+func (lv *sparseLevel[T]) relativeFloor(eps float64) float64 {
+	peak := 0.0
+	for b := range lv.buckets {
+		peak = math.Max(peak, cmplx.Abs(lv.resid[0][b]))
+	}
+
+	return eps * peak
+}
+
+// relativeEpsilon returns the relative rounding level of T's arithmetic.
+func relativeEpsilon[T Complex]() float64 {
+	var zero T
+	if _, ok := any(zero).(complex64); ok {
+		return 1e-4
+	}
+
+	return 1e-9
+}
+
+// unitPhase returns exp(2πi·r/n).
+func unitPhase(r, n uint64) complex128 {
+	sin, cos := math.Sincos(2 * math.Pi * float64(r) / float64(n))
+	return complex(cos, sin)
+}
+
+// mulMod returns a·b mod n without overflow.
+func mulMod(a, b, n uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, n)
+}
+
+// modInversePow2 returns the inverse of odd a modulo the power of two n.
+func modInversePow2(a, n uint64) uint64 {
+	if n <= 1 {
+		return 0
+	}
+
+	// Newton iteration doubles the number of correct low bits per step.
+	inv := a
+	for range 6 {
+		inv *= 2 - a*inv
+	}
+
+	return inv & (n - 1)
+}
@@ -0,0 +1,192 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+type plantedTone struct {
+	freq  int
+	value complex128
+}
+
+func plantSparseSignal(n int, tones []plantedTone, noise float64, seed int64) []complex128 {
+	rng := rand.New(rand.NewSource(seed))
+	x := make([]complex128, n)
+
+	for t := range x {
+		if noise > 0 {
+			x[t] = complex(rng.NormFloat64()*noise, rng.NormFloat64()*noise)
+		}
+	}
+
+	for _, tone := range tones {
+		// Time-domain tone whose unnormalized DFT at tone.freq equals tone.value.
+		amp := tone.value / complex(float64(n), 0)
+		for t := range x {
+			angle := 2 * math.Pi * float64((tone.freq*t)%n) / float64(n)
+			x[t] += amp * cmplx.Exp(complex(0, angle))
+		}
+	}
+
+	return x
+}
+
+func randomTones(n, k int, seed int64) []plantedTone {
+	rng := rand.New(rand.NewSource(seed))
+	seen := make(map[int]bool, k)
+	tones := make([]plantedTone, 0, k)
+
+	for len(tones) < k {
+		f := rng.Intn(n)
+		if seen[f] {
+			continue
+		}
+
+		seen[f] = true
+		mag := float64(n) * (0.5 + rng.Float64())
+		phase := rng.Float64() * 2 * math.Pi
+		tones = append(tones, plantedTone{freq: f, value: cmplx.Rect(mag, phase)})
+	}
+
+	return tones
+}
+
+func checkRecoveredTones[T Complex](t *testing.T, got []SparseCoefficient[T], want []plantedTone, tol float64) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("recovered %d tones, want %d", len(got), len(want))
+	}
+
+	byFreq := make(map[int]complex128, len(got))
+	for _, c := range got {
+		byFreq[c.Freq] = complex128(c.Value)
+	}
+
+	for _, tone := range want {
+		v, ok := byFreq[tone.freq]
+		if !ok {
+			t.Fatalf("missing tone at bin %d", tone.freq)
+		}
+
+		if rel := cmplx.Abs(v-tone.value) / cmplx.Abs(tone.value); rel > tol {
+			t.Fatalf("bin %d: got %v want %v (rel err %v)", tone.freq, v, tone.value, rel)
+		}
+	}
+}
+
+func TestSparseFFTRecoversExactTones(t *testing.T) {
+	t.Parallel()
+
+	const n, k = 1 << 14, 12
+
+	tones := randomTones(n, k, 1)
+	x := plantSparseSignal(n, tones, 0, 0)
+
+	sfft, err := NewSparseFFT[complex128](n, k)
+	if err != nil {
+		t.Fatalf("NewSparseFFT() returned error: %v", err)
+	}
+
+	got, err := sfft.Forward(x)
+	if err != nil {
+		t.Fatalf("Forward() returned error: %v", err)
+	}
+
+	checkRecoveredTones(t, got, tones, 1e-9)
+
+	for i := 1; i < len(got); i++ {
+		if got[i-1].Freq >= got[i].Freq {
+			t.Fatalf("result not sorted by frequency: %d before %d", got[i-1].Freq, got[i].Freq)
+		}
+	}
+}
+
+func TestSparseFFTRecoversTonesInNoise(t *testing.T) {
+	t.Parallel()
+
+	const n, k = 1 << 16, 20
+
+	tones := randomTones(n, k, 7)
+	x := plantSparseSignal(n, tones, 0.05, 8)
+
+	sfft, err := NewSparseFFTWithOptions[complex128](n, k, SparseOptions{FailureProbability: 1e-4, Seed: 3})
+	if err != nil {
+		t.Fatalf("NewSparseFFTWithOptions() returned error: %v", err)
+	}
+
+	if sfft.Rounds()*sfft.SamplesPerRound() >= n {
+		t.Fatalf("sparse FFT reads %d samples, want fewer than n=%d", sfft.Rounds()*sfft.SamplesPerRound(), n)
+	}
+
+	got, err := sfft.Forward(x)
+	if err != nil {
+		t.Fatalf("Forward() returned error: %v", err)
+	}
+
+	checkRecoveredTones(t, got, tones, 0.05)
+}
+
+func TestSparseFFTComplex64(t *testing.T) {
+	t.Parallel()
+
+	const n, k = 1 << 12, 6
+
+	tones := randomTones(n, k, 11)
+	x64 := plantSparseSignal(n, tones, 0.01, 12)
+
+	x := make([]complex64, n)
+	for i, v := range x64 {
+		x[i] = complex64(v)
+	}
+
+	sfft, err := NewSparseFFT[complex64](n, k)
+	if err != nil {
+		t.Fatalf("NewSparseFFT() returned error: %v", err)
+	}
+
+	got, err := sfft.Forward(x)
+	if err != nil {
+		t.Fatalf("Forward() returned error: %v", err)
+	}
+
+	checkRecoveredTones(t, got, tones, 0.05)
+}
+
+func TestSparseFFTErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSparseFFT[complex64](1000, 4)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("NewSparseFFT(1000, 4) = %v, want ErrInvalidLength", err)
+	}
+
+	_, err = NewSparseFFT[complex64](1024, 0)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("NewSparseFFT(1024, 0) = %v, want ErrInvalidLength", err)
+	}
+
+	_, err = NewSparseFFTWithOptions[complex64](1024, 4, SparseOptions{Buckets: 24})
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("NewSparseFFTWithOptions(Buckets: 24) = %v, want ErrInvalidLength", err)
+	}
+
+	sfft, err := NewSparseFFT[complex64](1024, 4)
+	if err != nil {
+		t.Fatalf("NewSparseFFT() returned error: %v", err)
+	}
+
+	_, err = sfft.Forward(nil)
+	if !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("Forward(nil) = %v, want ErrNilSlice", err)
+	}
+
+	_, err = sfft.Forward(make([]complex64, 512))
+	if !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("Forward(short) = %v, want ErrLengthMismatch", err)
+	}
+}
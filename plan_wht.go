@@ -0,0 +1,323 @@
+package algofft
+
+import (
+	m "github.com/MeKo-Christian/algo-fft/internal/math"
+	mem "github.com/MeKo-Christian/algo-fft/internal/memory"
+)
+
+// WHTOrder selects the row ordering of a Walsh-Hadamard transform.
+type WHTOrder uint8
+
+const (
+	// WHTNatural is the natural (Hadamard) order produced by the
+	// Sylvester construction H(2n) = [H(n) H(n); H(n) -H(n)].
+	WHTNatural WHTOrder = iota
+
+	// WHTSequency is the Walsh order: output k holds the Walsh function
+	// with k sign changes.
+	WHTSequency
+
+	// WHTDyadic is the Paley order: natural order with bit-reversed indices.
+	WHTDyadic
+)
+
+// String returns the name of the ordering.
+func (o WHTOrder) String() string {
+	switch o {
+	case WHTNatural:
+		return "natural"
+	case WHTSequency:
+		return "sequency"
+	case WHTDyadic:
+		return "dyadic"
+	default:
+		return "unknown"
+	}
+}
+
+// PlanWHT is a pre-computed fast Walsh-Hadamard transform plan.
+//
+// The transform uses only additions and subtractions (butterflies without
+// twiddle factors), so it works for real and complex element types alike:
+//
+//	X[k] = Σ x[n] * (-1)^popcount(n & k)   (natural order)
+//
+// Forward is unnormalized; Inverse scales by 1/N so that Inverse(Forward(x)) == x.
+// The length must be a power of two.
+//
+// A PlanWHT owns a scratch buffer for reordering, so it is not safe for
+// concurrent use; create one plan per goroutine or use Clone.
+type PlanWHT[T Scalar] struct {
+	n     int
+	order WHTOrder
+
+	// perm maps output index to natural-order index (nil for natural order).
+	perm []int
+
+	scratch        []T
+	scratchBacking []byte
+}
+
+// NewPlanWHT creates a natural-order Walsh-Hadamard plan of length n.
+func NewPlanWHT[T Scalar](n int) (*PlanWHT[T], error) {
+	return NewPlanWHTWithOrder[T](n, WHTNatural)
+}
+
+// NewPlanWHTWithOrder creates a Walsh-Hadamard plan of length n with the given ordering.
+func NewPlanWHTWithOrder[T Scalar](n int, order WHTOrder) (*PlanWHT[T], error) {
+	if n < 1 || !m.IsPowerOf2(n) {
+		return nil, ErrInvalidLength
+	}
+
+	var perm []int
+
+	switch order {
+	case WHTNatural:
+	case WHTSequency:
+		perm = m.ComputeSequencyIndices(n)
+	case WHTDyadic:
+		perm = m.ComputeBitReversalIndices(n)
+	default:
+		return nil, ErrNotImplemented
+	}
+
+	p := &PlanWHT[T]{
+		n:     n,
+		order: order,
+		perm:  perm,
+	}
+
+	if perm != nil {
+		p.scratch, p.scratchBacking = mem.AllocAligned[T](n)
+	}
+
+	return p, nil
+}
+
+// Len returns the transform length.
+func (p *PlanWHT[T]) Len() int {
+	return p.n
+}
+
+// Order returns the output ordering of the plan.
+func (p *PlanWHT[T]) Order() WHTOrder {
+	return p.order
+}
+
+// String returns a human-readable description of the plan.
+func (p *PlanWHT[T]) String() string {
+	return "PlanWHT[" + scalarTypeName[T]() + "](" + itoa(p.n) + ", " + p.order.String() + ")"
+}
+
+// Clone creates an independent copy of the plan with its own scratch buffer.
+func (p *PlanWHT[T]) Clone() *PlanWHT[T] {
+	clone := &PlanWHT[T]{
+		n:     p.n,
+		order: p.order,
+		perm:  p.perm, // Shared (immutable)
+	}
+
+	if p.perm != nil {
+		clone.scratch, clone.scratchBacking = mem.AllocAligned[T](p.n)
+	}
+
+	return clone
+}
+
+// Forward computes the unnormalized Walsh-Hadamard transform.
+// dst and src must have length Len() and may be the same slice.
+func (p *PlanWHT[T]) Forward(dst, src []T) error {
+	err := p.validate(dst, src)
+	if err != nil {
+		return err
+	}
+
+	if p.perm == nil {
+		copy(dst, src)
+		fwht(dst)
+
+		return nil
+	}
+
+	copy(p.scratch, src)
+	fwht(p.scratch)
+
+	for k, idx := range p.perm {
+		dst[k] = p.scratch[idx]
+	}
+
+	return nil
+}
+
+// Inverse computes the inverse Walsh-Hadamard transform, scaled by 1/N.
+// dst and src must have length Len() and may be the same slice.
+func (p *PlanWHT[T]) Inverse(dst, src []T) error {
+	err := p.validate(dst, src)
+	if err != nil {
+		return err
+	}
+
+	if p.perm == nil {
+		copy(dst, src)
+	} else {
+		for k, idx := range p.perm {
+			p.scratch[idx] = src[k]
+		}
+
+		copy(dst, p.scratch)
+	}
+
+	fwht(dst)
+	scaleScalar(dst, 1/float64(p.n))
+
+	return nil
+}
+
+// ForwardInPlace computes the forward transform in-place.
+func (p *PlanWHT[T]) ForwardInPlace(data []T) error {
+	return p.Forward(data, data)
+}
+
+// InverseInPlace computes the inverse transform in-place.
+func (p *PlanWHT[T]) InverseInPlace(data []T) error {
+	return p.Inverse(data, data)
+}
+
+// ForwardBatch computes count forward transforms on sequential data.
+//
+// The data layout matches Plan.ForwardBatch:
+//   - WHT i: src[i*n:(i+1)*n] → dst[i*n:(i+1)*n]
+//
+// dst and src must have length >= count * Len() and may be the same slice.
+//
+// Returns ErrNilSlice if dst or src is nil.
+// Returns ErrInvalidLength if count < 1.
+// Returns ErrLengthMismatch if slice lengths are insufficient.
+func (p *PlanWHT[T]) ForwardBatch(dst, src []T, count int) error {
+	return p.batch(dst, src, count, p.Forward)
+}
+
+// InverseBatch computes count inverse transforms on sequential data.
+// See ForwardBatch for the data layout.
+func (p *PlanWHT[T]) InverseBatch(dst, src []T, count int) error {
+	return p.batch(dst, src, count, p.Inverse)
+}
+
+func (p *PlanWHT[T]) batch(dst, src []T, count int, transform func(dst, src []T) error) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if count < 1 {
+		return ErrInvalidLength
+	}
+
+	required := count * p.n
+	if len(dst) < required || len(src) < required {
+		return ErrLengthMismatch
+	}
+
+	for i := range count {
+		start := i * p.n
+		end := start + p.n
+
+		err := transform(dst[start:end], src[start:end])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *PlanWHT[T]) validate(dst, src []T) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != p.n || len(src) != p.n {
+		return ErrLengthMismatch
+	}
+
+	return nil
+}
+
+// fwht computes the natural-order Walsh-Hadamard transform of data in-place.
+// Two radix-2 stages are fused into one radix-4 pass to halve memory traffic;
+// a final radix-2 pass handles odd log2(n). Each pass walks contiguous
+// sub-slices so the inner loops are bounds-check free and vectorizable.
+func fwht[T Scalar](data []T) {
+	n := len(data)
+	h := 1
+
+	for ; 4*h <= n; h *= 4 {
+		for i := 0; i < n; i += 4 * h {
+			a := data[i : i+h]
+			b := data[i+h : i+2*h]
+			c := data[i+2*h : i+3*h]
+			d := data[i+3*h : i+4*h]
+
+			for j := range a {
+				s0 := a[j] + b[j]
+				d0 := a[j] - b[j]
+				s1 := c[j] + d[j]
+				d1 := c[j] - d[j]
+
+				a[j] = s0 + s1
+				b[j] = d0 + d1
+				c[j] = s0 - s1
+				d[j] = d0 - d1
+			}
+		}
+	}
+
+	if 2*h == n {
+		a := data[:h]
+		b := data[h:n]
+
+		for j := range a {
+			x, y := a[j], b[j]
+			a[j] = x + y
+			b[j] = x - y
+		}
+	}
+}
+
+// scaleScalar multiplies every element of data by s.
+func scaleScalar[T Scalar](data []T, s float64) {
+	switch v := any(data).(type) {
+	case []float32:
+		f := float32(s)
+		for i := range v {
+			v[i] *= f
+		}
+	case []float64:
+		for i := range v {
+			v[i] *= s
+		}
+	case []complex64:
+		f := float32(s)
+		for i := range v {
+			v[i] = complex(real(v[i])*f, imag(v[i])*f)
+		}
+	case []complex128:
+		for i := range v {
+			v[i] = complex(real(v[i])*s, imag(v[i])*s)
+		}
+	}
+}
+
+func scalarTypeName[T Scalar]() string {
+	var zero T
+
+	switch any(zero).(type) {
+	case float32:
+		return "float32"
+	case float64:
+		return "float64"
+	case complex64:
+		return "complex64"
+	default:
+		return "complex128"
+	}
+}
@@ -0,0 +1,305 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"math/rand"
+	"testing"
+)
+
+// naiveWHT computes the natural-order Walsh-Hadamard transform by definition.
+func naiveWHT(x []float64) []float64 {
+	n := len(x)
+	out := make([]float64, n)
+
+	for k := range n {
+		var sum float64
+
+		for i := range n {
+			if bits.OnesCount(uint(i&k))%2 == 0 {
+				sum += x[i]
+			} else {
+				sum -= x[i]
+			}
+		}
+
+		out[k] = sum
+	}
+
+	return out
+}
+
+// signChanges counts sign changes along a ±1 basis vector.
+func signChanges(row []float64) int {
+	changes := 0
+
+	for i := 1; i < len(row); i++ {
+		if (row[i] > 0) != (row[i-1] > 0) {
+			changes++
+		}
+	}
+
+	return changes
+}
+
+func TestPlanWHTNaturalMatchesNaive(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+
+	for _, n := range []int{1, 2, 4, 8, 32, 128, 512} {
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = rng.Float64()*2 - 1
+		}
+
+		plan, err := NewPlanWHT[float64](n)
+		if err != nil {
+			t.Fatalf("NewPlanWHT(%d) returned error: %v", n, err)
+		}
+
+		got := make([]float64, n)
+
+		err = plan.Forward(got, x)
+		if err != nil {
+			t.Fatalf("Forward() returned error: %v", err)
+		}
+
+		want := naiveWHT(x)
+		for i := range want {
+			if math.Abs(got[i]-want[i]) > 1e-9 {
+				t.Fatalf("n=%d: got[%d]=%v want %v", n, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestPlanWHTSequencyOrder(t *testing.T) {
+	t.Parallel()
+
+	const n = 16
+
+	plan, err := NewPlanWHTWithOrder[float64](n, WHTSequency)
+	if err != nil {
+		t.Fatalf("NewPlanWHTWithOrder() returned error: %v", err)
+	}
+
+	// Transforming unit impulses yields the columns of the transform matrix;
+	// rows[k][j] is then the k-th Walsh function evaluated at j.
+	rows := make([][]float64, n)
+	for k := range rows {
+		rows[k] = make([]float64, n)
+	}
+
+	impulse := make([]float64, n)
+	out := make([]float64, n)
+
+	for j := range n {
+		clear(impulse)
+		impulse[j] = 1
+
+		err = plan.Forward(out, impulse)
+		if err != nil {
+			t.Fatalf("Forward() returned error: %v", err)
+		}
+
+		for k := range n {
+			rows[k][j] = out[k]
+		}
+	}
+
+	for k, row := range rows {
+		if got := signChanges(row); got != k {
+			t.Fatalf("sequency row %d has %d sign changes, want %d", k, got, k)
+		}
+	}
+}
+
+func TestPlanWHTDyadicOrder(t *testing.T) {
+	t.Parallel()
+
+	const n = 64
+
+	rng := rand.New(rand.NewSource(2))
+
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = rng.NormFloat64()
+	}
+
+	plan, err := NewPlanWHTWithOrder[float64](n, WHTDyadic)
+	if err != nil {
+		t.Fatalf("NewPlanWHTWithOrder() returned error: %v", err)
+	}
+
+	got := make([]float64, n)
+
+	err = plan.Forward(got, x)
+	if err != nil {
+		t.Fatalf("Forward() returned error: %v", err)
+	}
+
+	natural := naiveWHT(x)
+	nbits := bits.Len(uint(n)) - 1
+
+	for k := range n {
+		want := natural[int(bits.Reverse64(uint64(k))>>(64-nbits))]
+		if math.Abs(got[k]-want) > 1e-9 {
+			t.Fatalf("got[%d]=%v want %v", k, got[k], want)
+		}
+	}
+}
+
+func TestPlanWHTRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(3))
+
+	for _, order := range []WHTOrder{WHTNatural, WHTSequency, WHTDyadic} {
+		t.Run(order.String(), func(t *testing.T) {
+			t.Parallel()
+
+			const n = 256
+
+			plan32, err := NewPlanWHTWithOrder[float32](n, order)
+			if err != nil {
+				t.Fatalf("NewPlanWHTWithOrder() returned error: %v", err)
+			}
+
+			planC, err := NewPlanWHTWithOrder[complex128](n, order)
+			if err != nil {
+				t.Fatalf("NewPlanWHTWithOrder() returned error: %v", err)
+			}
+
+			x32 := make([]float32, n)
+			xc := make([]complex128, n)
+
+			for i := range n {
+				x32[i] = float32(rng.NormFloat64())
+				xc[i] = complex(rng.NormFloat64(), rng.NormFloat64())
+			}
+
+			data32 := append([]float32(nil), x32...)
+			if err := plan32.ForwardInPlace(data32); err != nil {
+				t.Fatalf("ForwardInPlace() returned error: %v", err)
+			}
+
+			if err := plan32.InverseInPlace(data32); err != nil {
+				t.Fatalf("InverseInPlace() returned error: %v", err)
+			}
+
+			for i := range x32 {
+				if math.Abs(float64(data32[i]-x32[i])) > 1e-4 {
+					t.Fatalf("float32 round trip [%d]: got %v want %v", i, data32[i], x32[i])
+				}
+			}
+
+			freq := make([]complex128, n)
+			back := make([]complex128, n)
+
+			if err := planC.Forward(freq, xc); err != nil {
+				t.Fatalf("Forward() returned error: %v", err)
+			}
+
+			if err := planC.Inverse(back, freq); err != nil {
+				t.Fatalf("Inverse() returned error: %v", err)
+			}
+
+			for i := range xc {
+				assertApproxComplex128Tolf(t, back[i], xc[i], 1e-12, "complex128 round trip [%d]", i)
+			}
+		})
+	}
+}
+
+func TestPlanWHTBatch(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n     = 32
+		count = 5
+	)
+
+	rng := rand.New(rand.NewSource(4))
+
+	src := make([]complex64, n*count)
+	for i := range src {
+		src[i] = complex(float32(rng.NormFloat64()), float32(rng.NormFloat64()))
+	}
+
+	plan, err := NewPlanWHTWithOrder[complex64](n, WHTSequency)
+	if err != nil {
+		t.Fatalf("NewPlanWHTWithOrder() returned error: %v", err)
+	}
+
+	batch := make([]complex64, n*count)
+
+	err = plan.ForwardBatch(batch, src, count)
+	if err != nil {
+		t.Fatalf("ForwardBatch() returned error: %v", err)
+	}
+
+	single := make([]complex64, n)
+
+	for b := range count {
+		err = plan.Forward(single, src[b*n:(b+1)*n])
+		if err != nil {
+			t.Fatalf("Forward() returned error: %v", err)
+		}
+
+		for i := range single {
+			if single[i] != batch[b*n+i] {
+				t.Fatalf("batch %d [%d]: got %v want %v", b, i, batch[b*n+i], single[i])
+			}
+		}
+	}
+
+	err = plan.InverseBatch(batch, batch, count)
+	if err != nil {
+		t.Fatalf("InverseBatch() returned error: %v", err)
+	}
+
+	for i := range src {
+		assertApproxComplex128Tolf(t, complex128(batch[i]), complex128(src[i]), 1e-5, "batch round trip [%d]", i)
+	}
+}
+
+func TestPlanWHTErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewPlanWHT[float32](12)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("NewPlanWHT(12) = %v, want ErrInvalidLength", err)
+	}
+
+	_, err = NewPlanWHT[float32](0)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("NewPlanWHT(0) = %v, want ErrInvalidLength", err)
+	}
+
+	plan, err := NewPlanWHT[float32](8)
+	if err != nil {
+		t.Fatalf("NewPlanWHT(8) returned error: %v", err)
+	}
+
+	if err := plan.Forward(nil, make([]float32, 8)); !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("Forward(nil, src) = %v, want ErrNilSlice", err)
+	}
+
+	if err := plan.Forward(make([]float32, 8), make([]float32, 4)); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("Forward(dst, short) = %v, want ErrLengthMismatch", err)
+	}
+
+	if err := plan.ForwardBatch(make([]float32, 16), make([]float32, 16), 3); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("ForwardBatch(short) = %v, want ErrLengthMismatch", err)
+	}
+
+	if err := plan.ForwardBatch(make([]float32, 16), make([]float32, 16), 0); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("ForwardBatch(count=0) = %v, want ErrInvalidLength", err)
+	}
+
+	if got, want := plan.String(), "PlanWHT[float32](8, natural)"; got != want {
+		t.Fatalf("String() = %q, want %q", got, want)
+	}
+}
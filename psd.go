@@ -0,0 +1,421 @@
+package algofft
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/MeKo-Christian/algo-fft/window"
+)
+
+// Detrend selects the trend removed from every segment before spectral
+// estimation.
+type Detrend uint8
+
+const (
+	// DetrendConstant subtracts the segment mean. It is the default, as in
+	// SciPy.
+	DetrendConstant Detrend = iota
+
+	// DetrendNone leaves the segments unchanged.
+	DetrendNone
+
+	// DetrendLinear subtracts the least-squares straight line.
+	DetrendLinear
+)
+
+// String returns the name of the detrending rule.
+func (d Detrend) String() string {
+	switch d {
+	case DetrendConstant:
+		return "constant"
+	case DetrendNone:
+		return "none"
+	case DetrendLinear:
+		return "linear"
+	default:
+		return "unknown"
+	}
+}
+
+// PSDScaling selects the units of a power spectral estimate.
+type PSDScaling uint8
+
+const (
+	// PSDDensity returns a power spectral density in units²/Hz, scaled by
+	// 1/(Fs·Σw²) (SciPy scaling="density"). It is the default.
+	PSDDensity PSDScaling = iota
+
+	// PSDSpectrum returns a power spectrum in units², scaled by 1/(Σw)²
+	// (SciPy scaling="spectrum"), so a bin-centred sinusoid of amplitude A
+	// reads A²/2 in the one-sided output.
+	PSDSpectrum
+)
+
+// String returns the name of the scaling.
+func (s PSDScaling) String() string {
+	switch s {
+	case PSDDensity:
+		return "density"
+	case PSDSpectrum:
+		return "spectrum"
+	default:
+		return "unknown"
+	}
+}
+
+// defaultSegmentLen is SciPy's default Welch segment length.
+const defaultSegmentLen = 256
+
+// PSDOptions configures Welch and periodogram spectral estimates. The zero
+// value matches the SciPy defaults.
+type PSDOptions struct {
+	// Fs is the sampling frequency. Zero selects 1.
+	Fs float64
+
+	// SegmentLen is the number of samples per Welch segment. Zero selects
+	// len(Window) if a window is given and 256 otherwise; segments longer
+	// than the signal are shortened to it. Periodogram always uses the
+	// whole signal.
+	SegmentLen int
+
+	// Overlap is the number of samples shared by consecutive Welch
+	// segments and must be less than SegmentLen. Zero selects
+	// SegmentLen/2; a negative value selects no overlap.
+	Overlap int
+
+	// Window holds the SegmentLen coefficients applied to every segment.
+	// Nil selects a periodic Hann window for Welch and the rectangular
+	// window for Periodogram.
+	Window []float64
+
+	// FFTLen is the transform length, at least SegmentLen; segments are
+	// zero-padded to it. Zero selects SegmentLen.
+	FFTLen int
+
+	// Detrend selects the trend removed from every segment.
+	Detrend Detrend
+
+	// TwoSided returns all FFTLen bins in FFT order instead of the
+	// one-sided spectrum of FFTLen/2+1 bins, in which the power of the
+	// negative frequencies is folded onto the positive ones.
+	TwoSided bool
+
+	// Scaling selects a power spectral density (default) or a power
+	// spectrum.
+	Scaling PSDScaling
+
+	// PlanOptions is passed to the FFT plan constructor.
+	PlanOptions PlanOptions
+}
+
+// Welch estimates the power spectral density of x with Welch's method:
+// the squared magnitude spectra of overlapping, detrended and windowed
+// segments are averaged. It returns the frequency of every bin and the
+// estimate, following scipy.signal.welch.
+func Welch(x []float32, opts PSDOptions) ([]float64, []float32, error) {
+	return welch[float32, complex64](x, opts, false)
+}
+
+// Welch64 is the float64 variant of Welch.
+func Welch64(x []float64, opts PSDOptions) ([]float64, []float64, error) {
+	return welch[float64, complex128](x, opts, false)
+}
+
+// Periodogram estimates the power spectral density of x from the squared
+// magnitude spectrum of the whole detrended and windowed signal, following
+// scipy.signal.periodogram. SegmentLen and Overlap are ignored, and a
+// given Window must have len(x) coefficients.
+func Periodogram(x []float32, opts PSDOptions) ([]float64, []float32, error) {
+	return welch[float32, complex64](x, opts, true)
+}
+
+// Periodogram64 is the float64 variant of Periodogram.
+func Periodogram64(x []float64, opts PSDOptions) ([]float64, []float64, error) {
+	return welch[float64, complex128](x, opts, true)
+}
+
+func welch[F Float, C Complex](x []F, opts PSDOptions, periodogram bool) ([]float64, []F, error) {
+	if x == nil {
+		return nil, nil, ErrNilSlice
+	}
+
+	est, err := newSpectralEstimator[F, C](len(x), 1, opts, periodogram)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	acc := make([]float64, est.bins)
+	segments := est.segments(len(x))
+	xs := [][]F{x}
+
+	for s := range segments {
+		err = est.transform(xs, s)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for k := range acc {
+			v := complex128(est.spec[k])
+			acc[k] += real(v)*real(v) + imag(v)*imag(v)
+		}
+	}
+
+	psd := make([]F, len(acc))
+	for k, v := range acc {
+		psd[k] = F(v * est.binScale(k, segments))
+	}
+
+	return est.frequencies(), psd, nil
+}
+
+// spectralEstimator holds the segmentation, window, scaling and plan
+// shared by the averaged spectral estimators, so every segment reuses the
+// same plan and buffers.
+type spectralEstimator[F Float, C Complex] struct {
+	segLen   int
+	step     int
+	fftLen   int
+	bins     int
+	channels int
+	fs       float64
+	scale    float64
+	oneSided bool
+	detrend  Detrend
+	window   []float64
+
+	plan *realTransform[F, C]
+
+	segment []float64
+	frame   []F
+
+	// spec holds the full spectra of the last transformed segment, one
+	// FFTLen block per channel.
+	spec []C
+}
+
+func newSpectralEstimator[F Float, C Complex](n, channels int, opts PSDOptions, periodogram bool) (*spectralEstimator[F, C], error) {
+	if n < 1 {
+		return nil, ErrInvalidLength
+	}
+
+	fs := opts.Fs
+	if fs == 0 {
+		fs = 1
+	}
+
+	if !(fs > 0) || math.IsInf(fs, 1) {
+		return nil, ErrInvalidParameter
+	}
+
+	if opts.Detrend > DetrendLinear || opts.Scaling > PSDSpectrum {
+		return nil, ErrInvalidMode
+	}
+
+	segLen, overlap := opts.SegmentLen, opts.Overlap
+
+	switch {
+	case periodogram:
+		segLen, overlap = n, -1
+	case segLen == 0 && opts.Window != nil:
+		segLen = len(opts.Window)
+	case segLen == 0:
+		segLen = min(defaultSegmentLen, n)
+	case segLen > n && opts.Window == nil:
+		segLen = n
+	}
+
+	if segLen < 1 || segLen > n {
+		return nil, ErrInvalidLength
+	}
+
+	switch {
+	case overlap == 0:
+		overlap = segLen / 2
+	case overlap < 0:
+		overlap = 0
+	case overlap >= segLen:
+		return nil, ErrInvalidParameter
+	}
+
+	win := opts.Window
+
+	switch {
+	case win != nil && len(win) != segLen:
+		return nil, ErrLengthMismatch
+	case win == nil && periodogram:
+		win = window.Rectangular(segLen)
+	case win == nil:
+		win = window.Hann(segLen, window.Periodic)
+	}
+
+	fftLen := opts.FFTLen
+	if fftLen == 0 {
+		fftLen = segLen
+	}
+
+	if fftLen < segLen {
+		return nil, ErrInvalidLength
+	}
+
+	var sum, energy float64
+	for _, w := range win {
+		sum += w
+		energy += w * w
+	}
+
+	scale := 1 / (sum * sum)
+	if opts.Scaling == PSDDensity {
+		scale = 1 / (fs * energy)
+	}
+
+	if math.IsInf(scale, 0) || math.IsNaN(scale) {
+		return nil, ErrInvalidParameter
+	}
+
+	e := &spectralEstimator[F, C]{
+		segLen:   segLen,
+		step:     segLen - overlap,
+		fftLen:   fftLen,
+		bins:     fftLen/2 + 1,
+		fs:       fs,
+		scale:    scale,
+		oneSided: !opts.TwoSided,
+		detrend:  opts.Detrend,
+		window:   win,
+		segment:  make([]float64, segLen),
+		channels: channels,
+		frame:    make([]F, channels*fftLen),
+		spec:     make([]C, channels*fftLen),
+	}
+
+	if opts.TwoSided {
+		e.bins = fftLen
+	}
+
+	// The channels are batched through forwardBatch, so a Batch or Stride
+	// in the caller's options must not reach the plan.
+	planOpts := opts.PlanOptions
+	planOpts.Batch = 0
+	planOpts.Stride = 0
+
+	var err error
+
+	e.plan, err = newRealTransform[F, C](fftLen, planOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// segments returns the number of whole segments in a signal of n samples.
+func (e *spectralEstimator[F, C]) segments(n int) int {
+	return (n-e.segLen)/e.step + 1
+}
+
+// transform detrends and windows segment s of every channel and stores
+// the full FFTLen-bin spectra back to back in e.spec, transforming all
+// channels in one batch.
+func (e *spectralEstimator[F, C]) transform(xs [][]F, s int) error {
+	for c, x := range xs {
+		seg := e.segment
+		for i := range seg {
+			seg[i] = float64(x[s*e.step+i])
+		}
+
+		detrendInPlace(seg, e.detrend)
+
+		frame := e.frame[c*e.fftLen : (c+1)*e.fftLen]
+		for i, v := range seg {
+			frame[i] = F(v * e.window[i])
+		}
+
+		clear(frame[e.segLen:])
+	}
+
+	half := e.plan.bins()
+
+	err := e.plan.forwardBatch(e.spec, e.frame, e.channels)
+	if err != nil {
+		return err
+	}
+
+	// forwardBatch packs the half spectra; spread them to FFTLen blocks,
+	// last channel first so that no spectrum is overwritten before it
+	// moves, and mirror the negative frequencies.
+	for c := e.channels - 1; c >= 0; c-- {
+		spec := e.spec[c*e.fftLen : (c+1)*e.fftLen]
+		copy(spec, e.spec[c*half:(c+1)*half])
+
+		for k := half; k < e.fftLen; k++ {
+			spec[k] = C(cmplx.Conj(complex128(spec[e.fftLen-k])))
+		}
+	}
+
+	return nil
+}
+
+// binScale returns the factor that turns the sum of the segment products
+// at output bin k into the averaged estimate in the requested units,
+// folding the negative frequencies of a one-sided estimate onto the
+// positive ones.
+func (e *spectralEstimator[F, C]) binScale(k, segments int) float64 {
+	scale := e.scale / float64(segments)
+
+	// DC and, for even lengths, Nyquist have no mirror image.
+	if e.oneSided && k > 0 && (e.fftLen%2 != 0 || k < e.fftLen/2) {
+		scale *= 2
+	}
+
+	return scale
+}
+
+// frequencies returns the frequency of every output bin: 0..Fs/2 for a
+// one-sided estimate, or the FFT order 0, Fs/N, ..., -Fs/N for a two-sided
+// one.
+func (e *spectralEstimator[F, C]) frequencies() []float64 {
+	if e.oneSided {
+		return RFFTFreq(e.fftLen, 1/e.fs)
+	}
+
+	return FFTFreq(e.fftLen, 1/e.fs)
+}
+
+// detrendInPlace removes the mean or the least-squares line from x.
+func detrendInPlace(x []float64, d Detrend) {
+	n := float64(len(x))
+
+	switch d {
+	case DetrendConstant:
+		var mean float64
+		for _, v := range x {
+			mean += v
+		}
+
+		mean /= n
+
+		for i := range x {
+			x[i] -= mean
+		}
+	case DetrendLinear:
+		// Fit a + b·(i - centre), whose normal equations decouple.
+		centre := (n - 1) / 2
+
+		var mean, slope, spread float64
+		for i, v := range x {
+			t := float64(i) - centre
+			mean += v
+			slope += t * v
+			spread += t * t
+		}
+
+		mean /= n
+		if spread > 0 {
+			slope /= spread
+		}
+
+		for i := range x {
+			x[i] -= mean + slope*(float64(i)-centre)
+		}
+	}
+}
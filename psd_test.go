@@ -0,0 +1,250 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/window"
+)
+
+// naiveWelch follows the definition of scipy.signal.welch with a direct
+// DFT of every segment.
+func naiveWelch(x []float64, fs float64, win []float64, overlap, nfft int, detrend Detrend, scaling PSDScaling, twoSided bool) []float64 {
+	segLen := len(win)
+	step := segLen - overlap
+	segments := (len(x)-segLen)/step + 1
+
+	var sum, energy float64
+	for _, w := range win {
+		sum += w
+		energy += w * w
+	}
+
+	scale := 1 / (fs * energy)
+	if scaling == PSDSpectrum {
+		scale = 1 / (sum * sum)
+	}
+
+	psd := make([]float64, nfft)
+
+	for s := range segments {
+		seg := append([]float64(nil), x[s*step:s*step+segLen]...)
+		detrendInPlace(seg, detrend)
+
+		for k := range nfft {
+			var v complex128
+			for j, xv := range seg {
+				v += complex(xv*win[j], 0) * cmplx.Exp(complex(0, -2*math.Pi*float64(k*j)/float64(nfft)))
+			}
+
+			psd[k] += cmplx.Abs(v) * cmplx.Abs(v) * scale / float64(segments)
+		}
+	}
+
+	if twoSided {
+		return psd
+	}
+
+	// Fold the negative frequencies onto the positive ones.
+	half := psd[:nfft/2+1]
+	for k := 1; k < len(half); k++ {
+		if nfft-k != k {
+			half[k] += psd[nfft-k]
+		}
+	}
+
+	return half
+}
+
+func TestPeriodogramKnownValues(t *testing.T) {
+	t.Parallel()
+
+	x := []float64{1, 2, 3, 4}
+
+	tests := []struct {
+		detrend  Detrend
+		twoSided bool
+		want     []float64
+		freqs    []float64
+	}{
+		{DetrendNone, false, []float64{25, 4, 1}, []float64{0, 0.25, 0.5}},
+		{DetrendNone, true, []float64{25, 2, 1, 2}, []float64{0, 0.25, -0.5, -0.25}},
+		{DetrendConstant, false, []float64{0, 4, 1}, []float64{0, 0.25, 0.5}},
+		{DetrendLinear, false, []float64{0, 0, 0}, []float64{0, 0.25, 0.5}},
+	}
+
+	for _, tc := range tests {
+		freqs, psd, err := Periodogram64(x, PSDOptions{Detrend: tc.detrend, TwoSided: tc.twoSided})
+		if err != nil {
+			t.Fatalf("%v: Periodogram64() returned error: %v", tc.detrend, err)
+		}
+
+		if len(psd) != len(tc.want) || len(freqs) != len(tc.freqs) {
+			t.Fatalf("%v: got %d bins and %d frequencies, want %d", tc.detrend, len(psd), len(freqs), len(tc.want))
+		}
+
+		for k := range tc.want {
+			assertApproxFloat64(t, psd[k], tc.want[k], 1e-12, "%v two-sided=%v psd[%d]", tc.detrend, tc.twoSided, k)
+			assertApproxFloat64(t, freqs[k], tc.freqs[k], 0, "%v two-sided=%v freqs[%d]", tc.detrend, tc.twoSided, k)
+		}
+	}
+}
+
+func TestWelchMatchesDefinition(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(1))
+	x := randomFloat64s(rng, 300)
+
+	for i := range x {
+		x[i] += 0.01 * float64(i) // a trend for the detrenders to remove
+	}
+
+	tests := []struct {
+		name string
+		opts PSDOptions
+		win  []float64
+		ovl  int
+		nfft int
+	}{
+		{"defaults", PSDOptions{SegmentLen: 64}, window.Hann(64, window.Periodic), 32, 64},
+		{"odd fft linear", PSDOptions{SegmentLen: 40, Overlap: 30, FFTLen: 45, Detrend: DetrendLinear, Fs: 8000},
+			window.Hann(40, window.Periodic), 30, 45},
+		{"hamming spectrum two-sided", PSDOptions{Window: window.Hamming(50, window.Symmetric), Overlap: -1, Scaling: PSDSpectrum, TwoSided: true, Detrend: DetrendNone},
+			window.Hamming(50, window.Symmetric), 0, 50},
+		{"zero padded", PSDOptions{SegmentLen: 33, FFTLen: 64}, window.Hann(33, window.Periodic), 16, 64},
+	}
+
+	for _, tc := range tests {
+		fs := tc.opts.Fs
+		if fs == 0 {
+			fs = 1
+		}
+
+		want := naiveWelch(x, fs, tc.win, tc.ovl, tc.nfft, tc.opts.Detrend, tc.opts.Scaling, tc.opts.TwoSided)
+
+		freqs, got, err := Welch64(x, tc.opts)
+		if err != nil {
+			t.Fatalf("%s: Welch64() returned error: %v", tc.name, err)
+		}
+
+		_, got32, err := Welch(toFloat32(x), tc.opts)
+		if err != nil {
+			t.Fatalf("%s: Welch() returned error: %v", tc.name, err)
+		}
+
+		if len(got) != len(want) || len(freqs) != len(want) {
+			t.Fatalf("%s: got %d bins, want %d", tc.name, len(got), len(want))
+		}
+
+		for k := range want {
+			assertApproxFloat64(t, got[k], want[k], 1e-12*(1+want[k]), "%s psd[%d]", tc.name, k)
+			assertApproxFloat64(t, float64(got32[k]), want[k], 1e-4*(1+want[k]), "%s float32 psd[%d]", tc.name, k)
+		}
+
+		assertApproxFloat64(t, freqs[1], fs/float64(tc.nfft), 1e-12, "%s bin spacing", tc.name)
+	}
+}
+
+func TestWelchScaling(t *testing.T) {
+	t.Parallel()
+
+	const (
+		n, segLen = 4096, 256
+		fs        = 1000.0
+		amp       = 3.0
+		bin       = 32
+	)
+
+	// A bin-centred sinusoid reads amp²/2 in the power spectrum.
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = amp * math.Sin(2*math.Pi*float64(bin)*float64(i)/segLen)
+	}
+
+	freqs, spectrum, err := Welch64(x, PSDOptions{Fs: fs, SegmentLen: segLen, Scaling: PSDSpectrum})
+	if err != nil {
+		t.Fatalf("Welch64() returned error: %v", err)
+	}
+
+	assertApproxFloat64(t, spectrum[bin], amp*amp/2, 1e-9, "sinusoid power")
+	assertApproxFloat64(t, freqs[bin], bin*fs/segLen, 1e-9, "sinusoid frequency")
+
+	// White noise of variance σ² has a one-sided density of 2σ²/Fs, and
+	// the density of a periodogram integrates to the mean power exactly.
+	noise := make([]float64, n)
+	rng := rand.New(rand.NewSource(9))
+
+	var power float64
+	for i := range noise {
+		noise[i] = rng.NormFloat64()
+		power += noise[i] * noise[i] / n
+	}
+
+	_, density, err := Welch64(noise, PSDOptions{Fs: fs, SegmentLen: segLen})
+	if err != nil {
+		t.Fatalf("Welch64() returned error: %v", err)
+	}
+
+	var mean float64
+	for _, v := range density[1 : len(density)-1] {
+		mean += v / float64(len(density)-2)
+	}
+
+	assertApproxFloat64(t, mean, 2/fs, 0.1*2/fs, "white noise density")
+
+	_, pgram, err := Periodogram64(noise, PSDOptions{Fs: fs, Detrend: DetrendNone, TwoSided: true})
+	if err != nil {
+		t.Fatalf("Periodogram64() returned error: %v", err)
+	}
+
+	var integral float64
+	for _, v := range pgram {
+		integral += v * fs / n
+	}
+
+	assertApproxFloat64(t, integral, power, 1e-9, "Parseval")
+}
+
+func TestPSDErrors(t *testing.T) {
+	t.Parallel()
+
+	x := make([]float64, 100)
+
+	tests := []struct {
+		name string
+		opts PSDOptions
+		want error
+	}{
+		{"negative fs", PSDOptions{Fs: -1}, ErrInvalidParameter},
+		{"overlap", PSDOptions{SegmentLen: 10, Overlap: 10}, ErrInvalidParameter},
+		{"short fft", PSDOptions{SegmentLen: 10, FFTLen: 9}, ErrInvalidLength},
+		{"window longer than signal", PSDOptions{Window: make([]float64, 101)}, ErrInvalidLength},
+		{"window length", PSDOptions{SegmentLen: 10, Window: make([]float64, 9)}, ErrLengthMismatch},
+		{"zero window", PSDOptions{Window: make([]float64, 10)}, ErrInvalidParameter},
+		{"detrend", PSDOptions{Detrend: Detrend(7)}, ErrInvalidMode},
+		{"scaling", PSDOptions{Scaling: PSDScaling(7)}, ErrInvalidMode},
+	}
+
+	for _, tc := range tests {
+		_, _, err := Welch64(x, tc.opts)
+		if !errors.Is(err, tc.want) {
+			t.Fatalf("%s: error = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+
+	if _, _, err := Welch(nil, PSDOptions{}); !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil signal error = %v, want ErrNilSlice", err)
+	}
+
+	if _, _, err := Periodogram([]float32{}, PSDOptions{}); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("empty signal error = %v, want ErrInvalidLength", err)
+	}
+
+	if _, _, err := Periodogram64(x, PSDOptions{Window: make([]float64, 50)}); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("periodogram window error = %v, want ErrLengthMismatch", err)
+	}
+}
@@ -0,0 +1,109 @@
+package algofft
+
+import "math/cmplx"
+
+// realTransform computes the n/2+1 non-negative frequency bins of real
+// signals of any length n ≥ 1, using PlanRealT for even lengths and a
+// complex plan for odd ones. Its buffers make it unsafe for concurrent use.
+type realTransform[F Float, C Complex] struct {
+	n    int
+	real *PlanRealT[F, C] // even lengths
+	cplx *Plan[C]         // odd lengths
+	buf  []C              // complex time/frequency buffer for odd lengths
+}
+
+func newRealTransform[F Float, C Complex](n int, opts PlanOptions) (*realTransform[F, C], error) {
+	if n < 1 {
+		return nil, ErrInvalidLength
+	}
+
+	t := &realTransform[F, C]{n: n}
+
+	var err error
+
+	if n%2 == 0 {
+		t.real, err = NewPlanRealTWithOptions[F, C](n, opts)
+	} else {
+		t.cplx, err = NewPlanWithOptions[C](n, opts)
+		t.buf = make([]C, n)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// bins returns the number of spectrum bins, n/2+1.
+func (t *realTransform[F, C]) bins() int {
+	return t.n/2 + 1
+}
+
+// forward writes the bins of the n-sample signal src to dst.
+func (t *realTransform[F, C]) forward(dst []C, src []F) error {
+	if t.real != nil {
+		return t.real.Forward(dst, src)
+	}
+
+	for i, v := range src {
+		t.buf[i] = C(complex(float64(v), 0))
+	}
+
+	err := t.cplx.InPlace(t.buf)
+	if err != nil {
+		return err
+	}
+
+	copy(dst, t.buf)
+
+	return nil
+}
+
+// forwardBatch transforms count n-sample signals stored back to back in
+// src and packs their bins back to back in dst.
+func (t *realTransform[F, C]) forwardBatch(dst []C, src []F, count int) error {
+	if t.real != nil {
+		return t.real.ForwardBatch(dst, src, count)
+	}
+
+	bins := t.bins()
+
+	for i := range count {
+		err := t.forward(dst[i*bins:(i+1)*bins], src[i*t.n:(i+1)*t.n])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// inverse writes the normalized inverse transform of the Hermitian
+// spectrum with bins src to dst. It clears the imaginary parts of the DC
+// and, for even n, Nyquist bins of src, which a real signal cannot have.
+func (t *realTransform[F, C]) inverse(dst []F, src []C) error {
+	src[0] = C(complex(real(complex128(src[0])), 0))
+
+	if t.real != nil {
+		src[t.n/2] = C(complex(real(complex128(src[t.n/2])), 0))
+		return t.real.Inverse(dst, src)
+	}
+
+	copy(t.buf, src)
+
+	for k := len(src); k < t.n; k++ {
+		t.buf[k] = C(cmplx.Conj(complex128(t.buf[t.n-k])))
+	}
+
+	err := t.cplx.InverseInPlace(t.buf)
+	if err != nil {
+		return err
+	}
+
+	for i, v := range t.buf {
+		dst[i] = F(real(complex128(v)))
+	}
+
+	return nil
+}
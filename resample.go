@@ -0,0 +1,269 @@
+package algofft
+
+// Resample resamples src to len(dst) samples by truncating or zero-padding
+// its spectrum, following scipy.signal.resample. The signal is treated as
+// one period of a periodic signal, so the result is exact for band-limited
+// periodic input and shows ringing at the ends otherwise; use ResamplePoly
+// for finite or streaming signals.
+//
+// For even lengths the Nyquist bin is split between the positive and
+// negative frequencies when upsampling and folded back when downsampling,
+// so that the result stays real and a tone at the old Nyquist frequency
+// keeps its amplitude.
+func Resample(dst, src []float32) error {
+	return resampleReal[float32, complex64](dst, src)
+}
+
+// Resample64 is the float64 variant of Resample.
+func Resample64(dst, src []float64) error {
+	return resampleReal[float64, complex128](dst, src)
+}
+
+// ResampleComplex is the complex-valued variant of Resample.
+func ResampleComplex[T Complex](dst, src []T) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	r, err := NewComplexResampler[T](len(src), len(dst))
+	if err != nil {
+		return err
+	}
+
+	return r.Apply(dst, src)
+}
+
+func resampleReal[F Float, C Complex](dst, src []F) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	r, err := NewResampler[F, C](len(src), len(dst))
+	if err != nil {
+		return err
+	}
+
+	return r.Apply(dst, src)
+}
+
+// Resampler is a reusable FFT resampler between two fixed lengths for real
+// signals. It computes the same result as Resample, using PlanRealT for
+// even lengths and complex plans for odd ones.
+//
+// Apply does not allocate. A Resampler must not be used by multiple
+// goroutines at the same time.
+type Resampler[F Float, C Complex] struct {
+	inLen, outLen int
+
+	in, out *realTransform[F, C]
+
+	spec    []C // InLen()/2+1 input bins
+	outSpec []C // OutLen()/2+1 output bins
+}
+
+// NewResampler creates a Resampler from inLen to outLen samples.
+//
+// Example:
+//
+//	r, err := algofft.NewResampler[float32, complex64](44100, 48000)
+func NewResampler[F Float, C Complex](inLen, outLen int) (*Resampler[F, C], error) {
+	return NewResamplerWithOptions[F, C](inLen, outLen, PlanOptions{})
+}
+
+// NewResamplerWithOptions creates a Resampler whose plans are built with
+// opts. Batch and Stride are ignored: each Apply resamples one signal.
+func NewResamplerWithOptions[F Float, C Complex](inLen, outLen int, opts PlanOptions) (*Resampler[F, C], error) {
+	if inLen < 1 || outLen < 1 {
+		return nil, ErrInvalidLength
+	}
+
+	r := &Resampler[F, C]{inLen: inLen, outLen: outLen}
+	if inLen == outLen {
+		return r, nil
+	}
+
+	planOpts := opts
+	planOpts.Batch = 0
+	planOpts.Stride = 0
+
+	var err error
+
+	r.in, err = newRealTransform[F, C](inLen, planOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	r.out, err = newRealTransform[F, C](outLen, planOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	r.spec = make([]C, r.in.bins())
+	r.outSpec = make([]C, r.out.bins())
+
+	return r, nil
+}
+
+// InLen returns the input length.
+func (r *Resampler[F, C]) InLen() int {
+	return r.inLen
+}
+
+// OutLen returns the output length.
+func (r *Resampler[F, C]) OutLen() int {
+	return r.outLen
+}
+
+// Apply resamples src (InLen samples) into dst (OutLen samples).
+func (r *Resampler[F, C]) Apply(dst, src []F) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(src) != r.inLen || len(dst) != r.outLen {
+		return ErrLengthMismatch
+	}
+
+	if r.inLen == r.outLen {
+		copy(dst, src)
+		return nil
+	}
+
+	err := r.in.forward(r.spec, src)
+	if err != nil {
+		return err
+	}
+
+	scale := float64(r.outLen) / float64(r.inLen)
+	common := min(r.inLen, r.outLen)
+	nyquist := common / 2
+
+	clear(r.outSpec)
+
+	for k := range nyquist + 1 {
+		r.outSpec[k] = r.spec[k] * C(complex(scale, 0))
+	}
+
+	if common%2 == 0 {
+		// Downsampling folds X[-M/2] = conj(X[M/2]) onto the new Nyquist bin;
+		// upsampling splits the old Nyquist bin between ±N/2.
+		v := scale * real(complex128(r.spec[nyquist]))
+		if r.outLen < r.inLen {
+			v *= 2
+		} else {
+			v /= 2
+		}
+
+		r.outSpec[nyquist] = C(complex(v, 0))
+	}
+
+	return r.out.inverse(dst, r.outSpec)
+}
+
+// ComplexResampler is the complex-valued counterpart of Resampler.
+type ComplexResampler[T Complex] struct {
+	inLen, outLen int
+
+	inPlan  *Plan[T]
+	outPlan *Plan[T]
+
+	spec []T
+	out  []T
+}
+
+// NewComplexResampler creates a ComplexResampler from inLen to outLen
+// samples.
+func NewComplexResampler[T Complex](inLen, outLen int) (*ComplexResampler[T], error) {
+	return NewComplexResamplerWithOptions[T](inLen, outLen, PlanOptions{})
+}
+
+// NewComplexResamplerWithOptions creates a ComplexResampler whose plans are
+// built with opts. Batch and Stride are ignored: each Apply resamples one
+// signal.
+func NewComplexResamplerWithOptions[T Complex](inLen, outLen int, opts PlanOptions) (*ComplexResampler[T], error) {
+	if inLen < 1 || outLen < 1 {
+		return nil, ErrInvalidLength
+	}
+
+	r := &ComplexResampler[T]{inLen: inLen, outLen: outLen}
+	if inLen == outLen {
+		return r, nil
+	}
+
+	planOpts := opts
+	planOpts.Batch = 0
+	planOpts.Stride = 0
+
+	var err error
+
+	r.inPlan, err = NewPlanWithOptions[T](inLen, planOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	r.outPlan, err = NewPlanWithOptions[T](outLen, planOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	r.spec = make([]T, inLen)
+	r.out = make([]T, outLen)
+
+	return r, nil
+}
+
+// InLen returns the input length.
+func (r *ComplexResampler[T]) InLen() int {
+	return r.inLen
+}
+
+// OutLen returns the output length.
+func (r *ComplexResampler[T]) OutLen() int {
+	return r.outLen
+}
+
+// Apply resamples src (InLen samples) into dst (OutLen samples).
+func (r *ComplexResampler[T]) Apply(dst, src []T) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(src) != r.inLen || len(dst) != r.outLen {
+		return ErrLengthMismatch
+	}
+
+	if r.inLen == r.outLen {
+		copy(dst, src)
+		return nil
+	}
+
+	err := r.inPlan.Forward(r.spec, src)
+	if err != nil {
+		return err
+	}
+
+	scale := T(complex(float64(r.outLen)/float64(r.inLen), 0))
+	common := min(r.inLen, r.outLen)
+	nyquist := common / 2
+
+	clear(r.out)
+
+	for k := range nyquist + 1 {
+		r.out[k] = r.spec[k] * scale
+	}
+
+	for k := 1; k <= (common-1)/2; k++ {
+		r.out[r.outLen-k] = r.spec[r.inLen-k] * scale
+	}
+
+	if common%2 == 0 {
+		if r.outLen < r.inLen {
+			r.out[nyquist] += r.spec[r.inLen-nyquist] * scale
+		} else {
+			r.out[nyquist] /= 2
+			r.out[r.outLen-nyquist] = r.out[nyquist]
+		}
+	}
+
+	return r.outPlan.Inverse(dst, r.out)
+}
@@ -0,0 +1,497 @@
+package algofft
+
+import (
+	"math"
+	"slices"
+
+	"github.com/MeKo-Christian/algo-fft/window"
+)
+
+// ResamplePolyOptions configures the polyphase resamplers.
+type ResamplePolyOptions struct {
+	// Taps replaces the default anti-aliasing filter. The filter runs at
+	// the upsampled rate and is centred on tap (len(Taps)-1)/2, which
+	// compensates its delay. Taps are used as given, so they should include
+	// the gain up. Nil selects the scipy.signal.resample_poly default: a
+	// Kaiser (β = 5) windowed sinc with 20·max(up, down)+1 taps and cutoff
+	// 1/max(up, down) of the Nyquist frequency.
+	Taps []float64
+
+	// FIR configures the FFT-based FIR filters that run the polyphase
+	// branches when the branches are too long for direct evaluation (see
+	// PolyResampler). The default filter is always evaluated directly.
+	FIR FIROptions
+}
+
+// ResamplePolyLen returns the number of samples ResamplePoly produces from
+// n input samples: ceil(n·up/down). It returns 0 for invalid arguments.
+func ResamplePolyLen(n, up, down int) int {
+	if n < 1 || up < 1 || down < 1 {
+		return 0
+	}
+
+	return (n*up + down - 1) / down
+}
+
+// ResamplePoly resamples src by the rational factor up/down with a
+// polyphase FIR filter, following scipy.signal.resample_poly: the signal is
+// upsampled by up, low-pass filtered and downsampled by down, with the
+// filter delay compensated. The dst slice must have length
+// ResamplePolyLen(len(src), up, down). The factor is reduced first, and an
+// up/down ratio of one copies src.
+//
+// Unlike Resample, the signal is not assumed to be periodic, which makes
+// ResamplePoly suited for long signals and, through PolyResampler, for
+// streams.
+func ResamplePoly(dst, src []float32, up, down int) error {
+	r, err := NewPolyResampler[float32, complex64](up, down)
+	if err != nil {
+		return err
+	}
+
+	return resamplePoly(&r.engine, dst, src)
+}
+
+// ResamplePoly64 is the float64 variant of ResamplePoly.
+func ResamplePoly64(dst, src []float64, up, down int) error {
+	r, err := NewPolyResampler[float64, complex128](up, down)
+	if err != nil {
+		return err
+	}
+
+	return resamplePoly(&r.engine, dst, src)
+}
+
+// ResamplePolyComplex is the complex-valued variant of ResamplePoly.
+func ResamplePolyComplex[T Complex](dst, src []T, up, down int) error {
+	r, err := NewComplexPolyResampler[T](up, down)
+	if err != nil {
+		return err
+	}
+
+	return resamplePoly(&r.engine, dst, src)
+}
+
+func resamplePoly[S Scalar](e *polyEngine[S], dst, src []S) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != ResamplePolyLen(len(src), e.up, e.down) {
+		return ErrLengthMismatch
+	}
+
+	out, err := e.append(dst[:0], src)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.flush(out)
+
+	return err
+}
+
+// PolyResampler is a streaming polyphase resampler for real-valued sample
+// streams. Each output sample is the dot product of the recent input with
+// the one polyphase branch of the anti-aliasing filter that produces it,
+// so only the outputs that are kept are computed. Filters whose branches
+// are much longer than down, such as long custom Taps, instead run every
+// branch as an FFT-based FIRFilter at the input rate.
+//
+// Append accepts chunks of any length and appends the output samples they
+// determine; Flush appends the rest. The concatenated outputs equal
+// ResamplePoly over the concatenated input. Because the filter is centred,
+// output lags input by about half the filter length at the input rate.
+//
+// A PolyResampler must not be used by multiple goroutines at the same time.
+type PolyResampler[F Float, C Complex] struct {
+	engine polyEngine[F]
+}
+
+// NewPolyResampler creates a PolyResampler for the factor up/down with the
+// default anti-aliasing filter.
+//
+// Example:
+//
+//	r, err := algofft.NewPolyResampler[float32, complex64](160, 147) // 44.1 → 48 kHz
+func NewPolyResampler[F Float, C Complex](up, down int) (*PolyResampler[F, C], error) {
+	return NewPolyResamplerWithOptions[F, C](up, down, ResamplePolyOptions{})
+}
+
+// NewPolyResamplerWithOptions creates a PolyResampler with explicit
+// options.
+func NewPolyResamplerWithOptions[F Float, C Complex](up, down int, opts ResamplePolyOptions) (*PolyResampler[F, C], error) {
+	r := &PolyResampler[F, C]{}
+
+	phases, err := r.engine.init(up, down, opts, func(v float64) F { return F(v) })
+	if err != nil {
+		return nil, err
+	}
+
+	for _, taps := range phases {
+		f, err := NewFIRFilterWithOptions[F, C](taps, opts.FIR)
+		if err != nil {
+			return nil, err
+		}
+
+		r.engine.phases = append(r.engine.phases, f)
+	}
+
+	return r, nil
+}
+
+// Up returns the reduced upsampling factor.
+func (r *PolyResampler[F, C]) Up() int {
+	return r.engine.up
+}
+
+// Down returns the reduced downsampling factor.
+func (r *PolyResampler[F, C]) Down() int {
+	return r.engine.down
+}
+
+// Append feeds src into the resampler and appends the output samples it
+// determines to dst, returning the extended slice.
+func (r *PolyResampler[F, C]) Append(dst, src []F) ([]F, error) {
+	return r.engine.append(dst, src)
+}
+
+// Flush appends the remaining output samples, as if the stream were
+// followed by zeros, and resets the resampler.
+func (r *PolyResampler[F, C]) Flush(dst []F) ([]F, error) {
+	return r.engine.flush(dst)
+}
+
+// Reset clears the resampler state so that the next Append starts a new
+// stream.
+func (r *PolyResampler[F, C]) Reset() {
+	r.engine.reset()
+}
+
+// ComplexPolyResampler is the complex-valued counterpart of PolyResampler,
+// with ComplexFIRFilter branches for long filters. It follows the same
+// streaming rules.
+type ComplexPolyResampler[T Complex] struct {
+	engine polyEngine[T]
+}
+
+// NewComplexPolyResampler creates a ComplexPolyResampler for the factor
+// up/down with the default anti-aliasing filter.
+func NewComplexPolyResampler[T Complex](up, down int) (*ComplexPolyResampler[T], error) {
+	return NewComplexPolyResamplerWithOptions[T](up, down, ResamplePolyOptions{})
+}
+
+// NewComplexPolyResamplerWithOptions creates a ComplexPolyResampler with
+// explicit options.
+func NewComplexPolyResamplerWithOptions[T Complex](up, down int, opts ResamplePolyOptions) (*ComplexPolyResampler[T], error) {
+	r := &ComplexPolyResampler[T]{}
+
+	phases, err := r.engine.init(up, down, opts, func(v float64) T { return T(complex(v, 0)) })
+	if err != nil {
+		return nil, err
+	}
+
+	for _, taps := range phases {
+		f, err := NewComplexFIRFilterWithOptions(taps, opts.FIR)
+		if err != nil {
+			return nil, err
+		}
+
+		r.engine.phases = append(r.engine.phases, f)
+	}
+
+	return r, nil
+}
+
+// Up returns the reduced upsampling factor.
+func (r *ComplexPolyResampler[T]) Up() int {
+	return r.engine.up
+}
+
+// Down returns the reduced downsampling factor.
+func (r *ComplexPolyResampler[T]) Down() int {
+	return r.engine.down
+}
+
+// Append feeds src into the resampler and appends the output samples it
+// determines to dst, returning the extended slice.
+func (r *ComplexPolyResampler[T]) Append(dst, src []T) ([]T, error) {
+	return r.engine.append(dst, src)
+}
+
+// Flush appends the remaining output samples, as if the stream were
+// followed by zeros, and resets the resampler.
+func (r *ComplexPolyResampler[T]) Flush(dst []T) ([]T, error) {
+	return r.engine.flush(dst)
+}
+
+// Reset clears the resampler state so that the next Append starts a new
+// stream.
+func (r *ComplexPolyResampler[T]) Reset() {
+	r.engine.reset()
+}
+
+// polyFilter is the streaming FIR filter of one polyphase branch.
+type polyFilter[S Scalar] interface {
+	Process(dst, src []S) error
+	Latency() int
+	Reset()
+}
+
+// polyEngine implements the bookkeeping shared by the real and complex
+// polyphase resamplers.
+//
+// With the filter h split into phases h_p[j] = h[j·up+p], the upsampled,
+// filtered signal is u[n·up+p] = v_p[n], where v_p is the input filtered
+// by h_p. Output m is u[m·down+half], where half is the filter delay, so
+// it is v_p[j] with p = (m·down+half) mod up and j = (m·down+half)/up,
+// and it is determined once input sample j has arrived.
+//
+// By default v_p[j] is evaluated directly as a dot product of h_p with
+// the last phaseLen inputs, for the selected phase only. When the phases
+// are long compared to down, every phase instead runs as an FFT-based
+// FIR filter over the whole input, and the outputs are picked from the
+// branch outputs.
+type polyEngine[S Scalar] struct {
+	up, down int
+	half     int
+	phaseLen int
+
+	bank   []S             // direct: phases reversed, phaseLen samples each
+	hist   []S             // direct: phaseLen-1 past inputs, then the current chunk
+	phases []polyFilter[S] // FFT: one filter per phase
+
+	branch   []S // FFT: branch outputs for the current chunk, one row per phase
+	zeros    []S // zero input for Flush
+	received int // input samples fed since the last reset
+	next     int // index of the next output sample
+}
+
+// init validates the arguments and reduces the factor. For direct
+// evaluation it fills the phase bank and returns nil; when the phases are
+// long enough for FFT filtering it returns them, converted with conv, so
+// that the caller can build the branch filters. A reduced factor of one
+// needs neither.
+func (e *polyEngine[S]) init(up, down int, opts ResamplePolyOptions, conv func(float64) S) ([][]S, error) {
+	if up < 1 || down < 1 {
+		return nil, ErrInvalidParameter
+	}
+
+	if opts.Taps != nil && len(opts.Taps) == 0 {
+		return nil, ErrInvalidLength
+	}
+
+	g := gcd(up, down)
+	e.up, e.down = up/g, down/g
+
+	if e.up == e.down {
+		return nil, nil
+	}
+
+	taps := opts.Taps
+	if taps == nil {
+		taps = resamplePolyTaps(e.up, e.down)
+	}
+
+	e.half = (len(taps) - 1) / 2
+	e.phaseLen = (len(taps) + e.up - 1) / e.up
+
+	// h_p[j], zero past the end of the filter.
+	phase := func(p, j int) S {
+		if k := j*e.up + p; k < len(taps) {
+			return conv(taps[k])
+		}
+
+		return 0
+	}
+
+	if !polyUseFFT(e.phaseLen, e.down) {
+		e.bank = make([]S, e.up*e.phaseLen)
+		for p := range e.up {
+			row := e.bank[p*e.phaseLen : (p+1)*e.phaseLen]
+			for j := range row {
+				row[len(row)-1-j] = phase(p, j)
+			}
+		}
+
+		e.hist = make([]S, e.phaseLen-1)
+
+		return nil, nil
+	}
+
+	phases := make([][]S, e.up)
+	for p := range phases {
+		phases[p] = make([]S, e.phaseLen)
+		for j := range phases[p] {
+			phases[p][j] = phase(p, j)
+		}
+	}
+
+	return phases, nil
+}
+
+// polyUseFFT reports whether phases of phaseLen taps are cheaper to run as
+// FFT filters. Direct evaluation costs phaseLen/down multiply-adds per
+// branch and input sample; an FFT filter costs a few times
+// log2(fftLen), with fftLen about 4·phaseLen, whichever outputs are used.
+func polyUseFFT(phaseLen, down int) bool {
+	return float64(phaseLen)/float64(down) > 4*math.Log2(float64(4*phaseLen))
+}
+
+func (e *polyEngine[S]) append(dst, src []S) ([]S, error) {
+	if src == nil {
+		return dst, ErrNilSlice
+	}
+
+	if e.up == e.down || len(src) == 0 {
+		return append(dst, src...), nil
+	}
+
+	return e.feed(dst, src, math.MaxInt)
+}
+
+// feed consumes src and appends the outputs that are now determined, up to
+// output index limit.
+func (e *polyEngine[S]) feed(dst, src []S, limit int) ([]S, error) {
+	if e.phases != nil {
+		return e.feedFFT(dst, src, limit)
+	}
+
+	start := e.received
+	e.received += len(src)
+	e.hist = append(e.hist, src...)
+
+	for ; e.next < limit; e.next++ {
+		i := e.next*e.down + e.half
+
+		j := i / e.up
+		if j >= e.received {
+			break
+		}
+
+		// hist[j-start] holds input j-phaseLen+1.
+		h := e.bank[(i%e.up)*e.phaseLen : (i%e.up+1)*e.phaseLen]
+		x := e.hist[j-start : j-start+e.phaseLen]
+
+		var acc S
+		for k := range h {
+			acc += h[k] * x[k]
+		}
+
+		dst = append(dst, acc)
+	}
+
+	keep := e.phaseLen - 1
+	copy(e.hist, e.hist[len(e.hist)-keep:])
+	e.hist = e.hist[:keep]
+
+	return dst, nil
+}
+
+// feedFFT filters src through every branch and picks the selected outputs.
+// The branch filters delay their output by their latency, so a call that
+// feeds inputs start..start+n returns v_p[start-latency..start+n-latency].
+func (e *polyEngine[S]) feedFFT(dst, src []S, limit int) ([]S, error) {
+	n := len(src)
+	e.branch = slices.Grow(e.branch[:0], e.up*n)[:e.up*n]
+
+	for p, f := range e.phases {
+		err := f.Process(e.branch[p*n:(p+1)*n], src)
+		if err != nil {
+			return dst, err
+		}
+	}
+
+	start := e.received - e.latency()
+	e.received += n
+
+	for ; e.next < limit; e.next++ {
+		i := e.next*e.down + e.half
+
+		j := i / e.up
+		if j >= start+n {
+			break
+		}
+
+		dst = append(dst, e.branch[(i%e.up)*n+j-start])
+	}
+
+	return dst, nil
+}
+
+// latency returns the delay of the branch filters.
+func (e *polyEngine[S]) latency() int {
+	if e.phases == nil {
+		return 0
+	}
+
+	return e.phases[0].Latency()
+}
+
+func (e *polyEngine[S]) flush(dst []S) ([]S, error) {
+	defer e.reset()
+
+	total := ResamplePolyLen(e.received, e.up, e.down)
+	if e.up == e.down || e.next >= total {
+		return dst, nil
+	}
+
+	// Feed zeros until the last output sample is determined.
+	last := ((total-1)*e.down + e.half) / e.up
+	pad := max(last+1+e.latency()-e.received, 0)
+
+	e.zeros = slices.Grow(e.zeros[:0], pad)[:pad]
+	clear(e.zeros)
+
+	return e.feed(dst, e.zeros, total)
+}
+
+func (e *polyEngine[S]) reset() {
+	for _, f := range e.phases {
+		f.Reset()
+	}
+
+	if e.bank != nil {
+		clear(e.hist)
+		e.hist = e.hist[:e.phaseLen-1]
+	}
+
+	e.received = 0
+	e.next = 0
+}
+
+// resamplePolyTaps designs the default anti-aliasing filter of
+// ResamplePoly, matching scipy.signal.firwin with a Kaiser (β = 5) window
+// and unit DC gain, scaled by up.
+func resamplePolyTaps(up, down int) []float64 {
+	rate := max(up, down)
+	half := 10 * rate
+	cutoff := 1 / float64(rate)
+
+	taps := window.Kaiser(2*half+1, 5, window.Symmetric)
+
+	var sum float64
+
+	for i := range taps {
+		x := cutoff * float64(i-half)
+		if x != 0 {
+			taps[i] *= math.Sin(math.Pi*x) / (math.Pi * x)
+		}
+
+		sum += taps[i]
+	}
+
+	for i := range taps {
+		taps[i] *= float64(up) / sum
+	}
+
+	return taps
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
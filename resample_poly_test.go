@@ -0,0 +1,313 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+// naiveResamplePoly upsamples x by up, filters it with the centred taps h
+// and keeps every down-th sample, as scipy.signal.resample_poly.
+func naiveResamplePoly(x []complex128, h []float64, up, down int) []complex128 {
+	half := (len(h) - 1) / 2
+	y := make([]complex128, ResamplePolyLen(len(x), up, down))
+
+	for m := range y {
+		i := m*down + half
+		for k, hk := range h {
+			if j := i - k; j >= 0 && j%up == 0 && j/up < len(x) {
+				y[m] += complex(hk, 0) * x[j/up]
+			}
+		}
+	}
+
+	return y
+}
+
+func TestResamplePolyMatchesNaive(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(9))
+
+	factors := [][2]int{{3, 2}, {2, 3}, {1, 4}, {5, 1}, {6, 4}, {160, 147}}
+
+	for _, f := range factors {
+		up, down := f[0], f[1]
+		g := gcd(up, down)
+		h := resamplePolyTaps(up/g, down/g)
+
+		x := randomFloat64s(rng, 300)
+		cx := randomComplex128s(rng, 300)
+
+		xc := make([]complex128, len(x))
+		for i, v := range x {
+			xc[i] = complex(v, 0)
+		}
+
+		want := naiveResamplePoly(xc, h, up/g, down/g)
+		cwant := naiveResamplePoly(cx, h, up/g, down/g)
+
+		got := make([]float64, ResamplePolyLen(len(x), up, down))
+		if err := ResamplePoly64(got, x, up, down); err != nil {
+			t.Fatalf("ResamplePoly64(%d/%d) returned error: %v", up, down, err)
+		}
+
+		got32 := make([]float32, len(got))
+		if err := ResamplePoly(got32, toFloat32(x), up, down); err != nil {
+			t.Fatalf("ResamplePoly(%d/%d) returned error: %v", up, down, err)
+		}
+
+		cgot := make([]complex128, len(got))
+		if err := ResamplePolyComplex(cgot, cx, up, down); err != nil {
+			t.Fatalf("ResamplePolyComplex(%d/%d) returned error: %v", up, down, err)
+		}
+
+		for i := range want {
+			assertApproxFloat64(t, got[i], real(want[i]), 1e-10, "ResamplePoly64 %d/%d [%d]", up, down, i)
+			assertApproxFloat64(t, float64(got32[i]), real(want[i]), 1e-4, "ResamplePoly %d/%d [%d]", up, down, i)
+
+			if cmplx.Abs(cgot[i]-cwant[i]) > 1e-10 {
+				t.Fatalf("ResamplePolyComplex %d/%d [%d] = %v, want %v", up, down, i, cgot[i], cwant[i])
+			}
+		}
+	}
+
+	// Custom taps, and a factor that reduces to one.
+	taps := []float64{0.25, 0.5, 1, 0.5, 0.25}
+	x := randomFloat64s(rng, 50)
+
+	r, err := NewPolyResamplerWithOptions[float64, complex128](4, 2, ResamplePolyOptions{Taps: taps})
+	if err != nil {
+		t.Fatalf("NewPolyResamplerWithOptions() returned error: %v", err)
+	}
+
+	if r.Up() != 2 || r.Down() != 1 {
+		t.Fatalf("reduced factor = %d/%d, want 2/1", r.Up(), r.Down())
+	}
+
+	got, err := r.Append(nil, x)
+	if err != nil {
+		t.Fatalf("Append() returned error: %v", err)
+	}
+
+	got, err = r.Flush(got)
+	if err != nil {
+		t.Fatalf("Flush() returned error: %v", err)
+	}
+
+	xc := make([]complex128, len(x))
+	for i, v := range x {
+		xc[i] = complex(v, 0)
+	}
+
+	want := naiveResamplePoly(xc, taps, 2, 1)
+	if len(got) != len(want) {
+		t.Fatalf("custom taps produced %d samples, want %d", len(got), len(want))
+	}
+
+	for i := range want {
+		assertApproxFloat64(t, got[i], real(want[i]), 1e-12, "custom taps [%d]", i)
+	}
+
+	same := make([]float64, len(x))
+	if err := ResamplePoly64(same, x, 3, 3); err != nil {
+		t.Fatalf("ResamplePoly64(3/3) returned error: %v", err)
+	}
+
+	for i := range x {
+		if same[i] != x[i] {
+			t.Fatalf("ResamplePoly64(3/3) [%d] = %v, want %v", i, same[i], x[i])
+		}
+	}
+}
+
+func TestPolyResamplerLongTapsUseFFTBranches(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(17))
+
+	// 601 taps at up = 2 give 301-tap phases, long enough for FFT branches.
+	taps := randomFloat64s(rng, 601)
+	x := randomFloat64s(rng, 1500)
+
+	xc := make([]complex128, len(x))
+	for i, v := range x {
+		xc[i] = complex(v, 0)
+	}
+
+	want := naiveResamplePoly(xc, taps, 2, 3)
+
+	// A Batch or Stride in the FIR plan options must not reach the branches.
+	for _, fir := range []FIROptions{{}, {PlanOptions: PlanOptions{Batch: 4, Stride: 8}}} {
+		r, err := NewPolyResamplerWithOptions[float64, complex128](2, 3, ResamplePolyOptions{Taps: taps, FIR: fir})
+		if err != nil {
+			t.Fatalf("NewPolyResamplerWithOptions() returned error: %v", err)
+		}
+
+		if r.engine.phases == nil || r.engine.latency() == 0 {
+			t.Fatalf("long taps did not select latency-buffered FFT branches")
+		}
+
+		var got []float64
+
+		for pos := 0; pos < len(x); {
+			chunk := min(1+rng.Intn(100), len(x)-pos)
+
+			got, err = r.Append(got, x[pos:pos+chunk])
+			if err != nil {
+				t.Fatalf("Append() returned error: %v", err)
+			}
+
+			pos += chunk
+		}
+
+		got, err = r.Flush(got)
+		if err != nil {
+			t.Fatalf("Flush() returned error: %v", err)
+		}
+
+		if len(got) != len(want) {
+			t.Fatalf("stream produced %d samples, want %d", len(got), len(want))
+		}
+
+		for i := range want {
+			assertApproxFloat64(t, got[i], real(want[i]), 1e-9, "FFT branches %+v [%d]", fir.PlanOptions, i)
+		}
+	}
+}
+
+func TestResamplePolyTone(t *testing.T) {
+	t.Parallel()
+
+	const n, freq = 4410, 1000.0 / 44100
+
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = math.Sin(2 * math.Pi * freq * float64(i))
+	}
+
+	y := make([]float64, ResamplePolyLen(n, 160, 147))
+	if err := ResamplePoly64(y, x, 160, 147); err != nil {
+		t.Fatalf("ResamplePoly64() returned error: %v", err)
+	}
+
+	// Away from the edges the tone is reproduced at the new rate.
+	outFreq := freq * 147 / 160
+	for i := 500; i < len(y)-500; i++ {
+		assertApproxFloat64(t, y[i], math.Sin(2*math.Pi*outFreq*float64(i)), 2e-3, "tone [%d]", i)
+	}
+}
+
+func TestPolyResamplerStreamMatchesOneShot(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(21))
+	x := toFloat32(randomFloat64s(rng, 2000))
+	cx := narrowComplex(randomComplex128s(rng, 2000))
+
+	for _, f := range [][2]int{{3, 2}, {2, 5}, {147, 160}} {
+		up, down := f[0], f[1]
+
+		want := make([]float32, ResamplePolyLen(len(x), up, down))
+		if err := ResamplePoly(want, x, up, down); err != nil {
+			t.Fatalf("ResamplePoly(%d/%d) returned error: %v", up, down, err)
+		}
+
+		cwant := make([]complex64, len(want))
+		if err := ResamplePolyComplex(cwant, cx, up, down); err != nil {
+			t.Fatalf("ResamplePolyComplex(%d/%d) returned error: %v", up, down, err)
+		}
+
+		r, err := NewPolyResampler[float32, complex64](up, down)
+		if err != nil {
+			t.Fatalf("NewPolyResampler() returned error: %v", err)
+		}
+
+		c, err := NewComplexPolyResampler[complex64](up, down)
+		if err != nil {
+			t.Fatalf("NewComplexPolyResampler() returned error: %v", err)
+		}
+
+		// Run twice to check that Flush resets the stream.
+		for range 2 {
+			var (
+				got  []float32
+				cgot []complex64
+			)
+
+			for pos := 0; pos < len(x); {
+				chunk := min(rng.Intn(300), len(x)-pos)
+				if rng.Intn(4) == 0 {
+					chunk = min(rng.Intn(3), len(x)-pos)
+				}
+
+				got, err = r.Append(got, x[pos:pos+chunk])
+				if err != nil {
+					t.Fatalf("Append() returned error: %v", err)
+				}
+
+				cgot, err = c.Append(cgot, cx[pos:pos+chunk])
+				if err != nil {
+					t.Fatalf("complex Append() returned error: %v", err)
+				}
+
+				pos += chunk
+			}
+
+			got, err = r.Flush(got)
+			if err != nil {
+				t.Fatalf("Flush() returned error: %v", err)
+			}
+
+			cgot, err = c.Flush(cgot)
+			if err != nil {
+				t.Fatalf("complex Flush() returned error: %v", err)
+			}
+
+			if len(got) != len(want) || len(cgot) != len(want) {
+				t.Fatalf("%d/%d: stream produced %d and %d samples, want %d", up, down, len(got), len(cgot), len(want))
+			}
+
+			for i := range want {
+				assertApproxFloat64(t, float64(got[i]), float64(want[i]), 1e-4, "%d/%d stream [%d]", up, down, i)
+
+				if cmplx.Abs(complex128(cgot[i]-cwant[i])) > 1e-4 {
+					t.Fatalf("%d/%d complex stream [%d] = %v, want %v", up, down, i, cgot[i], cwant[i])
+				}
+			}
+		}
+	}
+}
+
+func TestResamplePolyErrors(t *testing.T) {
+	t.Parallel()
+
+	x := make([]float32, 10)
+
+	if err := ResamplePoly(make([]float32, 15), x, 0, 2); !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("zero up error = %v, want ErrInvalidParameter", err)
+	}
+
+	if err := ResamplePoly(make([]float32, 14), x, 3, 2); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("dst length error = %v, want ErrLengthMismatch", err)
+	}
+
+	if err := ResamplePoly64(nil, make([]float64, 4), 3, 2); !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil dst error = %v, want ErrNilSlice", err)
+	}
+
+	if _, err := NewPolyResamplerWithOptions[float32, complex64](3, 2, ResamplePolyOptions{Taps: []float64{}}); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("empty taps error = %v, want ErrInvalidLength", err)
+	}
+
+	r, err := NewComplexPolyResampler[complex64](2, 1)
+	if err != nil {
+		t.Fatalf("NewComplexPolyResampler() returned error: %v", err)
+	}
+
+	if _, err := r.Append(nil, nil); !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil samples error = %v, want ErrNilSlice", err)
+	}
+}
@@ -0,0 +1,260 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+// naiveResample implements scipy.signal.resample with direct DFTs.
+func naiveResample(x []complex128, m int) []complex128 {
+	n := len(x)
+	spec := make([]complex128, n)
+
+	for k := range spec {
+		for i, v := range x {
+			spec[k] += v * cmplx.Exp(complex(0, -2*math.Pi*float64(k*i)/float64(n)))
+		}
+	}
+
+	common := min(n, m)
+	y := make([]complex128, m)
+
+	for k := 0; k <= common/2; k++ {
+		y[k] = spec[k]
+	}
+
+	for k := 1; k <= (common-1)/2; k++ {
+		y[m-k] = spec[n-k]
+	}
+
+	if common%2 == 0 {
+		if m < n {
+			y[common/2] += spec[n-common/2]
+		} else if m > n {
+			y[common/2] /= 2
+			y[m-common/2] = y[common/2]
+		}
+	}
+
+	out := make([]complex128, m)
+
+	for i := range out {
+		for k, v := range y {
+			out[i] += v * cmplx.Exp(complex(0, 2*math.Pi*float64(k*i)/float64(m)))
+		}
+
+		out[i] /= complex(float64(n), 0)
+	}
+
+	return out
+}
+
+var resampleLengths = [][2]int{
+	{16, 24}, {24, 16}, {15, 20}, {20, 15}, {17, 9}, {9, 17}, {12, 13}, {13, 12}, {8, 8}, {1, 5}, {6, 1}, {2, 3},
+}
+
+func TestResampleMatchesNaive(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(3))
+
+	for _, lens := range resampleLengths {
+		n, m := lens[0], lens[1]
+
+		x := randomFloat64s(rng, n)
+		cx := randomComplex128s(rng, n)
+
+		xc := make([]complex128, n)
+		for i, v := range x {
+			xc[i] = complex(v, 0)
+		}
+
+		want := naiveResample(xc, m)
+
+		got := make([]float64, m)
+		if err := Resample64(got, x); err != nil {
+			t.Fatalf("Resample64(%d→%d) returned error: %v", n, m, err)
+		}
+
+		got32 := make([]float32, m)
+		if err := Resample(got32, toFloat32(x)); err != nil {
+			t.Fatalf("Resample(%d→%d) returned error: %v", n, m, err)
+		}
+
+		for i := range want {
+			assertApproxFloat64(t, got[i], real(want[i]), 1e-12, "Resample64 %d→%d [%d]", n, m, i)
+			assertApproxFloat64(t, float64(got32[i]), real(want[i]), 1e-5, "Resample %d→%d [%d]", n, m, i)
+		}
+
+		cwant := naiveResample(cx, m)
+
+		cgot := make([]complex128, m)
+		if err := ResampleComplex(cgot, cx); err != nil {
+			t.Fatalf("ResampleComplex(%d→%d) returned error: %v", n, m, err)
+		}
+
+		cgot64 := make([]complex64, m)
+		if err := ResampleComplex(cgot64, narrowComplex(cx)); err != nil {
+			t.Fatalf("ResampleComplex[complex64](%d→%d) returned error: %v", n, m, err)
+		}
+
+		for i := range cwant {
+			if cmplx.Abs(cgot[i]-cwant[i]) > 1e-12 {
+				t.Fatalf("ResampleComplex %d→%d [%d] = %v, want %v", n, m, i, cgot[i], cwant[i])
+			}
+
+			if cmplx.Abs(complex128(cgot64[i])-cwant[i]) > 1e-5 {
+				t.Fatalf("ResampleComplex[complex64] %d→%d [%d] = %v, want %v", n, m, i, cgot64[i], cwant[i])
+			}
+		}
+	}
+}
+
+func TestResampleBandLimited(t *testing.T) {
+	t.Parallel()
+
+	tone := func(n, cycles int) []float64 {
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = math.Cos(2*math.Pi*float64(cycles*i)/float64(n) + 0.3)
+		}
+
+		return x
+	}
+
+	for _, lens := range [][2]int{{40, 64}, {64, 40}, {41, 27}, {27, 41}} {
+		n, m := lens[0], lens[1]
+
+		got := make([]float64, m)
+		if err := Resample64(got, tone(n, 5)); err != nil {
+			t.Fatalf("Resample64(%d→%d) returned error: %v", n, m, err)
+		}
+
+		want := tone(m, 5)
+		for i := range want {
+			assertApproxFloat64(t, got[i], want[i], 1e-12, "tone %d→%d [%d]", n, m, i)
+		}
+	}
+
+	// A cosine at the Nyquist frequency keeps its amplitude when upsampled.
+	nyquist := []float64{1, -1, 1, -1, 1, -1, 1, -1}
+
+	got := make([]float64, 16)
+	if err := Resample64(got, nyquist); err != nil {
+		t.Fatalf("Resample64(Nyquist) returned error: %v", err)
+	}
+
+	for i, v := range got {
+		assertApproxFloat64(t, v, math.Cos(math.Pi*float64(i)/2), 1e-12, "Nyquist [%d]", i)
+	}
+}
+
+func TestResampleErrors(t *testing.T) {
+	t.Parallel()
+
+	if err := Resample(nil, make([]float32, 4)); !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil dst error = %v, want ErrNilSlice", err)
+	}
+
+	if err := Resample64(make([]float64, 4), []float64{}); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("empty src error = %v, want ErrInvalidLength", err)
+	}
+
+	r, err := NewResampler[float32, complex64](10, 7)
+	if err != nil {
+		t.Fatalf("NewResampler() returned error: %v", err)
+	}
+
+	if err := r.Apply(make([]float32, 7), make([]float32, 11)); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("length mismatch error = %v, want ErrLengthMismatch", err)
+	}
+
+	if _, err := NewComplexResampler[complex64](0, 4); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("zero length error = %v, want ErrInvalidLength", err)
+	}
+}
+
+func TestResamplerIgnoresBatchAndStride(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(4))
+	opts := PlanOptions{Batch: 4, Stride: 32}
+
+	x := randomFloat64s(rng, 10)
+	want := make([]float64, 20)
+
+	if err := Resample64(want, x); err != nil {
+		t.Fatalf("Resample64() returned error: %v", err)
+	}
+
+	r, err := NewResamplerWithOptions[float64, complex128](10, 20, opts)
+	if err != nil {
+		t.Fatalf("NewResamplerWithOptions() returned error: %v", err)
+	}
+
+	got := make([]float64, 20)
+	if err := r.Apply(got, x); err != nil {
+		t.Fatalf("Resampler.Apply() returned error: %v", err)
+	}
+
+	for i := range want {
+		assertApproxFloat64(t, got[i], want[i], 1e-12, "Resampler [%d]", i)
+	}
+
+	cx := randomComplex128s(rng, 10)
+	cwant := make([]complex128, 20)
+
+	if err := ResampleComplex(cwant, cx); err != nil {
+		t.Fatalf("ResampleComplex() returned error: %v", err)
+	}
+
+	c, err := NewComplexResamplerWithOptions[complex128](10, 20, opts)
+	if err != nil {
+		t.Fatalf("NewComplexResamplerWithOptions() returned error: %v", err)
+	}
+
+	cgot := make([]complex128, 20)
+	if err := c.Apply(cgot, cx); err != nil {
+		t.Fatalf("ComplexResampler.Apply() returned error: %v", err)
+	}
+
+	for i := range cwant {
+		if cmplx.Abs(cgot[i]-cwant[i]) > 1e-12 {
+			t.Fatalf("ComplexResampler [%d] = %v, want %v", i, cgot[i], cwant[i])
+		}
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestResamplerApplyNoAllocs(t *testing.T) {
+	x := toFloat32(randomFloat64s(rand.New(rand.NewSource(5)), 441))
+
+	for _, out := range []int{480, 333} {
+		r, err := NewResampler[float32, complex64](len(x), out)
+		if err != nil {
+			t.Fatalf("NewResampler() returned error: %v", err)
+		}
+
+		dst := make([]float32, out)
+
+		assertNoAllocs(t, "Resampler.Apply", func() error {
+			return r.Apply(dst, x)
+		})
+	}
+
+	cx := narrowComplex(randomComplex128s(rand.New(rand.NewSource(6)), 100))
+
+	c, err := NewComplexResampler[complex64](len(cx), 150)
+	if err != nil {
+		t.Fatalf("NewComplexResampler() returned error: %v", err)
+	}
+
+	cdst := make([]complex64, 150)
+
+	assertNoAllocs(t, "ComplexResampler.Apply", func() error {
+		return c.Apply(cdst, cx)
+	})
+}
@@ -0,0 +1,334 @@
+package algofft
+
+import "math/cmplx"
+
+// FFTShift moves the zero-frequency bin of a spectrum in FFT order to the
+// centre, at index n/2, as numpy.fft.fftshift. It also reorders FFTFreq
+// output into ascending order. dst and src must have the same length and
+// must not overlap; use FFTShiftInPlace to shift in place.
+func FFTShift[T any](dst, src []T) error {
+	return shift1D(dst, src, false)
+}
+
+// IFFTShift undoes FFTShift, moving the centre bin back to index 0, as
+// numpy.fft.ifftshift. It differs from FFTShift only for odd lengths.
+func IFFTShift[T any](dst, src []T) error {
+	return shift1D(dst, src, true)
+}
+
+// FFTShiftInPlace is the in-place variant of FFTShift.
+func FFTShiftInPlace[T any](data []T) error {
+	return shift1DInPlace(data, false)
+}
+
+// IFFTShiftInPlace is the in-place variant of IFFTShift.
+func IFFTShiftInPlace[T any](data []T) error {
+	return shift1DInPlace(data, true)
+}
+
+// FFTShiftND applies FFTShift along the given axes of a row-major array
+// with the given dimensions, the layout of PlanND, Plan2D and Plan3D (see
+// their Dims methods). Nil axes shift every axis. dst and src must not
+// overlap.
+func FFTShiftND[T any](dst, src []T, dims, axes []int) error {
+	return shiftND(dst, src, dims, axes, false)
+}
+
+// IFFTShiftND applies IFFTShift along the given axes, undoing FFTShiftND.
+func IFFTShiftND[T any](dst, src []T, dims, axes []int) error {
+	return shiftND(dst, src, dims, axes, true)
+}
+
+// FFTShiftNDInPlace is the in-place variant of FFTShiftND. Every line along
+// a shifted axis is rotated by cycles, element by element, so no scratch
+// memory is needed for any size.
+func FFTShiftNDInPlace[T any](data []T, dims, axes []int) error {
+	return shiftNDInPlace(data, dims, axes, false)
+}
+
+// IFFTShiftNDInPlace is the in-place variant of IFFTShiftND.
+func IFFTShiftNDInPlace[T any](data []T, dims, axes []int) error {
+	return shiftNDInPlace(data, dims, axes, true)
+}
+
+// ExpandRealSpectrum rebuilds the full spectrum of a real array with the
+// given dimensions from its compact spectrum, in which the last axis holds
+// only bins 0..n/2, as produced by PlanReal2D.Forward, PlanReal3D.Forward
+// and PlanRealT.Forward. The missing bins follow from Hermitian symmetry,
+// X[k] = conj(X[-k]). dst holds the full row-major spectrum and must not
+// overlap compact.
+func ExpandRealSpectrum[T Complex](dst, compact []T, dims []int) error {
+	return expandRealSpectrum(dst, compact, dims, false)
+}
+
+// ExpandRealSpectrumShifted is ExpandRealSpectrum followed by FFTShiftND
+// along every axis, without an intermediate copy: the zero-frequency bin
+// of the result is at the centre, ready for display.
+func ExpandRealSpectrumShifted[T Complex](dst, compact []T, dims []int) error {
+	return expandRealSpectrum(dst, compact, dims, true)
+}
+
+func shift1D[T any](dst, src []T, inverse bool) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	if len(dst) != len(src) {
+		return ErrLengthMismatch
+	}
+
+	if len(src) == 0 {
+		return nil
+	}
+
+	n := len(src)
+	copyShifted(dst, src, []int{n}, []int{shiftAmount(n, inverse) % n})
+
+	return nil
+}
+
+func shift1DInPlace[T any](data []T, inverse bool) error {
+	if data == nil {
+		return ErrNilSlice
+	}
+
+	if n := len(data); n > 0 {
+		rotateAxis(data, n, 1, shiftAmount(n, inverse)%n)
+	}
+
+	return nil
+}
+
+func shiftND[T any](dst, src []T, dims, axes []int, inverse bool) error {
+	if dst == nil || src == nil {
+		return ErrNilSlice
+	}
+
+	shifts, err := shiftAmounts(len(src), dims, axes, inverse)
+	if err != nil {
+		return err
+	}
+
+	if len(dst) != len(src) {
+		return ErrLengthMismatch
+	}
+
+	copyShifted(dst, src, dims, shifts)
+
+	return nil
+}
+
+func shiftNDInPlace[T any](data []T, dims, axes []int, inverse bool) error {
+	if data == nil {
+		return ErrNilSlice
+	}
+
+	shifts, err := shiftAmounts(len(data), dims, axes, inverse)
+	if err != nil {
+		return err
+	}
+
+	block := len(data)
+	for axis, n := range dims {
+		block /= n
+		rotateAxis(data, n, block, shifts[axis])
+	}
+
+	return nil
+}
+
+// shiftAmount returns the rotation s, with element i moving to (i+s) mod n,
+// that FFTShift (n/2) or IFFTShift (n - n/2) applies to an axis of length n.
+func shiftAmount(n int, inverse bool) int {
+	if inverse {
+		return n - n/2
+	}
+
+	return n / 2
+}
+
+// shiftAmounts validates the dimensions and axes and returns the rotation
+// of every axis.
+func shiftAmounts(length int, dims, axes []int, inverse bool) ([]int, error) {
+	total, err := ndLen(dims)
+	if err != nil {
+		return nil, err
+	}
+
+	if total != length {
+		return nil, ErrLengthMismatch
+	}
+
+	shifts := make([]int, len(dims))
+
+	if axes == nil {
+		for axis, n := range dims {
+			shifts[axis] = shiftAmount(n, inverse) % n
+		}
+
+		return shifts, nil
+	}
+
+	selected := make([]bool, len(dims))
+
+	for _, axis := range axes {
+		if axis < 0 || axis >= len(dims) || selected[axis] {
+			return nil, ErrInvalidParameter
+		}
+
+		selected[axis] = true
+		shifts[axis] = shiftAmount(dims[axis], inverse) % dims[axis]
+	}
+
+	return shifts, nil
+}
+
+// ndLen validates the dimensions of a row-major array and returns its
+// length.
+func ndLen(dims []int) (int, error) {
+	if len(dims) == 0 {
+		return 0, ErrInvalidLength
+	}
+
+	total := 1
+
+	for _, n := range dims {
+		if n < 1 {
+			return 0, ErrInvalidLength
+		}
+
+		total *= n
+	}
+
+	return total, nil
+}
+
+// copyShifted writes src to dst with index i of every axis moved to
+// (i+shifts[axis]) mod dims[axis], copying contiguous runs of the last
+// axis.
+func copyShifted[T any](dst, src []T, dims, shifts []int) {
+	n, s := dims[0], shifts[0]
+
+	if len(dims) == 1 {
+		copy(dst[s:], src[:n-s])
+		copy(dst[:s], src[n-s:])
+
+		return
+	}
+
+	block := len(src) / n
+
+	for i := range n {
+		j := (i + s) % n
+		copyShifted(dst[j*block:(j+1)*block], src[i*block:(i+1)*block], dims[1:], shifts[1:])
+	}
+}
+
+// rotateAxis rotates every line of length n, with elements block apart, of
+// data by s: element i moves to (i+s) mod n. Each of the gcd(n, s) cycles
+// of the rotation is followed with a single temporary element.
+func rotateAxis[T any](data []T, n, block, s int) {
+	if s == 0 {
+		return
+	}
+
+	span := n * block
+	cycles := gcd(n, s)
+
+	for base := 0; base < len(data); base += span {
+		line := data[base : base+span]
+
+		for c := range cycles {
+			for e := range block {
+				tmp := line[c*block+e]
+				p := c
+
+				for {
+					q := p - s
+					if q < 0 {
+						q += n
+					}
+
+					if q == c {
+						break
+					}
+
+					line[p*block+e] = line[q*block+e]
+					p = q
+				}
+
+				line[p*block+e] = tmp
+			}
+		}
+	}
+}
+
+func expandRealSpectrum[T Complex](dst, compact []T, dims []int, shifted bool) error {
+	if dst == nil || compact == nil {
+		return ErrNilSlice
+	}
+
+	total, err := ndLen(dims)
+	if err != nil {
+		return err
+	}
+
+	last := dims[len(dims)-1]
+	half := last/2 + 1
+
+	if len(dst) != total || len(compact) != total/last*half {
+		return ErrLengthMismatch
+	}
+
+	// index holds the full-spectrum coordinates of the current output bin.
+	index := make([]int, len(dims))
+
+	for range total {
+		// Offset of the bin in the compact layout, conjugating the mirrored
+		// bin -k when the last coordinate lies in the missing half.
+		mirror := index[len(dims)-1] >= half
+		src := 0
+
+		for axis, n := range dims {
+			k := index[axis]
+			if mirror && k != 0 {
+				k = n - k
+			}
+
+			if axis == len(dims)-1 {
+				src = src*half + k
+			} else {
+				src = src*n + k
+			}
+		}
+
+		v := compact[src]
+		if mirror {
+			v = T(cmplx.Conj(complex128(v)))
+		}
+
+		out := 0
+
+		for axis, n := range dims {
+			k := index[axis]
+			if shifted {
+				k = (k + n/2) % n
+			}
+
+			out = out*n + k
+		}
+
+		dst[out] = v
+
+		for axis := len(dims) - 1; axis >= 0; axis-- {
+			index[axis]++
+			if index[axis] < dims[axis] {
+				break
+			}
+
+			index[axis] = 0
+		}
+	}
+
+	return nil
+}
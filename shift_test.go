@@ -0,0 +1,360 @@
+package algofft
+
+import (
+	"errors"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+func TestFFTFreq(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		n     int
+		d     float64
+		full  []float64
+		rfull []float64
+	}{
+		{5, 0.1, []float64{0, 2, 4, -4, -2}, []float64{0, 2, 4}},
+		{6, 0.5, []float64{0, 1.0 / 3, 2.0 / 3, -1, -2.0 / 3, -1.0 / 3}, []float64{0, 1.0 / 3, 2.0 / 3, 1}},
+		{1, 1, []float64{0}, []float64{0}},
+	}
+
+	for _, tc := range tests {
+		full, half := FFTFreq(tc.n, tc.d), RFFTFreq(tc.n, tc.d)
+		if len(full) != len(tc.full) || len(half) != len(tc.rfull) {
+			t.Fatalf("n=%d: got %d and %d frequencies, want %d and %d", tc.n, len(full), len(half), len(tc.full), len(tc.rfull))
+		}
+
+		for k := range full {
+			assertApproxFloat64(t, full[k], tc.full[k], 1e-12, "FFTFreq(%d) [%d]", tc.n, k)
+		}
+
+		for k := range half {
+			assertApproxFloat64(t, half[k], tc.rfull[k], 1e-12, "RFFTFreq(%d) [%d]", tc.n, k)
+		}
+	}
+
+	if FFTFreq(0, 1) != nil || RFFTFreq(-1, 1) != nil {
+		t.Fatal("frequencies for an empty transform, want nil")
+	}
+}
+
+func TestFFTShift1D(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in, shifted, ishifted []int
+	}{
+		{[]int{0, 1, 2, 3, 4}, []int{3, 4, 0, 1, 2}, []int{2, 3, 4, 0, 1}},
+		{[]int{0, 1, 2, 3, 4, 5}, []int{3, 4, 5, 0, 1, 2}, []int{3, 4, 5, 0, 1, 2}},
+		{[]int{7}, []int{7}, []int{7}},
+		{[]int{}, []int{}, []int{}},
+	}
+
+	for _, tc := range tests {
+		got := make([]int, len(tc.in))
+		if err := FFTShift(got, tc.in); err != nil {
+			t.Fatalf("FFTShift(%v) returned error: %v", tc.in, err)
+		}
+
+		inPlace := append([]int{}, tc.in...)
+		if err := FFTShiftInPlace(inPlace); err != nil {
+			t.Fatalf("FFTShiftInPlace(%v) returned error: %v", tc.in, err)
+		}
+
+		igot := make([]int, len(tc.in))
+		if err := IFFTShift(igot, tc.in); err != nil {
+			t.Fatalf("IFFTShift(%v) returned error: %v", tc.in, err)
+		}
+
+		iInPlace := append([]int{}, tc.in...)
+		if err := IFFTShiftInPlace(iInPlace); err != nil {
+			t.Fatalf("IFFTShiftInPlace(%v) returned error: %v", tc.in, err)
+		}
+
+		for i := range tc.in {
+			if got[i] != tc.shifted[i] || inPlace[i] != tc.shifted[i] {
+				t.Fatalf("FFTShift(%v) = %v, in place %v, want %v", tc.in, got, inPlace, tc.shifted)
+			}
+
+			if igot[i] != tc.ishifted[i] || iInPlace[i] != tc.ishifted[i] {
+				t.Fatalf("IFFTShift(%v) = %v, in place %v, want %v", tc.in, igot, iInPlace, tc.ishifted)
+			}
+		}
+	}
+
+	// FFTShift puts FFTFreq output in ascending order.
+	freqs := FFTFreq(9, 1)
+	if err := FFTShiftInPlace(freqs); err != nil {
+		t.Fatalf("FFTShiftInPlace() returned error: %v", err)
+	}
+
+	for k := 1; k < len(freqs); k++ {
+		if freqs[k] <= freqs[k-1] {
+			t.Fatalf("shifted frequencies %v are not ascending", freqs)
+		}
+	}
+}
+
+// naiveShiftND moves index i of every selected axis to (i + n/2) mod n, or
+// (i + n - n/2) mod n for the inverse shift.
+func naiveShiftND(src []int, dims, axes []int, inverse bool) []int {
+	selected := make([]bool, len(dims))
+	for _, a := range axes {
+		selected[a] = true
+	}
+
+	if axes == nil {
+		for a := range selected {
+			selected[a] = true
+		}
+	}
+
+	dst := make([]int, len(src))
+	index := make([]int, len(dims))
+
+	for i := range src {
+		rem := i
+		for a := len(dims) - 1; a >= 0; a-- {
+			index[a] = rem % dims[a]
+			rem /= dims[a]
+		}
+
+		out := 0
+
+		for a, n := range dims {
+			k := index[a]
+			if selected[a] {
+				k = (k + shiftAmount(n, inverse)) % n
+			}
+
+			out = out*n + k
+		}
+
+		dst[out] = src[i]
+	}
+
+	return dst
+}
+
+func TestFFTShiftNDMatchesNaive(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		dims, axes []int
+	}{
+		{[]int{3, 4, 5}, nil},
+		{[]int{3, 4, 5}, []int{0, 2}},
+		{[]int{3, 4, 5}, []int{1}},
+		{[]int{7, 9}, nil},
+		{[]int{6, 5, 3, 2}, []int{3, 0, 1}},
+		{[]int{1, 5}, nil},
+		{[]int{8}, []int{}},
+	}
+
+	for _, tc := range tests {
+		total := 1
+		for _, n := range tc.dims {
+			total *= n
+		}
+
+		src := make([]int, total)
+		for i := range src {
+			src[i] = i
+		}
+
+		for _, inverse := range []bool{false, true} {
+			want := naiveShiftND(src, tc.dims, tc.axes, inverse)
+
+			shift, shiftInPlace := FFTShiftND[int], FFTShiftNDInPlace[int]
+			if inverse {
+				shift, shiftInPlace = IFFTShiftND[int], IFFTShiftNDInPlace[int]
+			}
+
+			got := make([]int, total)
+			if err := shift(got, src, tc.dims, tc.axes); err != nil {
+				t.Fatalf("%v axes %v: shift returned error: %v", tc.dims, tc.axes, err)
+			}
+
+			inPlace := append([]int{}, src...)
+			if err := shiftInPlace(inPlace, tc.dims, tc.axes); err != nil {
+				t.Fatalf("%v axes %v: in-place shift returned error: %v", tc.dims, tc.axes, err)
+			}
+
+			for i := range want {
+				if got[i] != want[i] || inPlace[i] != want[i] {
+					t.Fatalf("%v axes %v inverse=%v: [%d] = %d, in place %d, want %d",
+						tc.dims, tc.axes, inverse, i, got[i], inPlace[i], want[i])
+				}
+			}
+		}
+
+		// IFFTShiftND undoes FFTShiftND.
+		data := append([]int{}, src...)
+		if err := FFTShiftNDInPlace(data, tc.dims, tc.axes); err != nil {
+			t.Fatalf("FFTShiftNDInPlace() returned error: %v", err)
+		}
+
+		if err := IFFTShiftNDInPlace(data, tc.dims, tc.axes); err != nil {
+			t.Fatalf("IFFTShiftNDInPlace() returned error: %v", err)
+		}
+
+		for i := range src {
+			if data[i] != src[i] {
+				t.Fatalf("%v axes %v: round trip [%d] = %d, want %d", tc.dims, tc.axes, i, data[i], src[i])
+			}
+		}
+	}
+}
+
+func TestExpandRealSpectrum(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(13))
+
+	// 2D and 3D real plans against the full complex transforms.
+	x2 := toFloat32(randomFloat64s(rng, 6*8))
+
+	real2D, err := NewPlanReal2D(6, 8)
+	if err != nil {
+		t.Fatalf("NewPlanReal2D() returned error: %v", err)
+	}
+
+	full2D, err := NewPlan2D[complex64](6, 8)
+	if err != nil {
+		t.Fatalf("NewPlan2D() returned error: %v", err)
+	}
+
+	checkExpand(t, x2, real2D.Dims(), real2D.SpectrumLen(), real2D.Forward, full2D.Forward)
+
+	x3 := toFloat32(randomFloat64s(rng, 3*5*6))
+
+	real3D, err := NewPlanReal3D(3, 5, 6)
+	if err != nil {
+		t.Fatalf("NewPlanReal3D() returned error: %v", err)
+	}
+
+	full3D, err := NewPlan3D[complex64](3, 5, 6)
+	if err != nil {
+		t.Fatalf("NewPlan3D() returned error: %v", err)
+	}
+
+	checkExpand(t, x3, real3D.Dims(), real3D.SpectrumLen(), real3D.Forward, full3D.Forward)
+
+	// An odd last dimension, with the compact spectrum cut from a full one.
+	dims := []int{4, 5}
+
+	nd, err := NewPlanND[complex128](dims)
+	if err != nil {
+		t.Fatalf("NewPlanND() returned error: %v", err)
+	}
+
+	xc := make([]complex128, 20)
+	for i, v := range randomFloat64s(rng, 20) {
+		xc[i] = complex(v, 0)
+	}
+
+	full := make([]complex128, 20)
+	if err := nd.Forward(full, xc); err != nil {
+		t.Fatalf("PlanND.Forward() returned error: %v", err)
+	}
+
+	compact := make([]complex128, 0, 12)
+	for r := range 4 {
+		compact = append(compact, full[r*5:r*5+3]...)
+	}
+
+	got := make([]complex128, 20)
+	if err := ExpandRealSpectrum(got, compact, dims); err != nil {
+		t.Fatalf("ExpandRealSpectrum() returned error: %v", err)
+	}
+
+	for i := range full {
+		if cmplx.Abs(got[i]-full[i]) > 1e-12 {
+			t.Fatalf("odd expand [%d] = %v, want %v", i, got[i], full[i])
+		}
+	}
+
+	if err := ExpandRealSpectrum(got, compact[:11], dims); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("short compact error = %v, want ErrLengthMismatch", err)
+	}
+
+	if err := ExpandRealSpectrumShifted(got, compact, []int{4, 0}); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("zero dimension error = %v, want ErrInvalidLength", err)
+	}
+}
+
+func checkExpand(
+	t *testing.T, x []float32, dims []int, spectrumLen int,
+	forwardReal func([]complex64, []float32) error,
+	forwardFull func([]complex64, []complex64) error,
+) {
+	t.Helper()
+
+	compact := make([]complex64, spectrumLen)
+	if err := forwardReal(compact, x); err != nil {
+		t.Fatalf("%v: real Forward() returned error: %v", dims, err)
+	}
+
+	xc := make([]complex64, len(x))
+	for i, v := range x {
+		xc[i] = complex(v, 0)
+	}
+
+	want := make([]complex64, len(x))
+	if err := forwardFull(want, xc); err != nil {
+		t.Fatalf("%v: complex Forward() returned error: %v", dims, err)
+	}
+
+	wantShifted := make([]complex64, len(x))
+	if err := FFTShiftND(wantShifted, want, dims, nil); err != nil {
+		t.Fatalf("%v: FFTShiftND() returned error: %v", dims, err)
+	}
+
+	got := make([]complex64, len(x))
+	if err := ExpandRealSpectrum(got, compact, dims); err != nil {
+		t.Fatalf("%v: ExpandRealSpectrum() returned error: %v", dims, err)
+	}
+
+	shifted := make([]complex64, len(x))
+	if err := ExpandRealSpectrumShifted(shifted, compact, dims); err != nil {
+		t.Fatalf("%v: ExpandRealSpectrumShifted() returned error: %v", dims, err)
+	}
+
+	for i := range want {
+		if cmplx.Abs(complex128(got[i]-want[i])) > 1e-4 {
+			t.Fatalf("%v: expand [%d] = %v, want %v", dims, i, got[i], want[i])
+		}
+
+		if cmplx.Abs(complex128(shifted[i]-wantShifted[i])) > 1e-4 {
+			t.Fatalf("%v: shifted expand [%d] = %v, want %v", dims, i, shifted[i], wantShifted[i])
+		}
+	}
+}
+
+func TestFFTShiftErrors(t *testing.T) {
+	t.Parallel()
+
+	data := make([]float64, 12)
+
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"nil", FFTShift(nil, data), ErrNilSlice},
+		{"length", FFTShift(data[:5], data[6:]), ErrLengthMismatch},
+		{"dims product", FFTShiftND(make([]float64, 12), data, []int{3, 5}, nil), ErrLengthMismatch},
+		{"no dims", FFTShiftNDInPlace(data, nil, nil), ErrInvalidLength},
+		{"axis range", IFFTShiftNDInPlace(data, []int{3, 4}, []int{2}), ErrInvalidParameter},
+		{"duplicate axis", IFFTShiftND(make([]float64, 12), data, []int{3, 4}, []int{1, 1}), ErrInvalidParameter},
+	}
+
+	for _, tc := range tests {
+		if !errors.Is(tc.err, tc.want) {
+			t.Fatalf("%s: error = %v, want %v", tc.name, tc.err, tc.want)
+		}
+	}
+}
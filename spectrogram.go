@@ -0,0 +1,398 @@
+package algofft
+
+import (
+	"math"
+	"slices"
+	"sync"
+)
+
+// SpectrogramScale selects the values stored in a spectrogram.
+type SpectrogramScale uint8
+
+const (
+	// SpectrogramPower stores the squared magnitude |X|² of every bin. It
+	// is the default.
+	SpectrogramPower SpectrogramScale = iota
+
+	// SpectrogramMagnitude stores the magnitude |X|.
+	SpectrogramMagnitude
+
+	// SpectrogramDB stores the power in decibels,
+	// 10·log10(max(|X|², Floor)/Reference).
+	SpectrogramDB
+)
+
+// String returns the name of the scale.
+func (s SpectrogramScale) String() string {
+	switch s {
+	case SpectrogramPower:
+		return "power"
+	case SpectrogramMagnitude:
+		return "magnitude"
+	case SpectrogramDB:
+		return "dB"
+	default:
+		return "unknown"
+	}
+}
+
+// defaultSpectrogramFloor is the default smallest power converted to dB,
+// which puts silence at -100 dB relative to a unit reference.
+const defaultSpectrogramFloor = 1e-10
+
+// SpectrogramConfig configures Spectrogram and SpectrogramStream.
+type SpectrogramConfig struct {
+	// STFT selects the framing: frame length, hop, window, FFT length,
+	// centring and padding. Unlike NewPlanSTFT, any hop is accepted, since a
+	// spectrogram need not be invertible.
+	STFT STFTOptions
+
+	// Scale selects power (default), magnitude or dB values.
+	Scale SpectrogramScale
+
+	// Reference is the power that maps to 0 dB for SpectrogramDB. Zero
+	// selects 1.
+	Reference float64
+
+	// Floor is the smallest power passed to the logarithm for
+	// SpectrogramDB, which bounds the output below at
+	// 10·log10(Floor/Reference) dB. Zero selects 1e-10.
+	Floor float64
+
+	// Workers is the number of goroutines Spectrogram spreads the frames
+	// over, each with its own plan and buffers. Values below 2 run on the
+	// calling goroutine. Streams ignore it.
+	Workers int
+}
+
+// Spectrogram computes the spectrogram of x: the framing of cfg.STFT, one
+// row of STFT.FFTLen/2+1 values per frame, transformed with PlanRealT
+// batch transforms. It returns the frames×bins row-major matrix and its
+// dimensions. The frames match PlanSTFT.Forward; the values are not
+// normalized by the window or FFT length.
+func Spectrogram(x []float32, cfg SpectrogramConfig) ([]float32, int, int, error) {
+	if x == nil {
+		return nil, 0, 0, ErrNilSlice
+	}
+
+	geom, err := newSTFTGeometry(cfg.STFT)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	frames := geom.frames(len(x))
+	if frames == 0 {
+		return nil, 0, 0, ErrInvalidLength
+	}
+
+	engine, err := newSpectrogramEngine(geom, cfg)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	out := make([]float32, frames*engine.bins)
+	workers := min(cfg.Workers, (frames+stftBatch-1)/stftBatch)
+
+	if workers < 2 {
+		err = engine.run(out, x, 0, frames)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		return out, frames, engine.bins, nil
+	}
+
+	// Contiguous, batch-aligned frame ranges, one per worker.
+	per := (frames + workers - 1) / workers
+	per = (per + stftBatch - 1) / stftBatch * stftBatch
+
+	engines := []*spectrogramEngine{engine}
+	for len(engines)*per < frames {
+		e, err := newSpectrogramEngine(geom, cfg)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		engines = append(engines, e)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	for w, e := range engines {
+		wg.Add(1)
+
+		go func(first, last int) {
+			defer wg.Done()
+
+			err := e.run(out, x, first, last)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}(w*per, min((w+1)*per, frames))
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, 0, 0, firstErr
+	}
+
+	return out, frames, engine.bins, nil
+}
+
+// SpectrogramStream computes a spectrogram incrementally: Append accepts
+// chunks of samples as they arrive and appends a row for every frame they
+// complete, and Flush emits the frames that reach past the end of the
+// signal. The rows are identical to Spectrogram over the concatenated
+// samples.
+//
+// Only the samples that later frames still need are retained. BoundaryWrap
+// padding needs the end of the signal before the first frame and is not
+// supported. A SpectrogramStream must not be used by multiple goroutines
+// at the same time.
+type SpectrogramStream struct {
+	engine *spectrogramEngine
+
+	buf      []float32 // samples [base, base+len(buf)) of the signal
+	base     int
+	received int
+	next     int // index of the next frame to emit
+}
+
+// NewSpectrogramStream creates a streaming spectrogram. It returns
+// ErrInvalidMode for BoundaryWrap padding and the errors of Spectrogram
+// for invalid options.
+func NewSpectrogramStream(cfg SpectrogramConfig) (*SpectrogramStream, error) {
+	geom, err := newSTFTGeometry(cfg.STFT)
+	if err != nil {
+		return nil, err
+	}
+
+	if geom.padding == BoundaryWrap {
+		return nil, ErrInvalidMode
+	}
+
+	engine, err := newSpectrogramEngine(geom, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SpectrogramStream{engine: engine}, nil
+}
+
+// Bins returns the number of values per row.
+func (s *SpectrogramStream) Bins() int {
+	return s.engine.bins
+}
+
+// Append feeds samples into the stream and appends a row of Bins() values
+// to dst for every frame they complete, returning the extended slice.
+func (s *SpectrogramStream) Append(dst, samples []float32) ([]float32, error) {
+	if samples == nil {
+		return dst, ErrNilSlice
+	}
+
+	s.buf = append(s.buf, samples...)
+	s.received += len(samples)
+
+	e := s.engine
+	last := s.next
+
+	for (last*e.hop - e.pad + e.frameLen) <= s.received {
+		last++
+	}
+
+	dst, err := s.emit(dst, last, s.received)
+	if err != nil {
+		return dst, err
+	}
+
+	// Keep the samples of the pending frames, plus enough history for the
+	// end-of-signal reflection in Flush.
+	keep := max(0, min(s.next*e.hop-e.pad, s.received-e.frameLen-e.hop))
+	if drop := keep - s.base; drop > 0 {
+		s.buf = s.buf[:copy(s.buf, s.buf[drop:])]
+		s.base = keep
+	}
+
+	return dst, nil
+}
+
+// Flush appends the rows of the remaining frames, which are padded past
+// the end of the signal, and resets the stream for a new signal.
+func (s *SpectrogramStream) Flush(dst []float32) ([]float32, error) {
+	dst, err := s.emit(dst, s.engine.frames(s.received), s.received)
+	s.Reset()
+
+	return dst, err
+}
+
+// Reset discards the buffered samples so that the stream starts a new
+// signal.
+func (s *SpectrogramStream) Reset() {
+	s.buf = s.buf[:0]
+	s.base = 0
+	s.received = 0
+	s.next = 0
+}
+
+// emit appends the rows of frames s.next..last-1 to dst, extending the
+// signal past index n by the padding rule.
+func (s *SpectrogramStream) emit(dst []float32, last, n int) ([]float32, error) {
+	e := s.engine
+
+	for s.next < last {
+		count := min(stftBatch, last-s.next)
+
+		for b := range count {
+			s.fill(e.frameBuf[b*e.fftLen:(b+1)*e.fftLen], (s.next+b)*e.hop-e.pad, n)
+		}
+
+		size := len(dst)
+		dst = slices.Grow(dst, count*e.bins)[:size+count*e.bins]
+
+		err := e.transform(dst[size:], count)
+		if err != nil {
+			return dst[:size], err
+		}
+
+		s.next += count
+	}
+
+	return dst, nil
+}
+
+// fill windows the frame of the buffered signal of n samples that starts
+// at sample start.
+func (s *SpectrogramStream) fill(frame []float32, start, n int) {
+	e := s.engine
+
+	for j, w := range e.win {
+		i := start + j
+
+		if i < 0 || i >= n {
+			if e.padding == BoundaryZero {
+				frame[j] = 0
+				continue
+			}
+
+			i = boundaryIndex(i, n, e.padding)
+		}
+
+		frame[j] = w * s.buf[i-s.base]
+	}
+
+	clear(frame[e.frameLen:])
+}
+
+// spectrogramEngine transforms batches of windowed frames and converts the
+// spectra to spectrogram values.
+type spectrogramEngine struct {
+	stftGeometry
+
+	bins      int
+	scale     SpectrogramScale
+	reference float64
+	floor     float64
+
+	win      []float32
+	plan     *PlanRealT[float32, complex64]
+	frameBuf []float32
+	spec     []complex64
+}
+
+func newSpectrogramEngine(geom stftGeometry, cfg SpectrogramConfig) (*spectrogramEngine, error) {
+	if cfg.Scale > SpectrogramDB {
+		return nil, ErrInvalidMode
+	}
+
+	reference, floor := cfg.Reference, cfg.Floor
+	if reference == 0 {
+		reference = 1
+	}
+
+	if floor == 0 {
+		floor = defaultSpectrogramFloor
+	}
+
+	if !(reference > 0) || !(floor > 0) {
+		return nil, ErrInvalidParameter
+	}
+
+	planOpts := cfg.STFT.PlanOptions
+	planOpts.Batch = 0
+	planOpts.Stride = 0
+
+	plan, err := NewPlanRealTWithOptions[float32, complex64](geom.fftLen, planOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &spectrogramEngine{
+		stftGeometry: geom,
+		bins:         geom.fftLen/2 + 1,
+		scale:        cfg.Scale,
+		reference:    reference,
+		floor:        floor,
+		win:          make([]float32, geom.frameLen),
+		plan:         plan,
+		frameBuf:     make([]float32, stftBatch*geom.fftLen),
+		spec:         make([]complex64, stftBatch*(geom.fftLen/2+1)),
+	}
+
+	for i, w := range geom.window {
+		e.win[i] = float32(w)
+	}
+
+	return e, nil
+}
+
+// run writes the rows of frames first..last-1 of x to dst.
+func (e *spectrogramEngine) run(dst, x []float32, first, last int) error {
+	for t0 := first; t0 < last; t0 += stftBatch {
+		count := min(stftBatch, last-t0)
+
+		for b := range count {
+			stftFrame(e.frameBuf[b*e.fftLen:(b+1)*e.fftLen], x, (t0+b)*e.hop-e.pad, e.win, e.padding)
+		}
+
+		err := e.transform(dst[t0*e.bins:], count)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// transform converts the first count frames of e.frameBuf into rows of dst.
+func (e *spectrogramEngine) transform(dst []float32, count int) error {
+	err := e.plan.ForwardBatch(e.spec, e.frameBuf, count)
+	if err != nil {
+		return err
+	}
+
+	for i, v := range e.spec[:count*e.bins] {
+		re, im := float64(real(v)), float64(imag(v))
+		power := re*re + im*im
+
+		switch e.scale {
+		case SpectrogramMagnitude:
+			dst[i] = float32(math.Sqrt(power))
+		case SpectrogramDB:
+			dst[i] = float32(10 * math.Log10(max(power, e.floor)/e.reference))
+		default:
+			dst[i] = float32(power)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestSpectrogramMatchesSTFT(t *testing.T) {
+	t.Parallel()
+
+	x := toFloat32(randomFloat64s(rand.New(rand.NewSource(2)), 3000))
+	opts := STFTOptions{FrameLen: 128, Hop: 32, Center: true, Padding: BoundaryReflect}
+
+	stft, err := NewPlanSTFT[float32, complex64](opts)
+	if err != nil {
+		t.Fatalf("NewPlanSTFT() returned error: %v", err)
+	}
+
+	spec := make([]complex64, stft.Frames(len(x))*stft.Bins())
+	if err := stft.Forward(spec, x); err != nil {
+		t.Fatalf("Forward() returned error: %v", err)
+	}
+
+	cfgs := []SpectrogramConfig{
+		{STFT: opts},
+		{STFT: opts, Scale: SpectrogramMagnitude},
+		{STFT: opts, Scale: SpectrogramDB, Reference: 4, Floor: 1e-3},
+	}
+
+	for _, cfg := range cfgs {
+		got, frames, bins, err := Spectrogram(x, cfg)
+		if err != nil {
+			t.Fatalf("%v: Spectrogram() returned error: %v", cfg.Scale, err)
+		}
+
+		if frames != stft.Frames(len(x)) || bins != stft.Bins() || len(got) != frames*bins {
+			t.Fatalf("%v: got %d×%d (%d values), want %d×%d", cfg.Scale, frames, bins, len(got), stft.Frames(len(x)), stft.Bins())
+		}
+
+		for i, v := range spec {
+			power := float64(real(v))*float64(real(v)) + float64(imag(v))*float64(imag(v))
+
+			want := power
+			switch cfg.Scale {
+			case SpectrogramMagnitude:
+				want = math.Sqrt(power)
+			case SpectrogramDB:
+				want = 10 * math.Log10(max(power, cfg.Floor)/cfg.Reference)
+			}
+
+			assertApproxFloat64(t, float64(got[i]), want, 1e-4*(1+math.Abs(want)), "%v [%d]", cfg.Scale, i)
+		}
+	}
+
+	// Silence sits at the floor: -100 dB relative to the default reference.
+	silent, _, _, err := Spectrogram(make([]float32, 500), SpectrogramConfig{STFT: opts, Scale: SpectrogramDB})
+	if err != nil {
+		t.Fatalf("Spectrogram(silence) returned error: %v", err)
+	}
+
+	for i, v := range silent {
+		if v != -100 {
+			t.Fatalf("silent [%d] = %v dB, want -100", i, v)
+		}
+	}
+}
+
+func TestSpectrogramParallel(t *testing.T) {
+	t.Parallel()
+
+	x := toFloat32(randomFloat64s(rand.New(rand.NewSource(6)), 20000))
+
+	// A hop longer than the frame is fine for a spectrogram, which need not
+	// be invertible.
+	for _, opts := range []STFTOptions{
+		{FrameLen: 256, Hop: 64, Center: true},
+		{FrameLen: 100, Hop: 150, FFTLen: 128},
+	} {
+		want, _, _, err := Spectrogram(x, SpectrogramConfig{STFT: opts})
+		if err != nil {
+			t.Fatalf("Spectrogram() returned error: %v", err)
+		}
+
+		for _, workers := range []int{2, 3, 16} {
+			got, _, _, err := Spectrogram(x, SpectrogramConfig{STFT: opts, Workers: workers})
+			if err != nil {
+				t.Fatalf("Spectrogram(workers=%d) returned error: %v", workers, err)
+			}
+
+			for i := range want {
+				if got[i] != want[i] {
+					t.Fatalf("workers=%d [%d] = %v, want %v", workers, i, got[i], want[i])
+				}
+			}
+		}
+	}
+}
+
+func TestSpectrogramStreamMatchesOneShot(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(12))
+
+	tests := []struct {
+		name string
+		opts STFTOptions
+		n    int
+	}{
+		{"centred reflect", STFTOptions{FrameLen: 64, Hop: 16, Center: true, Padding: BoundaryReflect}, 1000},
+		{"centred zero", STFTOptions{FrameLen: 64, Hop: 40, Center: true}, 777},
+		{"uncentred", STFTOptions{FrameLen: 50, Hop: 50, FFTLen: 64}, 1234},
+		{"shorter than a frame", STFTOptions{FrameLen: 256, Hop: 128, Center: true, Padding: BoundaryReflect}, 90},
+	}
+
+	for _, tc := range tests {
+		x := toFloat32(randomFloat64s(rng, tc.n))
+		cfg := SpectrogramConfig{STFT: tc.opts, Scale: SpectrogramDB}
+
+		want, _, _, err := Spectrogram(x, cfg)
+		if err != nil {
+			t.Fatalf("%s: Spectrogram() returned error: %v", tc.name, err)
+		}
+
+		stream, err := NewSpectrogramStream(cfg)
+		if err != nil {
+			t.Fatalf("%s: NewSpectrogramStream() returned error: %v", tc.name, err)
+		}
+
+		// Run twice to check that Flush resets the stream.
+		for range 2 {
+			var got []float32
+
+			for pos := 0; pos < len(x); {
+				chunk := min(rng.Intn(100), len(x)-pos)
+
+				got, err = stream.Append(got, x[pos:pos+chunk])
+				if err != nil {
+					t.Fatalf("%s: Append() returned error: %v", tc.name, err)
+				}
+
+				pos += chunk
+
+				if len(got)%stream.Bins() != 0 {
+					t.Fatalf("%s: Append() left a partial row", tc.name)
+				}
+			}
+
+			got, err = stream.Flush(got)
+			if err != nil {
+				t.Fatalf("%s: Flush() returned error: %v", tc.name, err)
+			}
+
+			if len(got) != len(want) {
+				t.Fatalf("%s: stream produced %d values, want %d", tc.name, len(got), len(want))
+			}
+
+			for i := range want {
+				if got[i] != want[i] {
+					t.Fatalf("%s: stream [%d] = %v, want %v", tc.name, i, got[i], want[i])
+				}
+			}
+		}
+	}
+}
+
+func TestSpectrogramErrors(t *testing.T) {
+	t.Parallel()
+
+	opts := STFTOptions{FrameLen: 32}
+
+	tests := []struct {
+		name string
+		cfg  SpectrogramConfig
+		want error
+	}{
+		{"scale", SpectrogramConfig{STFT: opts, Scale: SpectrogramScale(9)}, ErrInvalidMode},
+		{"reference", SpectrogramConfig{STFT: opts, Reference: -1}, ErrInvalidParameter},
+		{"floor", SpectrogramConfig{STFT: opts, Floor: math.NaN()}, ErrInvalidParameter},
+		{"frame length", SpectrogramConfig{}, ErrInvalidLength},
+	}
+
+	for _, tc := range tests {
+		if _, _, _, err := Spectrogram(make([]float32, 100), tc.cfg); !errors.Is(err, tc.want) {
+			t.Fatalf("%s: error = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+
+	if _, _, _, err := Spectrogram(nil, SpectrogramConfig{STFT: opts}); !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil signal error = %v, want ErrNilSlice", err)
+	}
+
+	if _, _, _, err := Spectrogram([]float32{}, SpectrogramConfig{STFT: opts}); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("empty signal error = %v, want ErrInvalidLength", err)
+	}
+
+	wrap := SpectrogramConfig{STFT: STFTOptions{FrameLen: 32, Center: true, Padding: BoundaryWrap}}
+	if _, err := NewSpectrogramStream(wrap); !errors.Is(err, ErrInvalidMode) {
+		t.Fatalf("wrap stream error = %v, want ErrInvalidMode", err)
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestSpectrogramStreamNoAllocs(t *testing.T) {
+	stream, err := NewSpectrogramStream(SpectrogramConfig{STFT: STFTOptions{FrameLen: 256, Hop: 64, Center: true}})
+	if err != nil {
+		t.Fatalf("NewSpectrogramStream() returned error: %v", err)
+	}
+
+	chunk := toFloat32(randomFloat64s(rand.New(rand.NewSource(1)), 512))
+	out := make([]float32, 0, 16*stream.Bins())
+
+	// Warm up the sample buffer.
+	for range 4 {
+		if _, err := stream.Append(out, chunk); err != nil {
+			t.Fatalf("Append() returned error: %v", err)
+		}
+	}
+
+	assertNoAllocs(t, "Append", func() error {
+		_, err := stream.Append(out, chunk)
+		return err
+	})
+}
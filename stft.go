@@ -0,0 +1,498 @@
+package algofft
+
+import (
+	"math"
+
+	"github.com/MeKo-Christian/algo-fft/window"
+)
+
+// stftBatch is the number of frames transformed per ForwardBatch call.
+const stftBatch = 8
+
+// stftNOLATolerance is the smallest window energy, relative to the largest,
+// that an output sample may receive from the overlapping frames.
+const stftNOLATolerance = 1e-10
+
+// STFTOptions configures a short-time Fourier transform.
+type STFTOptions struct {
+	// FrameLen is the number of samples per frame. It is required.
+	FrameLen int
+
+	// Hop is the number of samples between the starts of consecutive
+	// frames. Zero selects FrameLen/2.
+	Hop int
+
+	// Window holds the FrameLen coefficients applied to every frame. Nil
+	// selects a periodic Hann window.
+	Window []float64
+
+	// FFTLen is the transform length. Frames are zero-padded to it, so it
+	// must be at least FrameLen, and it must be even. Zero selects
+	// FrameLen rounded up to an even length.
+	FFTLen int
+
+	// Center pads FrameLen/2 samples in front of the signal so that frame t
+	// is centred on sample t·Hop (librosa center=True).
+	Center bool
+
+	// Padding selects how the signal is extended past its ends, both for
+	// the Center padding and for the last frame.
+	Padding Boundary
+
+	// PlanOptions is passed to the FFT plan constructors.
+	PlanOptions PlanOptions
+}
+
+// PlanSTFT is a pre-computed short-time Fourier transform.
+//
+// Forward splits a signal into overlapping windowed frames and transforms
+// them into a frames×bins row-major matrix; Frames reports the frame count
+// for a signal length. The frames cover every sample of the signal, the
+// last one extended according to Padding. Inverse reconstructs the signal
+// by weighted overlap-add: each frame is windowed again, summed, and
+// divided by the summed squared window, which recovers the input exactly
+// for an unmodified spectrum and gives the least-squares estimate for a
+// modified one.
+//
+// Real input uses PlanRealT batch transforms with FFTLen()/2+1 bins per
+// frame; ForwardComplex and InverseComplex handle complex input with all
+// FFTLen() bins.
+//
+// Without Center, the first and last samples are weighted by the window
+// edges alone; samples where that weight vanishes (such as the first
+// sample under a Hann window) are returned as zero by the inverse.
+//
+// Forward and Inverse do not allocate. A PlanSTFT must not be used by
+// multiple goroutines at the same time.
+type PlanSTFT[F Float, C Complex] struct {
+	stftGeometry
+
+	cola    bool
+	minNorm float64
+
+	winReal    []F
+	winComplex []C
+
+	realPlan *PlanRealT[F, C]
+	cplxPlan *Plan[C]
+
+	realBuf    []F
+	spectrum   []C
+	complexBuf []C
+}
+
+// NewPlanSTFT creates a short-time Fourier transform plan.
+//
+// It returns ErrInvalidLength for a FrameLen below 1 or an FFTLen that is
+// odd or shorter than FrameLen, ErrLengthMismatch for a window of the wrong
+// length, ErrInvalidParameter for a negative hop, ErrInvalidMode for an
+// unknown Padding, and ErrNotInvertible if the window and hop violate the
+// nonzero overlap-add (NOLA) condition, so that Inverse could not recover
+// the signal.
+//
+// Example:
+//
+//	stft, err := algofft.NewPlanSTFT[float32, complex64](algofft.STFTOptions{
+//		FrameLen: 1024,
+//		Hop:      256,
+//		Center:   true,
+//	})
+func NewPlanSTFT[F Float, C Complex](opts STFTOptions) (*PlanSTFT[F, C], error) {
+	geom, err := newSTFTGeometry(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &PlanSTFT[F, C]{stftGeometry: geom}
+
+	err = p.checkOverlapAdd()
+	if err != nil {
+		return nil, err
+	}
+
+	p.winReal = make([]F, p.frameLen)
+	p.winComplex = make([]C, p.frameLen)
+
+	for i, w := range p.window {
+		p.winReal[i] = F(w)
+		p.winComplex[i] = C(complex(w, 0))
+	}
+
+	planOpts := opts.PlanOptions
+	planOpts.Batch = 0
+	planOpts.Stride = 0
+
+	p.realPlan, err = NewPlanRealTWithOptions[F, C](p.fftLen, planOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	p.cplxPlan, err = NewPlanWithOptions[C](p.fftLen, planOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	p.realBuf = make([]F, stftBatch*p.fftLen)
+	p.spectrum = make([]C, stftBatch*p.Bins())
+	p.complexBuf = make([]C, stftBatch*p.fftLen)
+
+	return p, nil
+}
+
+// stftGeometry describes how a signal is cut into frames: frame t covers
+// samples [t·hop-pad, t·hop-pad+frameLen) and is zero-padded to fftLen.
+type stftGeometry struct {
+	frameLen int
+	hop      int
+	fftLen   int
+	pad      int
+	padding  Boundary
+	window   []float64
+}
+
+// newSTFTGeometry validates the framing options and applies their
+// defaults.
+func newSTFTGeometry(opts STFTOptions) (stftGeometry, error) {
+	frameLen := opts.FrameLen
+	if frameLen < 1 {
+		return stftGeometry{}, ErrInvalidLength
+	}
+
+	hop := opts.Hop
+	if hop < 0 {
+		return stftGeometry{}, ErrInvalidParameter
+	}
+
+	if hop == 0 {
+		hop = max(frameLen/2, 1)
+	}
+
+	fftLen := opts.FFTLen
+	if fftLen == 0 {
+		fftLen = frameLen + frameLen%2
+	}
+
+	if fftLen < frameLen || fftLen%2 != 0 {
+		return stftGeometry{}, ErrInvalidLength
+	}
+
+	if opts.Padding > BoundaryReflect {
+		return stftGeometry{}, ErrInvalidMode
+	}
+
+	win := opts.Window
+	if win == nil {
+		win = window.Hann(frameLen, window.Periodic)
+	} else if len(win) != frameLen {
+		return stftGeometry{}, ErrLengthMismatch
+	}
+
+	g := stftGeometry{
+		frameLen: frameLen,
+		hop:      hop,
+		fftLen:   fftLen,
+		padding:  opts.Padding,
+		window:   append([]float64(nil), win...),
+	}
+
+	if opts.Center {
+		g.pad = frameLen / 2
+	}
+
+	return g, nil
+}
+
+// frames returns the number of frames covering signalLen samples.
+func (g *stftGeometry) frames(signalLen int) int {
+	if signalLen < 1 {
+		return 0
+	}
+
+	span := signalLen + 2*g.pad - g.frameLen
+	if span <= 0 {
+		return 1
+	}
+
+	return 1 + (span+g.hop-1)/g.hop
+}
+
+// checkOverlapAdd evaluates the overlap-added window and squared window
+// over one hop period. NOLA requires every squared sum to be nonzero;
+// COLA additionally requires the plain sums to be constant.
+func (p *PlanSTFT[F, C]) checkOverlapAdd() error {
+	minEnergy, maxEnergy := math.Inf(1), 0.0
+	minSum, maxSum := math.Inf(1), math.Inf(-1)
+
+	for r := range p.hop {
+		var sum, energy float64
+		for i := r; i < p.frameLen; i += p.hop {
+			sum += p.window[i]
+			energy += p.window[i] * p.window[i]
+		}
+
+		minEnergy, maxEnergy = min(minEnergy, energy), max(maxEnergy, energy)
+		minSum, maxSum = min(minSum, sum), max(maxSum, sum)
+	}
+
+	if !(minEnergy > stftNOLATolerance*maxEnergy) {
+		return ErrNotInvertible
+	}
+
+	p.minNorm = stftNOLATolerance * maxEnergy
+	p.cola = maxSum-minSum <= stftNOLATolerance*math.Max(math.Abs(maxSum), math.Abs(minSum))
+
+	return nil
+}
+
+// FrameLen returns the number of samples per frame.
+func (p *PlanSTFT[F, C]) FrameLen() int {
+	return p.frameLen
+}
+
+// Hop returns the number of samples between consecutive frames.
+func (p *PlanSTFT[F, C]) Hop() int {
+	return p.hop
+}
+
+// FFTLen returns the transform length, which is also the number of bins
+// per frame of ForwardComplex.
+func (p *PlanSTFT[F, C]) FFTLen() int {
+	return p.fftLen
+}
+
+// Bins returns the number of bins per frame of Forward, FFTLen()/2+1.
+func (p *PlanSTFT[F, C]) Bins() int {
+	return p.fftLen/2 + 1
+}
+
+// COLA reports whether the window satisfies the constant overlap-add
+// condition for the hop, in which case the plain sum of the windowed frames
+// is the signal scaled by a constant. Inverse only needs the weaker NOLA
+// condition, which NewPlanSTFT already enforces.
+func (p *PlanSTFT[F, C]) COLA() bool {
+	return p.cola
+}
+
+// Frames returns the number of frames for a signal of signalLen samples:
+// enough to cover every sample, and 0 for an empty signal.
+func (p *PlanSTFT[F, C]) Frames(signalLen int) int {
+	return p.frames(signalLen)
+}
+
+// Forward computes the STFT of a real signal. dst must have length
+// Frames(len(signal))*Bins(); row t holds the spectrum of frame t.
+func (p *PlanSTFT[F, C]) Forward(dst []C, signal []F) error {
+	if dst == nil || signal == nil {
+		return ErrNilSlice
+	}
+
+	frames := p.Frames(len(signal))
+	if frames == 0 {
+		return ErrInvalidLength
+	}
+
+	bins := p.Bins()
+	if len(dst) != frames*bins {
+		return ErrLengthMismatch
+	}
+
+	for t0 := 0; t0 < frames; t0 += stftBatch {
+		count := min(stftBatch, frames-t0)
+
+		for b := range count {
+			stftFrame(p.realBuf[b*p.fftLen:(b+1)*p.fftLen], signal, (t0+b)*p.hop-p.pad, p.winReal, p.padding)
+		}
+
+		err := p.realPlan.ForwardBatch(dst[t0*bins:], p.realBuf, count)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Inverse reconstructs a real signal of len(dst) samples from its STFT by
+// weighted overlap-add. spectrum must have length Frames(len(dst))*Bins().
+// The imaginary parts of the DC and Nyquist bins are ignored, so modified
+// spectra need not be exactly conjugate-symmetric.
+func (p *PlanSTFT[F, C]) Inverse(dst []F, spectrum []C) error {
+	if dst == nil || spectrum == nil {
+		return ErrNilSlice
+	}
+
+	frames := p.Frames(len(dst))
+	if frames == 0 {
+		return ErrInvalidLength
+	}
+
+	bins := p.Bins()
+	if len(spectrum) != frames*bins {
+		return ErrLengthMismatch
+	}
+
+	clear(dst)
+
+	for t0 := 0; t0 < frames; t0 += stftBatch {
+		count := min(stftBatch, frames-t0)
+		buf := p.spectrum[:count*bins]
+
+		copy(buf, spectrum[t0*bins:(t0+count)*bins])
+
+		for b := range count {
+			dc, nyquist := complex128(buf[b*bins]), complex128(buf[(b+1)*bins-1])
+			buf[b*bins] = C(complex(real(dc), 0))
+			buf[(b+1)*bins-1] = C(complex(real(nyquist), 0))
+		}
+
+		err := p.realPlan.InverseBatch(p.realBuf, buf, count)
+		if err != nil {
+			return err
+		}
+
+		for b := range count {
+			stftOverlapAdd(dst, p.realBuf[b*p.fftLen:], (t0+b)*p.hop-p.pad, p.winReal)
+		}
+	}
+
+	for i := range dst {
+		norm := p.windowEnergy(i+p.pad, frames)
+		if norm > p.minNorm {
+			dst[i] /= F(norm)
+		} else {
+			dst[i] = 0
+		}
+	}
+
+	return nil
+}
+
+// ForwardComplex computes the STFT of a complex signal. dst must have
+// length Frames(len(signal))*FFTLen(); row t holds the full spectrum of
+// frame t.
+func (p *PlanSTFT[F, C]) ForwardComplex(dst, signal []C) error {
+	if dst == nil || signal == nil {
+		return ErrNilSlice
+	}
+
+	frames := p.Frames(len(signal))
+	if frames == 0 {
+		return ErrInvalidLength
+	}
+
+	if len(dst) != frames*p.fftLen {
+		return ErrLengthMismatch
+	}
+
+	for t0 := 0; t0 < frames; t0 += stftBatch {
+		count := min(stftBatch, frames-t0)
+
+		for b := range count {
+			stftFrame(p.complexBuf[b*p.fftLen:(b+1)*p.fftLen], signal, (t0+b)*p.hop-p.pad, p.winComplex, p.padding)
+		}
+
+		err := p.cplxPlan.ForwardBatch(dst[t0*p.fftLen:], p.complexBuf, count)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// InverseComplex reconstructs a complex signal of len(dst) samples from
+// its STFT by weighted overlap-add. spectrum must have length
+// Frames(len(dst))*FFTLen().
+func (p *PlanSTFT[F, C]) InverseComplex(dst, spectrum []C) error {
+	if dst == nil || spectrum == nil {
+		return ErrNilSlice
+	}
+
+	frames := p.Frames(len(dst))
+	if frames == 0 {
+		return ErrInvalidLength
+	}
+
+	if len(spectrum) != frames*p.fftLen {
+		return ErrLengthMismatch
+	}
+
+	clear(dst)
+
+	for t0 := 0; t0 < frames; t0 += stftBatch {
+		count := min(stftBatch, frames-t0)
+
+		err := p.cplxPlan.InverseBatch(p.complexBuf, spectrum[t0*p.fftLen:], count)
+		if err != nil {
+			return err
+		}
+
+		for b := range count {
+			stftOverlapAdd(dst, p.complexBuf[b*p.fftLen:], (t0+b)*p.hop-p.pad, p.winComplex)
+		}
+	}
+
+	for i := range dst {
+		norm := p.windowEnergy(i+p.pad, frames)
+		if norm > p.minNorm {
+			dst[i] /= C(complex(norm, 0))
+		} else {
+			dst[i] = 0
+		}
+	}
+
+	return nil
+}
+
+// windowEnergy returns the sum of the squared window over the frames that
+// cover position pos of the padded signal.
+func (p *PlanSTFT[F, C]) windowEnergy(pos, frames int) float64 {
+	first := 0
+	if pos >= p.frameLen {
+		first = (pos-p.frameLen)/p.hop + 1
+	}
+
+	last := min(frames-1, pos/p.hop)
+
+	var energy float64
+
+	for t := first; t <= last; t++ {
+		w := p.window[pos-t*p.hop]
+		energy += w * w
+	}
+
+	return energy
+}
+
+// stftFrame fills buf with the windowed frame of x starting at sample
+// start, which may lie outside x, extending x by the padding rule and
+// zero-padding buf past the window.
+func stftFrame[S Scalar](buf, x []S, start int, win []S, padding Boundary) {
+	n := len(x)
+
+	for j, w := range win {
+		i := start + j
+
+		switch {
+		case i >= 0 && i < n:
+			buf[j] = w * x[i]
+		case padding == BoundaryZero:
+			buf[j] = 0
+		default:
+			buf[j] = w * x[boundaryIndex(i, n, padding)]
+		}
+	}
+
+	clear(buf[len(win):])
+}
+
+// stftOverlapAdd adds the windowed frame starting at sample start to the
+// samples of dst it overlaps.
+func stftOverlapAdd[S Scalar](dst, frame []S, start int, win []S) {
+	lo := max(0, -start)
+	hi := min(len(win), len(dst)-start)
+
+	for j := lo; j < hi; j++ {
+		dst[start+j] += win[j] * frame[j]
+	}
+}
@@ -0,0 +1,307 @@
+package algofft
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+
+	"github.com/MeKo-Christian/algo-fft/window"
+)
+
+func TestSTFTRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(42))
+
+	tests := []struct {
+		name      string
+		opts      STFTOptions
+		signalLen int
+	}{
+		{"hann half overlap", STFTOptions{FrameLen: 64, Center: true}, 1000},
+		{"hann quarter hop reflect", STFTOptions{FrameLen: 64, Hop: 16, Center: true, Padding: BoundaryReflect}, 999},
+		{"wrap padding few frames", STFTOptions{FrameLen: 128, Hop: 48, Center: true, Padding: BoundaryWrap}, 300},
+		{"zero-padded fft", STFTOptions{FrameLen: 50, Hop: 20, FFTLen: 96, Center: true}, 777},
+		{"odd frame length", STFTOptions{FrameLen: 45, Hop: 15, Center: true}, 500},
+		{"uncentered hamming", STFTOptions{FrameLen: 32, Hop: 12, Window: window.Hamming(32, window.Symmetric)}, 401},
+		{"shorter than a frame", STFTOptions{FrameLen: 256, Hop: 64, Center: true, Padding: BoundaryReflect}, 100},
+	}
+
+	for _, tc := range tests {
+		stft, err := NewPlanSTFT[float64, complex128](tc.opts)
+		if err != nil {
+			t.Fatalf("%s: NewPlanSTFT() returned error: %v", tc.name, err)
+		}
+
+		signal := randomFloat64s(rng, tc.signalLen)
+		spec := make([]complex128, stft.Frames(tc.signalLen)*stft.Bins())
+
+		err = stft.Forward(spec, signal)
+		if err != nil {
+			t.Fatalf("%s: Forward() returned error: %v", tc.name, err)
+		}
+
+		got := make([]float64, tc.signalLen)
+
+		err = stft.Inverse(got, spec)
+		if err != nil {
+			t.Fatalf("%s: Inverse() returned error: %v", tc.name, err)
+		}
+
+		for i := range signal {
+			assertApproxFloat64(t, got[i], signal[i], 1e-10, "%s: sample %d", tc.name, i)
+		}
+	}
+}
+
+func TestSTFTFramesMatchDirectDFT(t *testing.T) {
+	t.Parallel()
+
+	const frameLen, hop, fftLen, signalLen = 20, 7, 24, 61
+
+	stft, err := NewPlanSTFT[float64, complex128](STFTOptions{
+		FrameLen: frameLen,
+		Hop:      hop,
+		FFTLen:   fftLen,
+		Center:   true,
+		Padding:  BoundaryReflect,
+	})
+	if err != nil {
+		t.Fatalf("NewPlanSTFT() returned error: %v", err)
+	}
+
+	signal := randomFloat64s(rand.New(rand.NewSource(7)), signalLen)
+	frames := stft.Frames(signalLen)
+
+	// Centred frames start FrameLen/2 samples early and the last one must
+	// reach the final sample.
+	if frames != 10 {
+		t.Fatalf("Frames(%d) = %d, want 10", signalLen, frames)
+	}
+
+	spec := make([]complex128, frames*stft.Bins())
+	if err := stft.Forward(spec, signal); err != nil {
+		t.Fatalf("Forward() returned error: %v", err)
+	}
+
+	w := window.Hann(frameLen, window.Periodic)
+
+	for frame := range frames {
+		for k := range stft.Bins() {
+			var want complex128
+
+			for j := range frameLen {
+				i := frame*hop - frameLen/2 + j
+				x := signal[boundaryIndex(i, signalLen, BoundaryReflect)]
+				want += complex(w[j]*x, 0) * cmplx.Exp(complex(0, -2*math.Pi*float64(k*j)/fftLen))
+			}
+
+			got := spec[frame*stft.Bins()+k]
+			if cmplx.Abs(got-want) > 1e-12 {
+				t.Fatalf("frame %d bin %d = %v, want %v", frame, k, got, want)
+			}
+		}
+	}
+}
+
+func TestSTFTComplexInput(t *testing.T) {
+	t.Parallel()
+
+	rng := rand.New(rand.NewSource(3))
+
+	stft, err := NewPlanSTFT[float32, complex64](STFTOptions{FrameLen: 64, Hop: 24, Center: true, Padding: BoundaryReflect})
+	if err != nil {
+		t.Fatalf("NewPlanSTFT() returned error: %v", err)
+	}
+
+	const signalLen = 700
+
+	signal := narrowComplex(randomComplex128s(rng, signalLen))
+	spec := make([]complex64, stft.Frames(signalLen)*stft.FFTLen())
+
+	if err := stft.ForwardComplex(spec, signal); err != nil {
+		t.Fatalf("ForwardComplex() returned error: %v", err)
+	}
+
+	got := make([]complex64, signalLen)
+	if err := stft.InverseComplex(got, spec); err != nil {
+		t.Fatalf("InverseComplex() returned error: %v", err)
+	}
+
+	for i := range signal {
+		if cmplx.Abs(complex128(got[i]-signal[i])) > 1e-5 {
+			t.Fatalf("sample %d = %v, want %v", i, got[i], signal[i])
+		}
+	}
+
+	// For a real signal, the complex transform holds the real one's bins
+	// and their conjugate mirror images.
+	realSignal := toFloat32(randomFloat64s(rng, signalLen))
+	promoted := make([]complex64, signalLen)
+
+	for i, v := range realSignal {
+		promoted[i] = complex(v, 0)
+	}
+
+	full := make([]complex64, stft.Frames(signalLen)*stft.FFTLen())
+	half := make([]complex64, stft.Frames(signalLen)*stft.Bins())
+
+	if err := stft.ForwardComplex(full, promoted); err != nil {
+		t.Fatalf("ForwardComplex() returned error: %v", err)
+	}
+
+	if err := stft.Forward(half, realSignal); err != nil {
+		t.Fatalf("Forward() returned error: %v", err)
+	}
+
+	for frame := range stft.Frames(signalLen) {
+		for k := range stft.Bins() {
+			got := full[frame*stft.FFTLen()+k]
+			want := half[frame*stft.Bins()+k]
+
+			if cmplx.Abs(complex128(got-want)) > 1e-4 {
+				t.Fatalf("frame %d bin %d = %v, want %v", frame, k, got, want)
+			}
+		}
+	}
+}
+
+func TestSTFTInverseOfModifiedSpectrum(t *testing.T) {
+	t.Parallel()
+
+	stft, err := NewPlanSTFT[float64, complex128](STFTOptions{FrameLen: 32, Hop: 8, Center: true})
+	if err != nil {
+		t.Fatalf("NewPlanSTFT() returned error: %v", err)
+	}
+
+	const signalLen = 256
+
+	signal := randomFloat64s(rand.New(rand.NewSource(11)), signalLen)
+	spec := make([]complex128, stft.Frames(signalLen)*stft.Bins())
+
+	if err := stft.Forward(spec, signal); err != nil {
+		t.Fatalf("Forward() returned error: %v", err)
+	}
+
+	// Scaling every bin by 2 doubles the signal; a stray imaginary part
+	// on the DC and Nyquist bins is ignored rather than rejected.
+	for i := range spec {
+		spec[i] *= 2
+	}
+
+	spec[0] += 1i
+	spec[stft.Bins()-1] -= 1i
+
+	got := make([]float64, signalLen)
+	if err := stft.Inverse(got, spec); err != nil {
+		t.Fatalf("Inverse() returned error: %v", err)
+	}
+
+	for i := stft.FrameLen(); i < signalLen; i++ {
+		assertApproxFloat64(t, got[i], 2*signal[i], 1e-10, "sample %d", i)
+	}
+}
+
+func TestSTFTOverlapAddConditions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts STFTOptions
+		cola bool
+	}{
+		{"hann half overlap", STFTOptions{FrameLen: 64}, true},
+		{"hann three-eighths hop", STFTOptions{FrameLen: 64, Hop: 24}, false},
+		{"blackman half overlap", STFTOptions{FrameLen: 60, Window: window.Blackman(60, window.Periodic)}, false},
+		{"blackman third overlap", STFTOptions{FrameLen: 60, Hop: 20, Window: window.Blackman(60, window.Periodic)}, true},
+		{"rectangular no overlap", STFTOptions{FrameLen: 16, Hop: 16, Window: window.Rectangular(16)}, true},
+	}
+
+	for _, tc := range tests {
+		stft, err := NewPlanSTFT[float32, complex64](tc.opts)
+		if err != nil {
+			t.Fatalf("%s: NewPlanSTFT() returned error: %v", tc.name, err)
+		}
+
+		if stft.COLA() != tc.cola {
+			t.Fatalf("%s: COLA() = %v, want %v", tc.name, stft.COLA(), tc.cola)
+		}
+	}
+
+	// A symmetric Hann window is zero at both ends, so with a hop of
+	// FrameLen-1 one sample per hop is never seen; with gaps between the
+	// frames nothing covers them at all.
+	violations := []STFTOptions{
+		{FrameLen: 32, Hop: 31, Window: window.Hann(32, window.Symmetric)},
+		{FrameLen: 16, Hop: 17, Window: window.Rectangular(16)},
+	}
+
+	for _, opts := range violations {
+		_, err := NewPlanSTFT[float32, complex64](opts)
+		if !errors.Is(err, ErrNotInvertible) {
+			t.Fatalf("hop %d: error = %v, want ErrNotInvertible", opts.Hop, err)
+		}
+	}
+}
+
+func TestSTFTErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		opts STFTOptions
+		want error
+	}{
+		{"zero frame length", STFTOptions{}, ErrInvalidLength},
+		{"negative hop", STFTOptions{FrameLen: 16, Hop: -1}, ErrInvalidParameter},
+		{"short fft", STFTOptions{FrameLen: 16, FFTLen: 8}, ErrInvalidLength},
+		{"odd fft", STFTOptions{FrameLen: 16, FFTLen: 17}, ErrInvalidLength},
+		{"window length", STFTOptions{FrameLen: 16, Window: make([]float64, 15)}, ErrLengthMismatch},
+		{"padding", STFTOptions{FrameLen: 16, Padding: Boundary(9)}, ErrInvalidMode},
+	}
+
+	for _, tc := range tests {
+		_, err := NewPlanSTFT[float64, complex128](tc.opts)
+		if !errors.Is(err, tc.want) {
+			t.Fatalf("%s: error = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+
+	stft, err := NewPlanSTFT[float64, complex128](STFTOptions{FrameLen: 16})
+	if err != nil {
+		t.Fatalf("NewPlanSTFT() returned error: %v", err)
+	}
+
+	if err := stft.Forward(nil, []float64{1}); !errors.Is(err, ErrNilSlice) {
+		t.Fatalf("nil dst error = %v, want ErrNilSlice", err)
+	}
+
+	if err := stft.Forward([]complex128{}, []float64{}); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("empty signal error = %v, want ErrInvalidLength", err)
+	}
+
+	if err := stft.Forward(make([]complex128, 8), make([]float64, 40)); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("short dst error = %v, want ErrLengthMismatch", err)
+	}
+
+	if err := stft.InverseComplex(make([]complex128, 40), make([]complex128, 9)); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("short spectrum error = %v, want ErrLengthMismatch", err)
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestSTFTNoAllocs(t *testing.T) {
+	stft, err := NewPlanSTFT[float32, complex64](STFTOptions{FrameLen: 256, Hop: 64, Center: true, Padding: BoundaryReflect})
+	if err != nil {
+		t.Fatalf("NewPlanSTFT() returned error: %v", err)
+	}
+
+	signal := toFloat32(randomFloat64s(rand.New(rand.NewSource(5)), 4000))
+	spec := make([]complex64, stft.Frames(len(signal))*stft.Bins())
+	out := make([]float32, len(signal))
+
+	assertNoAllocs(t, "Forward", func() error { return stft.Forward(spec, signal) })
+	assertNoAllocs(t, "Inverse", func() error { return stft.Inverse(out, spec) })
+}
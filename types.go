@@ -9,3 +9,8 @@ type Complex = fftypes.Complex
 // Float is a type constraint for floating-point types used in real FFT operations.
 // The canonical definition is in internal/fftypes.
 type Float = fftypes.Float
+
+// Scalar is a type constraint for real or complex element types used by
+// transforms without twiddle factors (e.g., PlanWHT).
+// The canonical definition is in internal/fftypes.
+type Scalar = fftypes.Scalar
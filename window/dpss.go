@@ -0,0 +1,388 @@
+package window
+
+import (
+	"math"
+	"math/bits"
+)
+
+// DPSS returns the zeroth-order discrete prolate spheroidal (Slepian)
+// sequence of length n with time-half-bandwidth product nw, the window that
+// concentrates the most energy in the band |f| <= nw/n. It is normalized to
+// a peak of 1, with scipy.signal.windows.dpss's "approximate" correction for
+// even lengths, whose true peak falls between two samples.
+//
+// nw must satisfy 0 < nw < n/2. n < 1 returns ErrInvalidLength.
+func DPSS(n int, nw float64, sym Symmetry) ([]float64, error) {
+	if n < 1 {
+		return nil, ErrInvalidLength
+	}
+
+	if n == 1 {
+		return Rectangular(n), nil
+	}
+
+	m := n
+	if sym == Periodic {
+		m = n + 1
+	}
+
+	tapers, _, err := DPSSTapers(m, nw, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	w := tapers[0]
+
+	peak := 0.0
+	for _, v := range w {
+		peak = max(peak, v)
+	}
+
+	scale := 1 / peak
+	if m%2 == 0 {
+		scale *= float64(m*m) / (float64(m*m) + nw)
+	}
+
+	for i := range w {
+		w[i] *= scale
+	}
+
+	return w[:n:n], nil
+}
+
+// DPSSTapers returns the first k discrete prolate spheroidal sequences of
+// length n with time-half-bandwidth product nw, for multitaper spectral
+// estimation, together with their concentration ratios: the fraction of
+// each taper's energy inside the band |f| <= nw/n, in decreasing order.
+//
+// The tapers are symmetric, have unit energy and follow the sign
+// convention of Percival and Walden: even-order tapers have a positive
+// mean and odd-order tapers start with a positive lobe. Only about 2·nw-1
+// tapers have ratios close to 1.
+//
+// nw must satisfy 0 < nw < n/2, and 1 <= k <= n.
+func DPSSTapers(n int, nw float64, k int) ([][]float64, []float64, error) {
+	if n < 1 || k < 1 || k > n {
+		return nil, nil, ErrInvalidLength
+	}
+
+	if !(nw > 0) || nw >= float64(n)/2 {
+		return nil, nil, ErrInvalidParameter
+	}
+
+	bandwidth := nw / float64(n)
+
+	// The tapers are the eigenvectors, for the largest eigenvalues, of the
+	// symmetric tridiagonal matrix that commutes with the time-and-band
+	// limiting operator (Slepian 1978).
+	diag := make([]float64, n)
+	off := make([]float64, n) // off[i] couples rows i-1 and i
+
+	cosW := math.Cos(2 * math.Pi * bandwidth)
+
+	for i := range n {
+		c := float64(n-1-2*i) / 2
+		diag[i] = c * c * cosW
+		off[i] = float64(i) * float64(n-i) / 2
+	}
+
+	tri := newTridiagonal(diag, off)
+	tapers := make([][]float64, k)
+	ratios := make([]float64, k)
+	spectrum := make([]complex128, 1<<bits.Len(uint(2*n-1)))
+	fft := newRadix2FFT(len(spectrum))
+
+	for order := range k {
+		lambda := tri.eigenvalue(n - 1 - order)
+		v := tri.eigenvector(lambda, tapers[:order])
+
+		fixDPSSSign(v, order)
+
+		tapers[order] = v
+		ratios[order] = concentration(v, bandwidth, spectrum, fft)
+	}
+
+	return tapers, ratios, nil
+}
+
+// fixDPSSSign applies the sign convention of Percival and Walden (1993,
+// p. 379).
+func fixDPSSSign(v []float64, order int) {
+	flip := false
+
+	if order%2 == 0 {
+		var sum float64
+		for _, x := range v {
+			sum += x
+		}
+
+		flip = sum < 0
+	} else {
+		thresh := max(1e-7, 1/float64(len(v)))
+
+		for _, x := range v {
+			if x*x > thresh {
+				flip = x < 0
+
+				break
+			}
+		}
+	}
+
+	if flip {
+		for i := range v {
+			v[i] = -v[i]
+		}
+	}
+}
+
+// concentration returns the fraction of the energy of the unit-energy
+// sequence v inside |f| <= w: vᵀAv with A[i][j] = sin(2πw(i-j))/(π(i-j)),
+// which is Σ A(lag)·r[lag] over the autocorrelation r of v. The
+// autocorrelation comes from the power spectrum in spectrum, a power of
+// two of at least 2·len(v) samples transformed by fft, so the cost is
+// O(n log n).
+func concentration(v []float64, w float64, spectrum []complex128, fft *radix2FFT) float64 {
+	n := len(v)
+
+	clear(spectrum)
+
+	for i, x := range v {
+		spectrum[i] = complex(x, 0)
+	}
+
+	fft.forward(spectrum)
+
+	for i, c := range spectrum {
+		spectrum[i] = complex(real(c)*real(c)+imag(c)*imag(c), 0)
+	}
+
+	// The power spectrum is real and even, so its forward transform is
+	// len(spectrum) times the autocorrelation.
+	fft.forward(spectrum)
+
+	scale := 1 / float64(len(spectrum))
+	ratio := 0.0
+
+	for lag := range n {
+		r := real(spectrum[lag]) * scale
+
+		if lag == 0 {
+			ratio += 2 * w * r
+		} else {
+			ratio += 2 * r * math.Sin(2*math.Pi*w*float64(lag)) / (math.Pi * float64(lag))
+		}
+	}
+
+	return ratio
+}
+
+// tridiagonal is a symmetric tridiagonal matrix with diagonal d and
+// off-diagonal e, where e[i] couples rows i-1 and i (e[0] is unused).
+type tridiagonal struct {
+	d, e       []float64
+	lower      float64
+	upper      float64
+	tolerance  float64
+	scratchDl  []float64
+	scratchD   []float64
+	scratchDu  []float64
+	scratchDu2 []float64
+	pivot      []bool
+}
+
+func newTridiagonal(d, e []float64) *tridiagonal {
+	n := len(d)
+	t := &tridiagonal{
+		d:          d,
+		e:          e,
+		lower:      math.Inf(1),
+		upper:      math.Inf(-1),
+		scratchDl:  make([]float64, n),
+		scratchD:   make([]float64, n),
+		scratchDu:  make([]float64, n),
+		scratchDu2: make([]float64, n),
+		pivot:      make([]bool, n),
+	}
+
+	// Gershgorin bounds.
+	for i := range n {
+		radius := 0.0
+		if i > 0 {
+			radius += math.Abs(e[i])
+		}
+
+		if i+1 < n {
+			radius += math.Abs(e[i+1])
+		}
+
+		t.lower = min(t.lower, d[i]-radius)
+		t.upper = max(t.upper, d[i]+radius)
+	}
+
+	t.tolerance = 2 * 0x1p-52 * max(math.Abs(t.lower), math.Abs(t.upper), 1)
+
+	return t
+}
+
+// countBelow returns the number of eigenvalues smaller than x (Sturm
+// sequence count of the LDLᵀ factorization of T - xI).
+func (t *tridiagonal) countBelow(x float64) int {
+	count := 0
+	q := 1.0
+
+	for i := range t.d {
+		if i == 0 {
+			q = t.d[0] - x
+		} else {
+			q = t.d[i] - x - t.e[i]*t.e[i]/q
+		}
+
+		if q == 0 {
+			q = -t.tolerance
+		}
+
+		if q < 0 {
+			count++
+		}
+	}
+
+	return count
+}
+
+// eigenvalue returns the index-th smallest eigenvalue by bisection.
+func (t *tridiagonal) eigenvalue(index int) float64 {
+	lo, hi := t.lower, t.upper
+
+	for hi-lo > t.tolerance {
+		mid := lo + (hi-lo)/2
+		if mid <= lo || mid >= hi {
+			break
+		}
+
+		if t.countBelow(mid) > index {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+
+	return lo + (hi-lo)/2
+}
+
+// eigenvector returns the unit eigenvector for lambda by inverse iteration,
+// kept orthogonal to the previously computed vectors.
+func (t *tridiagonal) eigenvector(lambda float64, previous [][]float64) []float64 {
+	n := len(t.d)
+	v := make([]float64, n)
+
+	// A start vector with both symmetric and antisymmetric components.
+	for i := range v {
+		v[i] = 1 + float64(i)/float64(n)
+	}
+
+	t.factor(lambda)
+
+	for range 3 {
+		t.solve(v)
+
+		for _, p := range previous {
+			var dot float64
+			for i := range v {
+				dot += v[i] * p[i]
+			}
+
+			for i := range v {
+				v[i] -= dot * p[i]
+			}
+		}
+
+		var norm float64
+		for _, x := range v {
+			norm += x * x
+		}
+
+		norm = math.Sqrt(norm)
+		for i := range v {
+			v[i] /= norm
+		}
+	}
+
+	return v
+}
+
+// factor computes the LU factorization with partial pivoting of
+// T - lambda·I (LAPACK dgttrf).
+func (t *tridiagonal) factor(lambda float64) {
+	n := len(t.d)
+	dl, d, du, du2 := t.scratchDl, t.scratchD, t.scratchDu, t.scratchDu2
+
+	for i := range n {
+		d[i] = t.d[i] - lambda
+		du2[i] = 0
+
+		if i+1 < n {
+			dl[i] = t.e[i+1]
+			du[i] = t.e[i+1]
+		}
+	}
+
+	for i := range n - 1 {
+		if math.Abs(d[i]) >= math.Abs(dl[i]) {
+			t.pivot[i] = false
+
+			if d[i] == 0 {
+				d[i] = t.tolerance
+			}
+
+			fact := dl[i] / d[i]
+			dl[i] = fact
+			d[i+1] -= fact * du[i]
+		} else {
+			t.pivot[i] = true
+
+			fact := d[i] / dl[i]
+			d[i] = dl[i]
+			dl[i] = fact
+			temp := du[i]
+			du[i] = d[i+1]
+			d[i+1] = temp - fact*d[i+1]
+
+			if i+2 < n {
+				du2[i] = du[i+1]
+				du[i+1] = -fact * du[i+1]
+			}
+		}
+	}
+
+	if d[n-1] == 0 {
+		d[n-1] = t.tolerance
+	}
+}
+
+// solve overwrites b with (T - lambda·I)⁻¹·b using the factorization
+// (LAPACK dgtts2).
+func (t *tridiagonal) solve(b []float64) {
+	n := len(b)
+	dl, d, du, du2 := t.scratchDl, t.scratchD, t.scratchDu, t.scratchDu2
+
+	for i := range n - 1 {
+		if t.pivot[i] {
+			temp := b[i]
+			b[i] = b[i+1]
+			b[i+1] = temp - dl[i]*b[i]
+		} else {
+			b[i+1] -= dl[i] * b[i]
+		}
+	}
+
+	b[n-1] /= d[n-1]
+
+	if n > 1 {
+		b[n-2] = (b[n-2] - du[n-2]*b[n-1]) / d[n-2]
+	}
+
+	for i := n - 3; i >= 0; i-- {
+		b[i] = (b[i] - du[i]*b[i+1] - du2[i]*b[i+2]) / d[i]
+	}
+}
@@ -0,0 +1,156 @@
+package window
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestDPSSTapersAreOrthonormalEigenvectors(t *testing.T) {
+	t.Parallel()
+
+	const n, nw, k = 128, 4.0, 10
+
+	tapers, ratios, err := DPSSTapers(n, nw, k)
+	if err != nil {
+		t.Fatalf("DPSSTapers() returned error: %v", err)
+	}
+
+	for a := range k {
+		for b := range k {
+			var dot float64
+			for i := range n {
+				dot += tapers[a][i] * tapers[b][i]
+			}
+
+			want := 0.0
+			if a == b {
+				want = 1
+			}
+
+			assertClose(t, dot, want, 1e-9, "<taper %d, taper %d>", a, b)
+		}
+	}
+
+	// Each taper satisfies the Slepian eigen equation: time- then
+	// band-limiting returns the taper scaled by its concentration.
+	w := nw / n
+
+	for order, v := range tapers[:3] {
+		for i := 0; i < n; i += 17 {
+			av := 2 * w * v[i]
+
+			for j := range n {
+				if j != i {
+					d := float64(i - j)
+					av += math.Sin(2*math.Pi*w*d) / (math.Pi * d) * v[j]
+				}
+			}
+
+			assertClose(t, av, ratios[order]*v[i], 1e-9, "taper %d eigen equation [%d]", order, i)
+		}
+	}
+}
+
+func TestDPSSConcentrationAndSigns(t *testing.T) {
+	t.Parallel()
+
+	const n, nw = 200, 3.0
+
+	tapers, ratios, err := DPSSTapers(n, nw, 8)
+	if err != nil {
+		t.Fatalf("DPSSTapers() returned error: %v", err)
+	}
+
+	for order, r := range ratios {
+		if order > 0 && r >= ratios[order-1] {
+			t.Fatalf("ratios not decreasing: %v", ratios)
+		}
+
+		if order < 2*nw-1 && r < 0.9 {
+			t.Fatalf("ratio[%d] = %v, want > 0.9 for the first 2NW-1 tapers", order, r)
+		}
+	}
+
+	if ratios[7] > 0.5 {
+		t.Fatalf("ratio[7] = %v, want < 0.5 beyond 2NW", ratios[7])
+	}
+
+	for order, v := range tapers {
+		parity := 1.0
+		if order%2 == 1 {
+			parity = -1
+		}
+
+		for i := range n {
+			assertClose(t, v[i], parity*v[n-1-i], 1e-9, "taper %d parity [%d]", order, i)
+		}
+
+		var sum float64
+		for _, x := range v {
+			sum += x
+		}
+
+		if order%2 == 0 && sum <= 0 {
+			t.Fatalf("taper %d has non-positive mean", order)
+		}
+	}
+
+	// Odd tapers start with a positive lobe.
+	for i, x := range tapers[1] {
+		if x*x > 1.0/n {
+			if x < 0 {
+				t.Fatalf("taper 1 starts with a negative lobe at %d", i)
+			}
+
+			break
+		}
+	}
+}
+
+func TestDPSSWindowNormalization(t *testing.T) {
+	t.Parallel()
+
+	odd, err := DPSS(51, 2, Symmetric)
+	if err != nil {
+		t.Fatalf("DPSS(51) returned error: %v", err)
+	}
+
+	assertClose(t, odd[25], 1, 1e-12, "odd-length peak")
+
+	even, err := DPSS(50, 2, Symmetric)
+	if err != nil {
+		t.Fatalf("DPSS(50) returned error: %v", err)
+	}
+
+	assertClose(t, even[24], 2500.0/2502, 1e-12, "even-length peak with approximate correction")
+
+	p := Analyze(even)
+	if p.SidelobeLevel > -40 {
+		t.Fatalf("DPSS sidelobe level = %v dB, want < -40 dB", p.SidelobeLevel)
+	}
+}
+
+func TestDPSSErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, _, err := DPSSTapers(0, 1, 1); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("n=0 error = %v, want ErrInvalidLength", err)
+	}
+
+	if _, _, err := DPSSTapers(16, 1, 17); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("k>n error = %v, want ErrInvalidLength", err)
+	}
+
+	if _, _, err := DPSSTapers(16, 8, 1); !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("nw=n/2 error = %v, want ErrInvalidParameter", err)
+	}
+
+	if _, err := DPSS(16, 0, Periodic); !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("nw=0 error = %v, want ErrInvalidParameter", err)
+	}
+
+	if _, err := DPSS(0, 1, Symmetric); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("n=0 error = %v, want ErrInvalidLength", err)
+	}
+}
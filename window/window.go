@@ -0,0 +1,258 @@
+// Package window provides the window functions used for spectral analysis
+// with algofft, together with their spectral figures of merit.
+//
+// Every generator returns float64 coefficients in either symmetric form,
+// for filter design, or periodic (DFT-even) form, for spectral analysis
+// with plans of the same length. The coefficient formulas and the
+// symmetric/periodic convention follow scipy.signal.windows: the periodic
+// window of length n is the symmetric window of length n+1 without its last
+// sample.
+//
+// Generators without an error result return an empty slice for n <= 0, as
+// scipy does; Gaussian, DPSS and DPSSTapers return ErrInvalidLength.
+//
+// Example:
+//
+//	w := window.Hann(1024, window.Periodic)
+//	err := window.ApplyInPlace(frame, w)
+//	err = plan.Forward(spectrum, frame)
+package window
+
+import (
+	"errors"
+	"math"
+
+	"github.com/MeKo-Christian/algo-fft/internal/fftypes"
+)
+
+// Sentinel errors returned by the window package.
+var (
+	// ErrInvalidLength is returned by the generators with an error result
+	// when a length or taper count is not positive.
+	ErrInvalidLength = errors.New("algo-fft/window: invalid window length")
+
+	// ErrInvalidParameter is returned when a shape parameter is out of range,
+	// such as a non-positive Gaussian standard deviation.
+	ErrInvalidParameter = errors.New("algo-fft/window: invalid window parameter")
+
+	// ErrLengthMismatch is returned when data and window lengths differ.
+	ErrLengthMismatch = errors.New("algo-fft/window: length mismatch")
+)
+
+// Symmetry selects between the two sampling conventions of a window.
+type Symmetry uint8
+
+const (
+	// Symmetric windows satisfy w[i] == w[n-1-i]. Use them for FIR filter
+	// design.
+	Symmetric Symmetry = iota
+
+	// Periodic (DFT-even) windows are one period of an n-periodic sequence.
+	// Use them for spectral analysis, STFT and Welch averaging.
+	Periodic
+)
+
+// String returns the name of the symmetry.
+func (s Symmetry) String() string {
+	switch s {
+	case Symmetric:
+		return "symmetric"
+	case Periodic:
+		return "periodic"
+	default:
+		return "unknown"
+	}
+}
+
+// Cosine-sum coefficients a_k of w = Σ (-1)^k a_k cos(2πk·i/(n-1)).
+var (
+	hannCoeffs           = []float64{0.5, 0.5}
+	hammingCoeffs        = []float64{0.54, 0.46}
+	blackmanCoeffs       = []float64{0.42, 0.50, 0.08}
+	blackmanHarrisCoeffs = []float64{0.35875, 0.48829, 0.14128, 0.01168}
+	nuttallCoeffs        = []float64{0.3635819, 0.4891775, 0.1365995, 0.0106411}
+	flatTopCoeffs        = []float64{0.21557895, 0.41663158, 0.277263158, 0.083578947, 0.006947368}
+)
+
+// Rectangular returns n ones. Both symmetries coincide.
+func Rectangular(n int) []float64 {
+	w := make([]float64, max(n, 0))
+	for i := range w {
+		w[i] = 1
+	}
+
+	return w
+}
+
+// Hann returns the Hann window 0.5 - 0.5·cos(2πi/(n-1)).
+func Hann(n int, sym Symmetry) []float64 {
+	return cosineSum(n, sym, hannCoeffs)
+}
+
+// Hamming returns the Hamming window 0.54 - 0.46·cos(2πi/(n-1)).
+func Hamming(n int, sym Symmetry) []float64 {
+	return cosineSum(n, sym, hammingCoeffs)
+}
+
+// Blackman returns the 3-term Blackman window.
+func Blackman(n int, sym Symmetry) []float64 {
+	return cosineSum(n, sym, blackmanCoeffs)
+}
+
+// BlackmanHarris returns the minimum 4-term Blackman-Harris window
+// (-92 dB sidelobes).
+func BlackmanHarris(n int, sym Symmetry) []float64 {
+	return cosineSum(n, sym, blackmanHarrisCoeffs)
+}
+
+// Nuttall returns Nuttall's minimum 4-term Blackman-Harris window, also
+// known as Blackman-Nuttall (-98 dB sidelobes).
+func Nuttall(n int, sym Symmetry) []float64 {
+	return cosineSum(n, sym, nuttallCoeffs)
+}
+
+// FlatTop returns the 5-term flat-top window, whose scalloping loss
+// is nearly zero, for measuring sinusoid amplitudes from FFT bins.
+func FlatTop(n int, sym Symmetry) []float64 {
+	return cosineSum(n, sym, flatTopCoeffs)
+}
+
+// Kaiser returns the Kaiser window I0(β·sqrt(1-x²))/I0(β) with x
+// running from -1 to 1. β = 0 is rectangular; β ≈ 8.6 resembles Blackman.
+func Kaiser(n int, beta float64, sym Symmetry) []float64 {
+	return generate(n, sym, func(w []float64) {
+		m := len(w)
+		alpha := float64(m-1) / 2
+		norm := besselI0(beta)
+
+		for i := range w {
+			x := (float64(i) - alpha) / alpha
+			w[i] = besselI0(beta*math.Sqrt(max(0, 1-x*x))) / norm
+		}
+	})
+}
+
+// Tukey returns the tapered cosine window whose first and last
+// α/2 fractions are half Hann tapers. α <= 0 gives the rectangular window
+// and α >= 1 the Hann window.
+func Tukey(n int, alpha float64, sym Symmetry) []float64 {
+	if alpha <= 0 {
+		return Rectangular(n)
+	}
+
+	if alpha >= 1 {
+		return Hann(n, sym)
+	}
+
+	return generate(n, sym, func(w []float64) {
+		m := len(w)
+		span := float64(m - 1)
+		width := int(math.Floor(alpha * span / 2))
+
+		for i := range w {
+			x := float64(i)
+
+			switch {
+			case i <= width:
+				w[i] = 0.5 * (1 + math.Cos(math.Pi*(-1+2*x/alpha/span)))
+			case i >= m-width-1:
+				w[i] = 0.5 * (1 + math.Cos(math.Pi*(-2/alpha+1+2*x/alpha/span)))
+			default:
+				w[i] = 1
+			}
+		}
+	})
+}
+
+// Gaussian returns exp(-x²/(2σ²)) with x measured in samples from
+// the window centre. n must be positive and std must be positive.
+func Gaussian(n int, std float64, sym Symmetry) ([]float64, error) {
+	if n < 1 {
+		return nil, ErrInvalidLength
+	}
+
+	if !(std > 0) {
+		return nil, ErrInvalidParameter
+	}
+
+	return generate(n, sym, func(w []float64) {
+		centre := float64(len(w)-1) / 2
+
+		for i := range w {
+			x := float64(i) - centre
+			w[i] = math.Exp(-x * x / (2 * std * std))
+		}
+	}), nil
+}
+
+// ApplyInPlace multiplies data by the window coefficients w. It does not
+// allocate.
+func ApplyInPlace[F fftypes.Float](data []F, w []float64) error {
+	if len(data) != len(w) {
+		return ErrLengthMismatch
+	}
+
+	for i, v := range w {
+		data[i] *= F(v)
+	}
+
+	return nil
+}
+
+// cosineSum evaluates a generalized cosine window with coefficients a.
+func cosineSum(n int, sym Symmetry, a []float64) []float64 {
+	return generate(n, sym, func(w []float64) {
+		m := len(w)
+
+		for i := range w {
+			phase := 2 * math.Pi * float64(i) / float64(m-1)
+			sign := 1.0
+
+			var sum float64
+
+			for k, ak := range a {
+				sum += sign * ak * math.Cos(float64(k)*phase)
+				sign = -sign
+			}
+
+			w[i] = sum
+		}
+	})
+}
+
+// generate allocates a length-n window and lets fill compute the symmetric
+// window of the sampling length: n for Symmetric, n+1 for Periodic, whose
+// last sample is then dropped. Lengths 0 and 1 need no formula.
+func generate(n int, sym Symmetry, fill func(w []float64)) []float64 {
+	if n <= 1 {
+		return Rectangular(n)
+	}
+
+	if sym == Periodic {
+		w := make([]float64, n+1)
+		fill(w)
+
+		return w[:n:n]
+	}
+
+	w := make([]float64, n)
+	fill(w)
+
+	return w
+}
+
+// besselI0 evaluates the modified Bessel function of the first kind of
+// order zero by its power series, which converges for all x.
+func besselI0(x float64) float64 {
+	half := x / 2
+	term := 1.0
+	sum := 1.0
+
+	for k := 1; term > sum*1e-17; k++ {
+		f := half / float64(k)
+		term *= f * f
+		sum += term
+	}
+
+	return sum
+}
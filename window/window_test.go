@@ -0,0 +1,216 @@
+package window
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func assertClose(t *testing.T, got, want, tol float64, format string, args ...any) {
+	t.Helper()
+
+	if math.Abs(got-want) > tol {
+		t.Fatalf(format+": got %v want %v", append(args, got, want)...)
+	}
+}
+
+func TestWindowKnownValues(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		got  []float64
+		want []float64
+	}{
+		{"hann symmetric", Hann(5, Symmetric), []float64{0, 0.5, 1, 0.5, 0}},
+		{"hann periodic", Hann(4, Periodic), []float64{0, 0.5, 1, 0.5}},
+		{"hamming symmetric", Hamming(3, Symmetric), []float64{0.08, 1, 0.08}},
+		{"blackman symmetric", Blackman(3, Symmetric), []float64{0, 1, 0}},
+		{"kaiser beta 0", Kaiser(4, 0, Symmetric), []float64{1, 1, 1, 1}},
+		{"kaiser beta 1", Kaiser(3, 1, Symmetric), []float64{1 / 1.2660658777520082, 1, 1 / 1.2660658777520082}},
+		{"tukey 0.5", Tukey(9, 0.5, Symmetric), []float64{0, 0.5, 1, 1, 1, 1, 1, 0.5, 0}},
+		{"tukey 0", Tukey(3, 0, Periodic), []float64{1, 1, 1}},
+		{"length 1", FlatTop(1, Symmetric), []float64{1}},
+		{"length 0", Hann(0, Periodic), []float64{}},
+	}
+
+	for _, tc := range tests {
+		if len(tc.got) != len(tc.want) {
+			t.Fatalf("%s: len = %d, want %d", tc.name, len(tc.got), len(tc.want))
+		}
+
+		for i := range tc.want {
+			assertClose(t, tc.got[i], tc.want[i], 1e-12, "%s [%d]", tc.name, i)
+		}
+	}
+
+	gauss, err := Gaussian(5, 1, Symmetric)
+	if err != nil {
+		t.Fatalf("Gaussian() returned error: %v", err)
+	}
+
+	for i, want := range []float64{math.Exp(-2), math.Exp(-0.5), 1, math.Exp(-0.5), math.Exp(-2)} {
+		assertClose(t, gauss[i], want, 1e-15, "gaussian [%d]", i)
+	}
+}
+
+func TestWindowSymmetryConventions(t *testing.T) {
+	t.Parallel()
+
+	generators := map[string]func(n int, sym Symmetry) []float64{
+		"hann":           Hann,
+		"hamming":        Hamming,
+		"blackman":       Blackman,
+		"blackmanharris": BlackmanHarris,
+		"nuttall":        Nuttall,
+		"flattop":        FlatTop,
+		"kaiser":         func(n int, sym Symmetry) []float64 { return Kaiser(n, 6, sym) },
+		"tukey":          func(n int, sym Symmetry) []float64 { return Tukey(n, 0.3, sym) },
+		"gaussian": func(n int, sym Symmetry) []float64 {
+			w, _ := Gaussian(n, 3, sym)
+			return w
+		},
+		"dpss": func(n int, sym Symmetry) []float64 {
+			w, _ := DPSS(n, 2.5, sym)
+			return w
+		},
+	}
+
+	for name, gen := range generators {
+		for _, n := range []int{16, 33} {
+			sym := gen(n, Symmetric)
+			for i := range sym {
+				assertClose(t, sym[i], sym[n-1-i], 1e-12, "%s n=%d symmetric [%d]", name, n, i)
+			}
+
+			// The periodic window is the symmetric window one sample longer,
+			// truncated.
+			periodic := gen(n, Periodic)
+			longer := gen(n+1, Symmetric)
+
+			if len(periodic) != n {
+				t.Fatalf("%s: periodic len = %d, want %d", name, len(periodic), n)
+			}
+
+			for i := range periodic {
+				assertClose(t, periodic[i], longer[i], 1e-12, "%s n=%d periodic [%d]", name, n, i)
+			}
+		}
+	}
+}
+
+func TestAnalyzeMatchesHarrisTable(t *testing.T) {
+	t.Parallel()
+
+	const n = 1024
+
+	// Coherent gain, ENBW (bins), scalloping loss (dB) and highest sidelobe
+	// (dB) from Harris (1978) and the definitions of the coefficients.
+	tests := []struct {
+		name                   string
+		w                      []float64
+		gain, enbw, scallop    float64
+		sidelobeLo, sidelobeHi float64
+	}{
+		{"rectangular", Rectangular(n), 1, 1, 3.92, -13.3, -13.2},
+		{"hann", Hann(n, Periodic), 0.5, 1.5, 1.42, -31.6, -31.4},
+		{"hamming", Hamming(n, Periodic), 0.54, 1.36, 1.75, -43, -42.5},
+		{"blackman", Blackman(n, Periodic), 0.42, 1.73, 1.10, -58.3, -58},
+		{"blackmanharris", BlackmanHarris(n, Periodic), 0.36, 2.00, 0.83, -92.2, -91.9},
+		{"flattop", FlatTop(n, Periodic), 0.22, 3.77, 0.01, -94, -92},
+	}
+
+	for _, tc := range tests {
+		p := Analyze(tc.w)
+
+		assertClose(t, p.CoherentGain, tc.gain, 0.005, "%s coherent gain", tc.name)
+		assertClose(t, p.ENBW, tc.enbw, 0.005, "%s ENBW", tc.name)
+		assertClose(t, p.ScallopingLoss, tc.scallop, 0.01, "%s scalloping loss", tc.name)
+
+		if p.SidelobeLevel < tc.sidelobeLo || p.SidelobeLevel > tc.sidelobeHi {
+			t.Fatalf("%s sidelobe level = %v dB, want in [%v, %v]", tc.name, p.SidelobeLevel, tc.sidelobeLo, tc.sidelobeHi)
+		}
+	}
+
+	if p := Analyze([]float64{1}); !math.IsInf(p.SidelobeLevel, -1) {
+		t.Fatalf("length-1 sidelobe level = %v, want -Inf", p.SidelobeLevel)
+	}
+
+	if p := Analyze(nil); p != (Properties{}) {
+		t.Fatalf("Analyze(nil) = %+v, want zero", p)
+	}
+}
+
+func TestKaiserBetaTradesSidelobesForWidth(t *testing.T) {
+	t.Parallel()
+
+	prev := Analyze(Kaiser(512, 2, Periodic))
+
+	for _, beta := range []float64{5, 8.6, 14} {
+		p := Analyze(Kaiser(512, beta, Periodic))
+		if p.SidelobeLevel >= prev.SidelobeLevel || p.ENBW <= prev.ENBW {
+			t.Fatalf("beta=%v: sidelobe %v dB, ENBW %v; previous %v dB, %v",
+				beta, p.SidelobeLevel, p.ENBW, prev.SidelobeLevel, prev.ENBW)
+		}
+
+		prev = p
+	}
+}
+
+func TestApplyInPlace(t *testing.T) {
+	t.Parallel()
+
+	w := Hann(4, Periodic)
+	data32 := []float32{2, 2, 2, 2}
+	data64 := []float64{1, 2, 3, 4}
+
+	if err := ApplyInPlace(data32, w); err != nil {
+		t.Fatalf("ApplyInPlace(float32) returned error: %v", err)
+	}
+
+	if err := ApplyInPlace(data64, w); err != nil {
+		t.Fatalf("ApplyInPlace(float64) returned error: %v", err)
+	}
+
+	for i, want := range []float64{0, 1, 2, 1} {
+		assertClose(t, float64(data32[i]), want, 1e-7, "float32 [%d]", i)
+	}
+
+	for i, want := range []float64{0, 1, 3, 2} {
+		assertClose(t, data64[i], want, 1e-15, "float64 [%d]", i)
+	}
+
+	if err := ApplyInPlace(data64[:3], w); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("mismatch error = %v, want ErrLengthMismatch", err)
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics during parallel tests
+func TestApplyInPlaceNoAllocs(t *testing.T) {
+	w := Blackman(256, Periodic)
+	data := make([]float32, 256)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = ApplyInPlace(data, w)
+	})
+	if allocs != 0 {
+		t.Fatalf("ApplyInPlace allocated %v times, want 0", allocs)
+	}
+}
+
+func TestGaussianErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Gaussian(8, 0, Symmetric); !errors.Is(err, ErrInvalidParameter) {
+		t.Fatalf("Gaussian(std=0) error = %v, want ErrInvalidParameter", err)
+	}
+
+	if _, err := Gaussian(0, 1, Symmetric); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("Gaussian(n=0) error = %v, want ErrInvalidLength", err)
+	}
+
+	// Generators without an error result return an empty window.
+	if w := Hann(-3, Periodic); len(w) != 0 {
+		t.Fatalf("Hann(-3) = %v, want empty", w)
+	}
+}